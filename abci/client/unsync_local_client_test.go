@@ -0,0 +1,62 @@
+package abcicli_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/types"
+)
+
+// blockingApp blocks CheckTx until the given number of calls are running
+// concurrently.
+type blockingApp struct {
+	types.BaseApplication
+
+	wg *sync.WaitGroup
+}
+
+func (app blockingApp) CheckTx(context.Context, *types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	app.wg.Done()
+	app.wg.Wait()
+	return &types.ResponseCheckTx{Code: types.CodeTypeOK}, nil
+}
+
+func TestUnsyncLocalClientConcurrentCheckTx(t *testing.T) {
+	const calls = 10
+
+	var wg sync.WaitGroup
+	wg.Add(calls)
+	c := abcicli.NewUnsyncLocalClient(blockingApp{wg: &wg})
+
+	// The callback is not called concurrently, so it needs no lock.
+	responses := 0
+	c.SetResponseCallback(func(*types.Request, *types.Response) { responses++ })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var calling sync.WaitGroup
+		for i := 0; i < calls; i++ {
+			calling.Add(1)
+			go func() {
+				defer calling.Done()
+				_, err := c.CheckTxAsync(context.Background(), &types.RequestCheckTx{})
+				assert.NoError(t, err)
+			}()
+		}
+		calling.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "CheckTx calls were serialized")
+	}
+	assert.Equal(t, calls, responses)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cometbft/cometbft/abci/types"
 	cryptoencoding "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cryptoproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	"github.com/cometbft/cometbft/version"
 )
@@ -34,10 +35,19 @@ var _ types.Application = (*Application)(nil)
 // Application is the kvstore state machine. It complies with the abci.Application interface.
 // It takes transactions in the form of key=value and saves them in a database. This is
 // a somewhat trivial example as there is no real state execution
+//
+// Query, Info and CheckTx are safe to call concurrently with the consensus
+// connection, and CheckTx with itself.
 type Application struct {
 	types.BaseApplication
 
-	state        State
+	// mtx guards the committed state, served by Query and Info, and the
+	// validators against the consensus connection. FinalizeBlock only updates
+	// the working state and isn't blocked by queries. CheckTx is stateless and
+	// doesn't need it.
+	mtx          cmtsync.RWMutex
+	committed    State // state as of the last Commit
+	state        State // working state, updated by FinalizeBlock
 	RetainBlocks int64 // blocks to retain after commit (via ResponseCommit.RetainHeight)
 	stagedTxs    [][]byte
 	logger       log.Logger
@@ -53,9 +63,11 @@ type Application struct {
 
 // NewApplication creates an instance of the kvstore from the provided database
 func NewApplication(db dbm.DB) *Application {
+	state := loadState(db)
 	return &Application{
 		logger:             log.NewNopLogger(),
-		state:              loadState(db),
+		committed:          state,
+		state:              state,
 		valAddrToPubKeyMap: make(map[string]cryptoproto.PublicKey),
 	}
 }
@@ -85,8 +97,11 @@ func (app *Application) SetGenBlockEvents() {
 // Tendermint will ensure it is in sync with the application by potentially replaying the blocks it has. If the
 // Application returns a 0 appBlockHeight, Tendermint will call InitChain to initialize the application with consensus related data
 func (app *Application) Info(context.Context, *types.RequestInfo) (*types.ResponseInfo, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
 	// Tendermint expects the application to persist validators, on start-up we need to reload them to memory if they exist
-	if len(app.valAddrToPubKeyMap) == 0 && app.state.Height > 0 {
+	if len(app.valAddrToPubKeyMap) == 0 && app.committed.Height > 0 {
 		validators := app.getValidators()
 		for _, v := range validators {
 			pubkey, err := cryptoencoding.PubKeyFromProto(v.PubKey)
@@ -98,11 +113,11 @@ func (app *Application) Info(context.Context, *types.RequestInfo) (*types.Respon
 	}
 
 	return &types.ResponseInfo{
-		Data:             fmt.Sprintf("{\"size\":%v}", app.state.Size),
+		Data:             fmt.Sprintf("{\"size\":%v}", app.committed.Size),
 		Version:          version.ABCIVersion,
 		AppVersion:       AppVersion,
-		LastBlockHeight:  app.state.Height,
-		LastBlockAppHash: app.state.Hash(),
+		LastBlockHeight:  app.committed.Height,
+		LastBlockAppHash: app.committed.Hash(),
 	}, nil
 }
 
@@ -110,6 +125,9 @@ func (app *Application) Info(context.Context, *types.RequestInfo) (*types.Respon
 // case that the application starts prepopulated with values. This method is called whenever a new instance of the application
 // starts (i.e. app height = 0).
 func (app *Application) InitChain(_ context.Context, req *types.RequestInitChain) (*types.ResponseInitChain, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
 	for _, v := range req.Validators {
 		app.updateValidator(v)
 	}
@@ -198,6 +216,7 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.RequestFinal
 	app.stagedTxs = make([][]byte, 0)
 
 	// Punish validators who committed equivocation.
+	app.mtx.RLock()
 	for _, ev := range req.Misbehavior {
 		if ev.Type == types.MisbehaviorType_DUPLICATE_VOTE {
 			addr := string(ev.Validator.Address)
@@ -213,6 +232,7 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.RequestFinal
 			}
 		}
 	}
+	app.mtx.RUnlock()
 
 	respTxs := make([]*types.ExecTxResult, len(req.Txs))
 	for i, tx := range req.Txs {
@@ -325,6 +345,9 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.RequestFinal
 // AppHash, ConsensusParams and ValidatorSet has occurred.
 // The KVStore persists the validator updates and the new key values
 func (app *Application) Commit(context.Context, *types.RequestCommit) (*types.ResponseCommit, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
 	// apply the validator updates to state (note this is really the validator set at h + 2)
 	for _, valUpdate := range app.valUpdates {
 		app.updateValidator(valUpdate)
@@ -345,6 +368,7 @@ func (app *Application) Commit(context.Context, *types.RequestCommit) (*types.Re
 
 	// persist the state (i.e. size and height)
 	saveState(app.state)
+	app.committed = app.state
 
 	resp := &types.ResponseCommit{}
 	if app.RetainBlocks > 0 && app.state.Height >= app.RetainBlocks {
@@ -355,11 +379,14 @@ func (app *Application) Commit(context.Context, *types.RequestCommit) (*types.Re
 
 // Returns an associated value or nil if missing.
 func (app *Application) Query(_ context.Context, reqQuery *types.RequestQuery) (*types.ResponseQuery, error) {
+	app.mtx.RLock()
+	defer app.mtx.RUnlock()
+
 	resQuery := &types.ResponseQuery{}
 
 	if reqQuery.Path == "/val" {
 		key := []byte(ValidatorPrefix + string(reqQuery.Data))
-		value, err := app.state.db.Get(key)
+		value, err := app.committed.db.Get(key)
 		if err != nil {
 			panic(err)
 		}
@@ -371,7 +398,7 @@ func (app *Application) Query(_ context.Context, reqQuery *types.RequestQuery) (
 	}
 
 	if reqQuery.Prove {
-		value, err := app.state.db.Get(prefixKey(reqQuery.Data))
+		value, err := app.committed.db.Get(prefixKey(reqQuery.Data))
 		if err != nil {
 			panic(err)
 		}
@@ -384,13 +411,13 @@ func (app *Application) Query(_ context.Context, reqQuery *types.RequestQuery) (
 		resQuery.Index = -1 // TODO make Proof return index
 		resQuery.Key = reqQuery.Data
 		resQuery.Value = value
-		resQuery.Height = app.state.Height
+		resQuery.Height = app.committed.Height
 
 		return resQuery, nil
 	}
 
 	resQuery.Key = reqQuery.Data
-	value, err := app.state.db.Get(prefixKey(reqQuery.Data))
+	value, err := app.committed.db.Get(prefixKey(reqQuery.Data))
 	if err != nil {
 		panic(err)
 	}
@@ -400,7 +427,7 @@ func (app *Application) Query(_ context.Context, reqQuery *types.RequestQuery) (
 		resQuery.Log = "exists"
 	}
 	resQuery.Value = value
-	resQuery.Height = app.state.Height
+	resQuery.Height = app.committed.Height
 
 	return resQuery, nil
 }
@@ -2182,6 +2182,14 @@ type ResponseCheckTx struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// sender is an optional identifier of the account that signed the
+	// transaction. It is only used by the priority mempool, which never reorders
+	// transactions from the same sender.
+	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	// priority is an optional value used by the priority mempool to order
+	// transactions when reaping them and to decide which transactions to evict
+	// when the mempool is full. Higher values have precedence.
+	Priority int64 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
@@ -2273,6 +2281,20 @@ func (m *ResponseCheckTx) GetCodespace() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ResponseCheckTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type ResponseCommit struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
 }
@@ -3615,202 +3637,202 @@ func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_2
 
 var fileDescriptor_252557cfdd89a31a = []byte{
 	// 3138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x3b, 0x73, 0x23, 0xc7,
-	0x11, 0xc6, 0xe2, 0x8d, 0xc6, 0x83, 0xcb, 0x21, 0xef, 0x84, 0x83, 0x4e, 0x24, 0xb5, 0x2a, 0x49,
-	0xa7, 0x93, 0x44, 0xca, 0x3c, 0x9f, 0x1e, 0x75, 0x92, 0x5d, 0x20, 0x0e, 0x67, 0x90, 0x47, 0x91,
-	0xd4, 0x12, 0x3c, 0x95, 0xfc, 0xd0, 0x6a, 0x09, 0x0c, 0x88, 0xd5, 0x01, 0xd8, 0xd5, 0xee, 0x80,
-	0x02, 0x15, 0xb9, 0xfc, 0xa8, 0x72, 0x29, 0x52, 0x95, 0x1d, 0x28, 0xb0, 0x02, 0x07, 0x4e, 0xfc,
-	0x0b, 0x1c, 0xd9, 0x89, 0x03, 0x05, 0x0e, 0x14, 0x3a, 0x92, 0x5d, 0x52, 0xa6, 0xd4, 0x81, 0x53,
-	0xd7, 0x3c, 0xf6, 0x05, 0x60, 0x09, 0xe0, 0xe4, 0xc4, 0x65, 0x67, 0x3b, 0xbd, 0xdd, 0x3d, 0x33,
-	0xbd, 0x3d, 0xdd, 0xfd, 0xf5, 0x0e, 0x3c, 0x4e, 0xf0, 0xa0, 0x8d, 0xed, 0xbe, 0x31, 0x20, 0x5b,
-	0xfa, 0x69, 0xcb, 0xd8, 0x22, 0x17, 0x16, 0x76, 0x36, 0x2d, 0xdb, 0x24, 0x26, 0x5a, 0xf2, 0x5f,
-	0x6e, 0xd2, 0x97, 0x95, 0x27, 0x02, 0xdc, 0x2d, 0xfb, 0xc2, 0x22, 0xe6, 0x96, 0x65, 0x9b, 0x66,
-	0x87, 0xf3, 0x57, 0xae, 0x4f, 0xbe, 0x7e, 0x88, 0x2f, 0x84, 0xb6, 0x90, 0x30, 0x9b, 0x65, 0xcb,
-	0xd2, 0x6d, 0xbd, 0xef, 0xbe, 0xde, 0x98, 0x78, 0x7d, 0xae, 0xf7, 0x8c, 0xb6, 0x4e, 0x4c, 0x5b,
-	0x70, 0xac, 0x9f, 0x99, 0xe6, 0x59, 0x0f, 0x6f, 0xb1, 0xd1, 0xe9, 0xb0, 0xb3, 0x45, 0x8c, 0x3e,
-	0x76, 0x88, 0xde, 0xb7, 0x04, 0xc3, 0xea, 0x99, 0x79, 0x66, 0xb2, 0xc7, 0x2d, 0xfa, 0xc4, 0xa9,
-	0xca, 0x9f, 0x73, 0x90, 0x51, 0xf1, 0x07, 0x43, 0xec, 0x10, 0xb4, 0x0d, 0x49, 0xdc, 0xea, 0x9a,
-	0x65, 0x69, 0x43, 0xba, 0x91, 0xdf, 0xbe, 0xbe, 0x39, 0xb6, 0xc1, 0x4d, 0xc1, 0x57, 0x6f, 0x75,
-	0xcd, 0x46, 0x4c, 0x65, 0xbc, 0xe8, 0x36, 0xa4, 0x3a, 0xbd, 0xa1, 0xd3, 0x2d, 0xc7, 0x99, 0xd0,
-	0x13, 0x51, 0x42, 0xf7, 0x28, 0x53, 0x23, 0xa6, 0x72, 0x6e, 0x3a, 0x95, 0x31, 0xe8, 0x98, 0xe5,
-	0xc4, 0xe5, 0x53, 0xed, 0x0e, 0x3a, 0x6c, 0x2a, 0xca, 0x8b, 0x76, 0x00, 0x8c, 0x81, 0x41, 0xb4,
-	0x56, 0x57, 0x37, 0x06, 0xe5, 0x14, 0x93, 0x7c, 0x32, 0x5a, 0xd2, 0x20, 0x35, 0xca, 0xd8, 0x88,
-	0xa9, 0x39, 0xc3, 0x1d, 0xd0, 0xe5, 0x7e, 0x30, 0xc4, 0xf6, 0x45, 0x39, 0x7d, 0xf9, 0x72, 0xdf,
-	0xa2, 0x4c, 0x74, 0xb9, 0x8c, 0x1b, 0xbd, 0x0e, 0xd9, 0x56, 0x17, 0xb7, 0x1e, 0x6a, 0x64, 0x54,
-	0xce, 0x32, 0xc9, 0xf5, 0x28, 0xc9, 0x1a, 0xe5, 0x6b, 0x8e, 0x1a, 0x31, 0x35, 0xd3, 0xe2, 0x8f,
-	0xe8, 0x55, 0x48, 0xb7, 0xcc, 0x7e, 0xdf, 0x20, 0xe5, 0x3c, 0x93, 0x5d, 0x8b, 0x94, 0x65, 0x5c,
-	0x8d, 0x98, 0x2a, 0xf8, 0xd1, 0x01, 0x94, 0x7a, 0x86, 0x43, 0x34, 0x67, 0xa0, 0x5b, 0x4e, 0xd7,
-	0x24, 0x4e, 0xb9, 0xc0, 0x34, 0x3c, 0x1d, 0xa5, 0x61, 0xdf, 0x70, 0xc8, 0xb1, 0xcb, 0xdc, 0x88,
-	0xa9, 0xc5, 0x5e, 0x90, 0x40, 0xf5, 0x99, 0x9d, 0x0e, 0xb6, 0x3d, 0x85, 0xe5, 0xe2, 0xe5, 0xfa,
-	0x0e, 0x29, 0xb7, 0x2b, 0x4f, 0xf5, 0x99, 0x41, 0x02, 0xfa, 0x11, 0xac, 0xf4, 0x4c, 0xbd, 0xed,
-	0xa9, 0xd3, 0x5a, 0xdd, 0xe1, 0xe0, 0x61, 0xb9, 0xc4, 0x94, 0x3e, 0x17, 0xb9, 0x48, 0x53, 0x6f,
-	0xbb, 0x2a, 0x6a, 0x54, 0xa0, 0x11, 0x53, 0x97, 0x7b, 0xe3, 0x44, 0xf4, 0x2e, 0xac, 0xea, 0x96,
-	0xd5, 0xbb, 0x18, 0xd7, 0xbe, 0xc4, 0xb4, 0xdf, 0x8c, 0xd2, 0x5e, 0xa5, 0x32, 0xe3, 0xea, 0x91,
-	0x3e, 0x41, 0x45, 0x4d, 0x90, 0x2d, 0x1b, 0x5b, 0xba, 0x8d, 0x35, 0xcb, 0x36, 0x2d, 0xd3, 0xd1,
-	0x7b, 0x65, 0x99, 0xe9, 0x7e, 0x36, 0x4a, 0xf7, 0x11, 0xe7, 0x3f, 0x12, 0xec, 0x8d, 0x98, 0xba,
-	0x64, 0x85, 0x49, 0x5c, 0xab, 0xd9, 0xc2, 0x8e, 0xe3, 0x6b, 0x5d, 0x9e, 0xa5, 0x95, 0xf1, 0x87,
-	0xb5, 0x86, 0x48, 0xa8, 0x0e, 0x79, 0x3c, 0xa2, 0xe2, 0xda, 0xb9, 0x49, 0x70, 0x19, 0x31, 0x85,
-	0x4a, 0xe4, 0x09, 0x65, 0xac, 0x0f, 0x4c, 0x82, 0x1b, 0x31, 0x15, 0xb0, 0x37, 0x42, 0x3a, 0x5c,
-	0x39, 0xc7, 0xb6, 0xd1, 0xb9, 0x60, 0x6a, 0x34, 0xf6, 0xc6, 0x31, 0xcc, 0x41, 0x79, 0x85, 0x29,
-	0x7c, 0x3e, 0x4a, 0xe1, 0x03, 0x26, 0x44, 0x55, 0xd4, 0x5d, 0x91, 0x46, 0x4c, 0x5d, 0x39, 0x9f,
-	0x24, 0x53, 0x17, 0xeb, 0x18, 0x03, 0xbd, 0x67, 0x7c, 0x84, 0xb5, 0xd3, 0x9e, 0xd9, 0x7a, 0x58,
-	0x5e, 0xbd, 0xdc, 0xc5, 0xee, 0x09, 0xee, 0x1d, 0xca, 0x4c, 0x5d, 0xac, 0x13, 0x24, 0xec, 0x64,
-	0x20, 0x75, 0xae, 0xf7, 0x86, 0x78, 0x2f, 0x99, 0x4d, 0xca, 0xa9, 0xbd, 0x64, 0x36, 0x23, 0x67,
-	0xf7, 0x92, 0xd9, 0x9c, 0x0c, 0x7b, 0xc9, 0x2c, 0xc8, 0x79, 0xe5, 0x59, 0xc8, 0x07, 0x02, 0x13,
-	0x2a, 0x43, 0xa6, 0x8f, 0x1d, 0x47, 0x3f, 0xc3, 0x2c, 0x8e, 0xe5, 0x54, 0x77, 0xa8, 0x94, 0xa0,
-	0x10, 0x0c, 0x46, 0xca, 0x27, 0x92, 0x27, 0x49, 0xe3, 0x0c, 0x95, 0x3c, 0xc7, 0x36, 0x33, 0x87,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0xe3, 0xc6,
+	0xd1, 0x27, 0xf8, 0x66, 0xf3, 0x05, 0x8d, 0xb4, 0x6b, 0x2e, 0xbd, 0x96, 0x64, 0xb8, 0x6c, 0xaf,
+	0xd7, 0xb6, 0xe4, 0x4f, 0xfb, 0xf9, 0x55, 0x6b, 0x27, 0x45, 0x71, 0xb9, 0xa1, 0xb4, 0x6b, 0x49,
+	0x86, 0xa8, 0x75, 0x39, 0x0f, 0xc3, 0x10, 0x39, 0x14, 0xe1, 0x25, 0x09, 0x18, 0x18, 0xca, 0x94,
+	0x4f, 0xa9, 0x3c, 0xaa, 0x52, 0x3e, 0xb9, 0x2a, 0x39, 0xf8, 0x10, 0x1f, 0x72, 0xc8, 0xff, 0x90,
+	0x53, 0x72, 0xc9, 0xc1, 0x87, 0x1c, 0x7c, 0xcc, 0xc9, 0x49, 0xd9, 0x37, 0x5f, 0x73, 0xc8, 0x35,
+	0x35, 0x0f, 0x80, 0x00, 0x09, 0x88, 0xe4, 0x3a, 0x97, 0x54, 0x72, 0xc3, 0x34, 0xba, 0x7b, 0x66,
+	0x1a, 0x3d, 0xdd, 0xfd, 0x6b, 0x0c, 0x3c, 0x4e, 0xf0, 0xb0, 0x83, 0xed, 0x81, 0x31, 0x24, 0xdb,
+	0xfa, 0x69, 0xdb, 0xd8, 0x26, 0x17, 0x16, 0x76, 0xb6, 0x2c, 0xdb, 0x24, 0x26, 0x2a, 0x4f, 0x5e,
+	0x6e, 0xd1, 0x97, 0xd5, 0x27, 0x7c, 0xdc, 0x6d, 0xfb, 0xc2, 0x22, 0xe6, 0xb6, 0x65, 0x9b, 0x66,
+	0x97, 0xf3, 0x57, 0xaf, 0xcf, 0xbe, 0x7e, 0x88, 0x2f, 0x84, 0xb6, 0x80, 0x30, 0x9b, 0x65, 0xdb,
+	0xd2, 0x6d, 0x7d, 0xe0, 0xbe, 0xde, 0x9c, 0x79, 0x7d, 0xae, 0xf7, 0x8d, 0x8e, 0x4e, 0x4c, 0x5b,
+	0x70, 0x6c, 0x9c, 0x99, 0xe6, 0x59, 0x1f, 0x6f, 0xb3, 0xd1, 0xe9, 0xa8, 0xbb, 0x4d, 0x8c, 0x01,
+	0x76, 0x88, 0x3e, 0xb0, 0x04, 0xc3, 0xda, 0x99, 0x79, 0x66, 0xb2, 0xc7, 0x6d, 0xfa, 0xc4, 0xa9,
+	0xca, 0x9f, 0x72, 0x90, 0x51, 0xf1, 0x87, 0x23, 0xec, 0x10, 0xb4, 0x03, 0x49, 0xdc, 0xee, 0x99,
+	0x15, 0x69, 0x53, 0xba, 0x91, 0xdf, 0xb9, 0xbe, 0x35, 0xb5, 0xc1, 0x2d, 0xc1, 0xd7, 0x68, 0xf7,
+	0xcc, 0x66, 0x4c, 0x65, 0xbc, 0xe8, 0x65, 0x48, 0x75, 0xfb, 0x23, 0xa7, 0x57, 0x89, 0x33, 0xa1,
+	0x27, 0xa2, 0x84, 0xee, 0x52, 0xa6, 0x66, 0x4c, 0xe5, 0xdc, 0x74, 0x2a, 0x63, 0xd8, 0x35, 0x2b,
+	0x89, 0xcb, 0xa7, 0xda, 0x1b, 0x76, 0xd9, 0x54, 0x94, 0x17, 0xed, 0x02, 0x18, 0x43, 0x83, 0x68,
+	0xed, 0x9e, 0x6e, 0x0c, 0x2b, 0x29, 0x26, 0xf9, 0x64, 0xb4, 0xa4, 0x41, 0xea, 0x94, 0xb1, 0x19,
+	0x53, 0x73, 0x86, 0x3b, 0xa0, 0xcb, 0xfd, 0x70, 0x84, 0xed, 0x8b, 0x4a, 0xfa, 0xf2, 0xe5, 0xbe,
+	0x4d, 0x99, 0xe8, 0x72, 0x19, 0x37, 0x7a, 0x03, 0xb2, 0xed, 0x1e, 0x6e, 0x3f, 0xd4, 0xc8, 0xb8,
+	0x92, 0x65, 0x92, 0x1b, 0x51, 0x92, 0x75, 0xca, 0xd7, 0x1a, 0x37, 0x63, 0x6a, 0xa6, 0xcd, 0x1f,
+	0xd1, 0x6b, 0x90, 0x6e, 0x9b, 0x83, 0x81, 0x41, 0x2a, 0x79, 0x26, 0xbb, 0x1e, 0x29, 0xcb, 0xb8,
+	0x9a, 0x31, 0x55, 0xf0, 0xa3, 0x03, 0x28, 0xf5, 0x0d, 0x87, 0x68, 0xce, 0x50, 0xb7, 0x9c, 0x9e,
+	0x49, 0x9c, 0x4a, 0x81, 0x69, 0x78, 0x3a, 0x4a, 0xc3, 0x7d, 0xc3, 0x21, 0xc7, 0x2e, 0x73, 0x33,
+	0xa6, 0x16, 0xfb, 0x7e, 0x02, 0xd5, 0x67, 0x76, 0xbb, 0xd8, 0xf6, 0x14, 0x56, 0x8a, 0x97, 0xeb,
+	0x3b, 0xa4, 0xdc, 0xae, 0x3c, 0xd5, 0x67, 0xfa, 0x09, 0xe8, 0x47, 0xb0, 0xda, 0x37, 0xf5, 0x8e,
+	0xa7, 0x4e, 0x6b, 0xf7, 0x46, 0xc3, 0x87, 0x95, 0x12, 0x53, 0xfa, 0x5c, 0xe4, 0x22, 0x4d, 0xbd,
+	0xe3, 0xaa, 0xa8, 0x53, 0x81, 0x66, 0x4c, 0x5d, 0xe9, 0x4f, 0x13, 0xd1, 0x7b, 0xb0, 0xa6, 0x5b,
+	0x56, 0xff, 0x62, 0x5a, 0x7b, 0x99, 0x69, 0xbf, 0x19, 0xa5, 0xbd, 0x46, 0x65, 0xa6, 0xd5, 0x23,
+	0x7d, 0x86, 0x8a, 0x5a, 0x20, 0x5b, 0x36, 0xb6, 0x74, 0x1b, 0x6b, 0x96, 0x6d, 0x5a, 0xa6, 0xa3,
+	0xf7, 0x2b, 0x32, 0xd3, 0xfd, 0x6c, 0x94, 0xee, 0x23, 0xce, 0x7f, 0x24, 0xd8, 0x9b, 0x31, 0xb5,
+	0x6c, 0x05, 0x49, 0x5c, 0xab, 0xd9, 0xc6, 0x8e, 0x33, 0xd1, 0xba, 0x32, 0x4f, 0x2b, 0xe3, 0x0f,
+	0x6a, 0x0d, 0x90, 0x50, 0x03, 0xf2, 0x78, 0x4c, 0xc5, 0xb5, 0x73, 0x93, 0xe0, 0x0a, 0x62, 0x0a,
+	0x95, 0xc8, 0x13, 0xca, 0x58, 0x1f, 0x98, 0x04, 0x37, 0x63, 0x2a, 0x60, 0x6f, 0x84, 0x74, 0xb8,
+	0x72, 0x8e, 0x6d, 0xa3, 0x7b, 0xc1, 0xd4, 0x68, 0xec, 0x8d, 0x63, 0x98, 0xc3, 0xca, 0x2a, 0x53,
+	0xf8, 0x7c, 0x94, 0xc2, 0x07, 0x4c, 0x88, 0xaa, 0x68, 0xb8, 0x22, 0xcd, 0x98, 0xba, 0x7a, 0x3e,
+	0x4b, 0xa6, 0x2e, 0xd6, 0x35, 0x86, 0x7a, 0xdf, 0xf8, 0x18, 0x6b, 0xa7, 0x7d, 0xb3, 0xfd, 0xb0,
+	0xb2, 0x76, 0xb9, 0x8b, 0xdd, 0x15, 0xdc, 0xbb, 0x94, 0x99, 0xba, 0x58, 0xd7, 0x4f, 0xd8, 0xcd,
+	0x40, 0xea, 0x5c, 0xef, 0x8f, 0xf0, 0x7e, 0x32, 0x9b, 0x94, 0x53, 0xfb, 0xc9, 0x6c, 0x46, 0xce,
+	0xee, 0x27, 0xb3, 0x39, 0x19, 0xf6, 0x93, 0x59, 0x90, 0xf3, 0xca, 0xb3, 0x90, 0xf7, 0x05, 0x26,
+	0x54, 0x81, 0xcc, 0x00, 0x3b, 0x8e, 0x7e, 0x86, 0x59, 0x1c, 0xcb, 0xa9, 0xee, 0x50, 0x29, 0x41,
+	0xc1, 0x1f, 0x8c, 0x94, 0x4f, 0x25, 0xc8, 0xfb, 0xe2, 0x0c, 0x95, 0x3c, 0xc7, 0x36, 0x33, 0x87,
 	0x90, 0x14, 0x43, 0xf4, 0x14, 0x14, 0xd9, 0x56, 0x34, 0xf7, 0x3d, 0x0d, 0x76, 0x49, 0xb5, 0xc0,
-	0x88, 0x0f, 0x04, 0xd3, 0x3a, 0xe4, 0xad, 0x6d, 0xcb, 0x63, 0x49, 0x30, 0x16, 0xb0, 0xb6, 0x2d,
+	0x88, 0x0f, 0x04, 0xd3, 0x06, 0xe4, 0xad, 0x1d, 0xcb, 0x63, 0x49, 0x30, 0x16, 0xb0, 0x76, 0x2c,
 	0x97, 0xe1, 0x49, 0x28, 0xd0, 0x7d, 0x7b, 0x1c, 0x49, 0x36, 0x49, 0x9e, 0xd2, 0x04, 0x8b, 0xf2,
-	0xd7, 0x38, 0xc8, 0xe3, 0x01, 0x0c, 0xbd, 0x0a, 0x49, 0x1a, 0xcb, 0x45, 0x58, 0xae, 0x6c, 0xf2,
-	0x40, 0xbf, 0xe9, 0x06, 0xfa, 0xcd, 0xa6, 0x1b, 0xe8, 0x77, 0xb2, 0x9f, 0x7f, 0xb9, 0x1e, 0xfb,
-	0xe4, 0xef, 0xeb, 0x92, 0xca, 0x24, 0xd0, 0x35, 0x1a, 0xb6, 0x74, 0x63, 0xa0, 0x19, 0x6d, 0xb6,
-	0xe4, 0x1c, 0x8d, 0x49, 0xba, 0x31, 0xd8, 0x6d, 0xa3, 0x7d, 0x90, 0x5b, 0xe6, 0xc0, 0xc1, 0x03,
-	0x67, 0xe8, 0x68, 0x3c, 0xd5, 0x88, 0x60, 0x1c, 0x0a, 0xa9, 0x3c, 0xe1, 0xd5, 0x5c, 0xce, 0x23,
-	0xc6, 0xa8, 0x2e, 0xb5, 0xc2, 0x04, 0x74, 0x0f, 0xc0, 0xcb, 0x47, 0x4e, 0x39, 0xb9, 0x91, 0xb8,
-	0x91, 0xdf, 0xde, 0x98, 0xf8, 0xe0, 0x0f, 0x5c, 0x96, 0x13, 0xab, 0xad, 0x13, 0xbc, 0x93, 0xa4,
-	0xcb, 0x55, 0x03, 0x92, 0xe8, 0x19, 0x58, 0xd2, 0x2d, 0x4b, 0x73, 0x88, 0x4e, 0xb0, 0x76, 0x7a,
-	0x41, 0xb0, 0xc3, 0xe2, 0x7c, 0x41, 0x2d, 0xea, 0x96, 0x75, 0x4c, 0xa9, 0x3b, 0x94, 0x88, 0x9e,
-	0x86, 0x12, 0x8d, 0xe9, 0x86, 0xde, 0xd3, 0xba, 0xd8, 0x38, 0xeb, 0x12, 0x16, 0xcf, 0x13, 0x6a,
-	0x51, 0x50, 0x1b, 0x8c, 0xa8, 0xb4, 0xbd, 0x2f, 0xce, 0xe2, 0x39, 0x42, 0x90, 0x6c, 0xeb, 0x44,
-	0x67, 0x96, 0x2c, 0xa8, 0xec, 0x99, 0xd2, 0x2c, 0x9d, 0x74, 0x85, 0x7d, 0xd8, 0x33, 0xba, 0x0a,
-	0x69, 0xa1, 0x36, 0xc1, 0xd4, 0x8a, 0x11, 0x5a, 0x85, 0x94, 0x65, 0x9b, 0xe7, 0x98, 0x7d, 0xba,
-	0xac, 0xca, 0x07, 0x8a, 0x0a, 0xa5, 0x70, 0xec, 0x47, 0x25, 0x88, 0x93, 0x91, 0x98, 0x25, 0x4e,
-	0x46, 0xe8, 0x25, 0x48, 0x52, 0x43, 0xb2, 0x39, 0x4a, 0x53, 0xb2, 0x9d, 0x90, 0x6b, 0x5e, 0x58,
-	0x58, 0x65, 0x9c, 0xca, 0x12, 0x14, 0x43, 0x39, 0x41, 0xb9, 0x0a, 0xab, 0xd3, 0x42, 0xbc, 0xd2,
-	0xf5, 0xe8, 0xa1, 0x50, 0x8d, 0x6e, 0x43, 0xd6, 0x8b, 0xf1, 0xdc, 0x71, 0xae, 0x4d, 0x4c, 0xeb,
-	0x32, 0xab, 0x1e, 0x2b, 0xf5, 0x18, 0xfa, 0x01, 0xba, 0xba, 0xc8, 0xe8, 0x05, 0x35, 0xa3, 0x5b,
-	0x56, 0x43, 0x77, 0xba, 0xca, 0x7b, 0x50, 0x8e, 0x8a, 0xdf, 0x01, 0x83, 0x49, 0xcc, 0xed, 0x5d,
-	0x83, 0x5d, 0x85, 0x74, 0xc7, 0xb4, 0xfb, 0x3a, 0x61, 0xca, 0x8a, 0xaa, 0x18, 0x51, 0x43, 0xf2,
-	0x58, 0x9e, 0x60, 0x64, 0x3e, 0x50, 0x34, 0xb8, 0x16, 0x19, 0xc3, 0xa9, 0x88, 0x31, 0x68, 0x63,
-	0x6e, 0xd6, 0xa2, 0xca, 0x07, 0xbe, 0x22, 0xbe, 0x58, 0x3e, 0xa0, 0xd3, 0x3a, 0x6c, 0xaf, 0x4c,
-	0x7f, 0x4e, 0x15, 0x23, 0xe5, 0xd3, 0x04, 0x5c, 0x9d, 0x1e, 0xc9, 0xd1, 0x06, 0x14, 0xfa, 0xfa,
-	0x48, 0x23, 0x23, 0xe1, 0x76, 0x12, 0xfb, 0xf0, 0xd0, 0xd7, 0x47, 0xcd, 0x11, 0xf7, 0x39, 0x19,
-	0x12, 0x64, 0xe4, 0x94, 0xe3, 0x1b, 0x89, 0x1b, 0x05, 0x95, 0x3e, 0xa2, 0x13, 0x58, 0xee, 0x99,
-	0x2d, 0xbd, 0xa7, 0xf5, 0x74, 0x87, 0x68, 0x22, 0xc5, 0xf3, 0x43, 0xf4, 0xd4, 0x84, 0xb1, 0x79,
-	0x4c, 0xc6, 0x6d, 0xfe, 0x3d, 0x69, 0xc0, 0x11, 0xfe, 0xbf, 0xc4, 0x74, 0xec, 0xeb, 0xee, 0xa7,
-	0x46, 0x77, 0x21, 0xdf, 0x37, 0x9c, 0x53, 0xdc, 0xd5, 0xcf, 0x0d, 0xd3, 0x16, 0xa7, 0x69, 0xd2,
-	0x69, 0xde, 0xf4, 0x79, 0x84, 0xa6, 0xa0, 0x58, 0xe0, 0x93, 0xa4, 0x42, 0x3e, 0xec, 0x46, 0x93,
-	0xf4, 0xc2, 0xd1, 0xe4, 0x25, 0x58, 0x1d, 0xe0, 0x11, 0xd1, 0xfc, 0xf3, 0xca, 0xfd, 0x24, 0xc3,
-	0x4c, 0x8f, 0xe8, 0x3b, 0xef, 0x84, 0x3b, 0xd4, 0x65, 0xd0, 0x73, 0x2c, 0x17, 0x5a, 0xa6, 0x83,
-	0x6d, 0x4d, 0x6f, 0xb7, 0x6d, 0xec, 0x38, 0xac, 0x7c, 0x2a, 0xb0, 0x04, 0xc7, 0xe8, 0x55, 0x4e,
-	0x56, 0x7e, 0x15, 0xfc, 0x34, 0xe1, 0xdc, 0x27, 0x0c, 0x2f, 0xf9, 0x86, 0x3f, 0x86, 0x55, 0x21,
-	0xdf, 0x0e, 0xd9, 0x9e, 0xd7, 0xa0, 0x8f, 0x4f, 0x9e, 0xaf, 0x71, 0x9b, 0x23, 0x57, 0x3c, 0xda,
-	0xec, 0x89, 0x47, 0x33, 0x3b, 0x82, 0x24, 0x33, 0x4a, 0x92, 0x87, 0x18, 0xfa, 0xfc, 0xdf, 0xf6,
-	0x29, 0xbe, 0x0f, 0xcb, 0x13, 0x75, 0x84, 0xb7, 0x2f, 0x69, 0xea, 0xbe, 0xe2, 0xc1, 0x7d, 0x29,
-	0xbf, 0x95, 0xa0, 0x12, 0x5d, 0x38, 0x4c, 0x55, 0xf5, 0x3c, 0x2c, 0x7b, 0x7b, 0xf1, 0xd6, 0xc7,
-	0xcf, 0xb4, 0xec, 0xbd, 0x10, 0x0b, 0x8c, 0x0c, 0xcf, 0x4f, 0x43, 0x69, 0xac, 0xac, 0xe1, 0x5f,
-	0xa1, 0x78, 0x1e, 0x9c, 0x5f, 0xf9, 0x45, 0xc2, 0x8b, 0x99, 0xa1, 0xda, 0x63, 0x8a, 0xa3, 0xbd,
-	0x05, 0x2b, 0x6d, 0xdc, 0x32, 0xda, 0x8f, 0xea, 0x67, 0xcb, 0x42, 0xfa, 0xff, 0x6e, 0x36, 0xe9,
-	0x66, 0xbf, 0x01, 0xc8, 0xaa, 0xd8, 0xb1, 0x68, 0x29, 0x81, 0x76, 0x20, 0x87, 0x47, 0x2d, 0x6c,
-	0x11, 0xb7, 0xfa, 0x9a, 0x5e, 0xdd, 0x72, 0xee, 0xba, 0xcb, 0x49, 0xb1, 0x9d, 0x27, 0x86, 0x6e,
-	0x09, 0xf8, 0x1a, 0x8d, 0x44, 0x85, 0x78, 0x10, 0xbf, 0xbe, 0xec, 0xe2, 0xd7, 0x44, 0x24, 0x34,
-	0xe3, 0x52, 0x63, 0x00, 0xf6, 0x96, 0x00, 0xb0, 0xc9, 0x19, 0x93, 0x85, 0x10, 0x6c, 0x2d, 0x84,
-	0x60, 0xd3, 0x33, 0xb6, 0x19, 0x01, 0x61, 0x5f, 0x76, 0x21, 0x6c, 0x66, 0xc6, 0x8a, 0xc7, 0x30,
-	0xec, 0x1b, 0x01, 0x0c, 0x9b, 0x63, 0xa2, 0x1b, 0x91, 0xa2, 0x53, 0x40, 0xec, 0x6b, 0x1e, 0x88,
-	0x2d, 0x44, 0x02, 0x60, 0x21, 0x3c, 0x8e, 0x62, 0x0f, 0x27, 0x50, 0x2c, 0x47, 0x9d, 0xcf, 0x44,
-	0xaa, 0x98, 0x01, 0x63, 0x0f, 0x27, 0x60, 0x6c, 0x69, 0x86, 0xc2, 0x19, 0x38, 0xf6, 0xc7, 0xd3,
-	0x71, 0x6c, 0x34, 0xd2, 0x14, 0xcb, 0x9c, 0x0f, 0xc8, 0x6a, 0x11, 0x40, 0x56, 0x8e, 0x04, 0x5d,
-	0x5c, 0xfd, 0xdc, 0x48, 0xf6, 0x64, 0x0a, 0x92, 0xe5, 0x98, 0xf3, 0x46, 0xa4, 0xf2, 0x39, 0xa0,
-	0xec, 0xc9, 0x14, 0x28, 0x8b, 0x66, 0xaa, 0x9d, 0x89, 0x65, 0xef, 0x85, 0xb1, 0xec, 0x4a, 0x44,
-	0xc1, 0xe4, 0x9f, 0xf6, 0x08, 0x30, 0x7b, 0x1a, 0x05, 0x66, 0x39, 0xe0, 0x7c, 0x21, 0x52, 0xe3,
-	0x02, 0x68, 0xf6, 0x70, 0x02, 0xcd, 0x5e, 0x99, 0xe1, 0x69, 0xf3, 0xc3, 0xd9, 0x94, 0x9c, 0xde,
-	0x4b, 0x66, 0xb3, 0x72, 0x8e, 0x03, 0xd9, 0xbd, 0x64, 0x36, 0x2f, 0x17, 0x94, 0xe7, 0x68, 0xf6,
-	0x1d, 0x8b, 0x73, 0xb4, 0xcc, 0xc5, 0xb6, 0x6d, 0xda, 0x02, 0x98, 0xf2, 0x81, 0x72, 0x83, 0xc2,
-	0x1b, 0x3f, 0xa6, 0x5d, 0x02, 0x7d, 0x19, 0x9c, 0x08, 0xc4, 0x31, 0xe5, 0x8f, 0x92, 0x2f, 0xcb,
-	0xc0, 0x6f, 0x10, 0x1a, 0xe5, 0x04, 0x34, 0x0a, 0x00, 0xe2, 0x78, 0x18, 0x10, 0xaf, 0x43, 0x9e,
-	0xc2, 0x84, 0x31, 0xac, 0xab, 0x5b, 0x1e, 0xd6, 0xbd, 0x09, 0xcb, 0x2c, 0x61, 0x72, 0xd8, 0x2c,
-	0xd2, 0x52, 0x92, 0xa5, 0xa5, 0x25, 0xfa, 0x82, 0x5b, 0x87, 0xe7, 0xa7, 0x17, 0x61, 0x25, 0xc0,
-	0xeb, 0xc1, 0x0f, 0x0e, 0xfc, 0x64, 0x8f, 0xbb, 0x2a, 0x70, 0xc8, 0x5f, 0x24, 0xdf, 0x42, 0x3e,
-	0x48, 0x9e, 0x86, 0x67, 0xa5, 0xff, 0x10, 0x9e, 0x8d, 0x3f, 0x32, 0x9e, 0x0d, 0xc2, 0xa9, 0x44,
-	0x18, 0x4e, 0xfd, 0x4b, 0xf2, 0xbf, 0x89, 0x87, 0x4e, 0x5b, 0x66, 0x1b, 0x0b, 0x80, 0xc3, 0x9e,
-	0x69, 0x49, 0xd2, 0x33, 0xcf, 0x04, 0x8c, 0xa1, 0x8f, 0x94, 0xcb, 0x4b, 0x3c, 0x39, 0x91, 0x57,
-	0x3c, 0x6c, 0xc4, 0x13, 0xbf, 0xc0, 0x46, 0x32, 0x24, 0x1e, 0x62, 0xde, 0xe9, 0x2c, 0xa8, 0xf4,
-	0x91, 0xf2, 0x31, 0xe7, 0x13, 0x09, 0x9c, 0x0f, 0xd0, 0xab, 0x90, 0x63, 0x7d, 0x6a, 0xcd, 0xb4,
-	0x1c, 0xd1, 0xdd, 0x0c, 0x95, 0x36, 0xbc, 0x59, 0xbd, 0x79, 0x44, 0x79, 0x0e, 0x2d, 0x47, 0xcd,
-	0x5a, 0xe2, 0x29, 0x50, 0x71, 0xe4, 0x42, 0x15, 0xc7, 0x75, 0xc8, 0xd1, 0xd5, 0x3b, 0x96, 0xde,
-	0xc2, 0x65, 0x60, 0x0b, 0xf5, 0x09, 0xca, 0x1f, 0xe2, 0xb0, 0x34, 0x96, 0x68, 0xa6, 0xee, 0xdd,
-	0x75, 0xc9, 0x78, 0x00, 0xad, 0xcf, 0x67, 0x8f, 0x35, 0x80, 0x33, 0xdd, 0xd1, 0x3e, 0xd4, 0x07,
-	0x04, 0xb7, 0x85, 0x51, 0x02, 0x14, 0x54, 0x81, 0x2c, 0x1d, 0x0d, 0x1d, 0xdc, 0x16, 0x8d, 0x03,
-	0x6f, 0x8c, 0x1a, 0x90, 0xc6, 0xe7, 0x78, 0x40, 0x9c, 0x72, 0x86, 0x7d, 0xf6, 0xab, 0x93, 0x48,
-	0x8e, 0xbe, 0xde, 0x29, 0xd3, 0x8f, 0xfd, 0xcd, 0x97, 0xeb, 0x32, 0xe7, 0x7e, 0xc1, 0xec, 0x1b,
-	0x04, 0xf7, 0x2d, 0x72, 0xa1, 0x0a, 0xf9, 0xb0, 0x15, 0xb2, 0x63, 0x56, 0x60, 0x2d, 0xac, 0x82,
-	0x8b, 0x4c, 0xa9, 0x4d, 0x0d, 0xd3, 0x36, 0xc8, 0x85, 0x5a, 0xec, 0xe3, 0xbe, 0x65, 0x9a, 0x3d,
-	0x8d, 0x9f, 0xf1, 0x2a, 0x94, 0xc2, 0x79, 0x15, 0x3d, 0x05, 0x45, 0x1b, 0x13, 0xdd, 0x18, 0x68,
-	0xa1, 0x22, 0xb8, 0xc0, 0x89, 0xfc, 0x4c, 0xed, 0x25, 0xb3, 0x92, 0x1c, 0xdf, 0x4b, 0x66, 0xe3,
-	0x72, 0x42, 0x39, 0x82, 0x2b, 0x53, 0xf3, 0x2a, 0x7a, 0x05, 0x72, 0x7e, 0x4a, 0x96, 0xd8, 0x6e,
-	0x2f, 0x69, 0x12, 0xf8, 0xbc, 0xca, 0x9f, 0x24, 0x5f, 0x65, 0xb8, 0xed, 0x50, 0x87, 0xb4, 0x8d,
-	0x9d, 0x61, 0x8f, 0x37, 0x02, 0x4a, 0xdb, 0x2f, 0xce, 0x97, 0x91, 0x29, 0x75, 0xd8, 0x23, 0xaa,
-	0x10, 0x56, 0xde, 0x85, 0x34, 0xa7, 0xa0, 0x3c, 0x64, 0x4e, 0x0e, 0xee, 0x1f, 0x1c, 0xbe, 0x7d,
-	0x20, 0xc7, 0x10, 0x40, 0xba, 0x5a, 0xab, 0xd5, 0x8f, 0x9a, 0xb2, 0x84, 0x72, 0x90, 0xaa, 0xee,
-	0x1c, 0xaa, 0x4d, 0x39, 0x4e, 0xc9, 0x6a, 0x7d, 0xaf, 0x5e, 0x6b, 0xca, 0x09, 0xb4, 0x0c, 0x45,
-	0xfe, 0xac, 0xdd, 0x3b, 0x54, 0xdf, 0xac, 0x36, 0xe5, 0x64, 0x80, 0x74, 0x5c, 0x3f, 0xb8, 0x5b,
-	0x57, 0xe5, 0x94, 0xf2, 0x1d, 0xb8, 0x16, 0x99, 0xc3, 0xfd, 0x9e, 0x82, 0x14, 0xe8, 0x29, 0x28,
-	0x9f, 0xc6, 0x29, 0xa8, 0x89, 0x4a, 0xcc, 0x68, 0x6f, 0x6c, 0xe3, 0xdb, 0x0b, 0x64, 0xf5, 0xb1,
-	0xdd, 0x53, 0x1c, 0x63, 0xe3, 0x0e, 0x26, 0xad, 0x2e, 0x2f, 0x14, 0x78, 0x04, 0x2a, 0xaa, 0x45,
-	0x41, 0x65, 0x42, 0x0e, 0x67, 0x7b, 0x1f, 0xb7, 0x88, 0xc6, 0x9d, 0xc8, 0x61, 0x60, 0x22, 0x47,
-	0xd9, 0x28, 0xf5, 0x98, 0x13, 0x95, 0xf7, 0x16, 0xb2, 0x65, 0x0e, 0x52, 0x6a, 0xbd, 0xa9, 0xbe,
-	0x23, 0x27, 0x10, 0x82, 0x12, 0x7b, 0xd4, 0x8e, 0x0f, 0xaa, 0x47, 0xc7, 0x8d, 0x43, 0x6a, 0xcb,
-	0x15, 0x58, 0x72, 0x6d, 0xe9, 0x12, 0x53, 0xca, 0xf3, 0xf0, 0x58, 0x44, 0x55, 0x31, 0x09, 0xa9,
-	0x94, 0xdf, 0x49, 0x41, 0xee, 0x70, 0x65, 0x70, 0x08, 0x69, 0x87, 0xe8, 0x64, 0xe8, 0x08, 0x23,
-	0xbe, 0x32, 0x6f, 0x99, 0xb1, 0xe9, 0x3e, 0x1c, 0x33, 0x71, 0x55, 0xa8, 0x51, 0x6e, 0x43, 0x29,
-	0xfc, 0x26, 0xda, 0x06, 0xbe, 0x13, 0xc5, 0x95, 0x3b, 0x80, 0x26, 0xab, 0x8f, 0x29, 0xf0, 0x52,
-	0x9a, 0x06, 0x2f, 0x7f, 0x2f, 0xc1, 0xe3, 0x97, 0x54, 0x1a, 0xe8, 0xad, 0xb1, 0x4d, 0xbe, 0xb6,
-	0x48, 0x9d, 0xb2, 0xc9, 0x69, 0x63, 0xdb, 0xbc, 0x05, 0x85, 0x20, 0x7d, 0xbe, 0x4d, 0x7e, 0x13,
-	0xf7, 0x0f, 0x71, 0x18, 0x07, 0xfb, 0x21, 0x50, 0xfa, 0x96, 0x21, 0xf0, 0x75, 0x00, 0x32, 0xd2,
-	0xb8, 0x5b, 0xbb, 0x79, 0xf4, 0x89, 0x29, 0xad, 0x31, 0xdc, 0x6a, 0x8e, 0xc4, 0x21, 0xc8, 0x11,
-	0xf1, 0xe4, 0xa0, 0xe3, 0x60, 0x53, 0x60, 0xc8, 0x72, 0xac, 0x23, 0x00, 0xf3, 0xbc, 0xc9, 0xd8,
-	0x6f, 0x1e, 0x70, 0xb2, 0x83, 0xde, 0x81, 0xc7, 0xc6, 0x0a, 0x05, 0x4f, 0x75, 0x72, 0xde, 0x7a,
-	0xe1, 0x4a, 0xb8, 0x5e, 0x70, 0x55, 0x07, 0xb3, 0x7d, 0x2a, 0x9c, 0xed, 0xdf, 0x01, 0xf0, 0x9b,
-	0x03, 0x34, 0xc2, 0xd8, 0xe6, 0x70, 0xd0, 0x66, 0x1e, 0x90, 0x52, 0xf9, 0x00, 0xdd, 0x86, 0x14,
-	0xf5, 0x24, 0xd7, 0x4e, 0x93, 0xa1, 0x98, 0x7a, 0x42, 0xa0, 0xb9, 0xc0, 0xb9, 0x15, 0x03, 0xd0,
-	0x64, 0x6f, 0x31, 0x62, 0x8a, 0x37, 0xc2, 0x53, 0x3c, 0x19, 0xd9, 0xa5, 0x9c, 0x3e, 0xd5, 0x47,
-	0x90, 0x62, 0x5f, 0x9e, 0x26, 0x5d, 0xd6, 0xd0, 0x16, 0xd5, 0x22, 0x7d, 0x46, 0x3f, 0x01, 0xd0,
-	0x09, 0xb1, 0x8d, 0xd3, 0xa1, 0x3f, 0xc1, 0xfa, 0x74, 0xcf, 0xa9, 0xba, 0x7c, 0x3b, 0xd7, 0x85,
-	0x0b, 0xad, 0xfa, 0xa2, 0x01, 0x37, 0x0a, 0x28, 0x54, 0x0e, 0xa0, 0x14, 0x96, 0x75, 0xeb, 0x1b,
-	0xbe, 0x86, 0x70, 0x7d, 0xc3, 0xcb, 0x55, 0x51, 0xdf, 0x78, 0xd5, 0x51, 0x82, 0x77, 0xed, 0xd9,
-	0x40, 0xf9, 0x69, 0x1c, 0x0a, 0x41, 0xc7, 0xfb, 0xdf, 0x2b, 0x41, 0x94, 0x5f, 0x4a, 0x90, 0xf5,
-	0xb6, 0x1f, 0x6e, 0xe1, 0x87, 0xfe, 0x79, 0x70, 0xeb, 0xc5, 0x83, 0x7d, 0x77, 0xfe, 0x87, 0x23,
-	0xe1, 0xfd, 0xe1, 0xb8, 0xe3, 0xa5, 0xbf, 0xa8, 0x86, 0x48, 0xd0, 0xd6, 0xc2, 0xab, 0xdc, 0x6c,
-	0x7f, 0x07, 0x72, 0xde, 0xe9, 0xa5, 0xa0, 0xc3, 0x6d, 0x1c, 0x49, 0xe2, 0x0c, 0x89, 0xb6, 0xdf,
-	0x2a, 0xa4, 0x2c, 0xf3, 0x43, 0xd1, 0xd4, 0x4f, 0xa8, 0x7c, 0xa0, 0xb4, 0x61, 0x69, 0xec, 0xe8,
-	0xa3, 0x3b, 0x90, 0xb1, 0x86, 0xa7, 0x9a, 0xeb, 0x1c, 0x63, 0xed, 0x35, 0xb7, 0x9c, 0x1d, 0x9e,
-	0xf6, 0x8c, 0xd6, 0x7d, 0x7c, 0xe1, 0x2e, 0xc6, 0x1a, 0x9e, 0xde, 0xe7, 0x3e, 0xc4, 0x67, 0x89,
-	0x07, 0x67, 0xf9, 0xb5, 0x04, 0x59, 0xf7, 0x4c, 0xa0, 0xef, 0x41, 0xce, 0x0b, 0x2b, 0xde, 0x5f,
-	0xb9, 0xc8, 0x78, 0x24, 0xf4, 0xfb, 0x22, 0xa8, 0xea, 0xfe, 0x4e, 0x34, 0xda, 0x5a, 0xa7, 0xa7,
-	0x73, 0x5f, 0x2a, 0x85, 0x6d, 0xc6, 0x03, 0x0f, 0x8b, 0xc7, 0xbb, 0x77, 0xef, 0xf5, 0xf4, 0x33,
-	0x35, 0xcf, 0x64, 0x76, 0xdb, 0x74, 0x20, 0x2a, 0xbb, 0x7f, 0x4a, 0x20, 0x8f, 0x9f, 0xd8, 0x6f,
-	0xbd, 0xba, 0xc9, 0x34, 0x97, 0x98, 0x92, 0xe6, 0xd0, 0x16, 0xac, 0x78, 0x1c, 0x9a, 0x63, 0x9c,
-	0x0d, 0x74, 0x32, 0xb4, 0xb1, 0x68, 0x48, 0x22, 0xef, 0xd5, 0xb1, 0xfb, 0x66, 0x72, 0xd7, 0xa9,
-	0x47, 0xdc, 0xf5, 0xcf, 0xe3, 0x90, 0x0f, 0xb4, 0x47, 0xd1, 0x77, 0x03, 0xc1, 0xa8, 0x34, 0x25,
-	0x33, 0x04, 0x78, 0xfd, 0x3f, 0x6c, 0x61, 0x33, 0xc5, 0x17, 0x37, 0x53, 0x54, 0x13, 0xda, 0xed,
-	0xb6, 0x26, 0x17, 0xee, 0xb6, 0xbe, 0x00, 0x88, 0x98, 0x44, 0xef, 0x69, 0xe7, 0x26, 0x31, 0x06,
-	0x67, 0x1a, 0x77, 0x43, 0x1e, 0x3a, 0x64, 0xf6, 0xe6, 0x01, 0x7b, 0x71, 0xc4, 0x3c, 0xf2, 0x67,
-	0x12, 0x64, 0xbd, 0xb2, 0x7b, 0xd1, 0xff, 0x6f, 0x57, 0x21, 0x2d, 0x2a, 0x4b, 0xfe, 0x03, 0x4e,
-	0x8c, 0xa6, 0xb6, 0x95, 0x2b, 0x90, 0xed, 0x63, 0xa2, 0xb3, 0x38, 0xc8, 0xb3, 0x9a, 0x37, 0xbe,
-	0xf9, 0x1a, 0xe4, 0x03, 0xff, 0x2e, 0x69, 0x68, 0x3c, 0xa8, 0xbf, 0x2d, 0xc7, 0x2a, 0x99, 0x8f,
-	0x3f, 0xdb, 0x48, 0x1c, 0xe0, 0x0f, 0xe9, 0x69, 0x56, 0xeb, 0xb5, 0x46, 0xbd, 0x76, 0x5f, 0x96,
-	0x2a, 0xf9, 0x8f, 0x3f, 0xdb, 0xc8, 0xa8, 0x98, 0x75, 0x14, 0x6f, 0xde, 0x87, 0xa5, 0xb1, 0x0f,
-	0x13, 0x2e, 0x5b, 0x10, 0x94, 0xee, 0x9e, 0x1c, 0xed, 0xef, 0xd6, 0xaa, 0xcd, 0xba, 0xf6, 0xe0,
-	0xb0, 0x59, 0x97, 0x25, 0xf4, 0x18, 0xac, 0xec, 0xef, 0xfe, 0xa0, 0xd1, 0xd4, 0x6a, 0xfb, 0xbb,
-	0xf5, 0x83, 0xa6, 0x56, 0x6d, 0x36, 0xab, 0xb5, 0xfb, 0x72, 0x7c, 0xfb, 0xb3, 0x3c, 0x24, 0xab,
-	0x3b, 0xb5, 0x5d, 0x54, 0x83, 0x24, 0x6b, 0x85, 0x5c, 0x7a, 0x79, 0xa9, 0x72, 0x79, 0x6f, 0x18,
-	0xdd, 0x83, 0x14, 0xeb, 0x92, 0xa0, 0xcb, 0x6f, 0x33, 0x55, 0x66, 0x34, 0x8b, 0xe9, 0x62, 0xd8,
-	0x89, 0xbc, 0xf4, 0x7a, 0x53, 0xe5, 0xf2, 0xde, 0x31, 0xda, 0x87, 0x8c, 0x0b, 0x92, 0x67, 0xdd,
-	0x39, 0xaa, 0xcc, 0x6c, 0xe8, 0xd2, 0xad, 0xf1, 0x66, 0xc3, 0xe5, 0x37, 0x9f, 0x2a, 0x33, 0xba,
-	0xca, 0x68, 0x17, 0xd2, 0x02, 0x8e, 0xce, 0xb8, 0xcc, 0x54, 0x99, 0xd5, 0x27, 0x46, 0x2a, 0xe4,
-	0xfc, 0x36, 0xce, 0xec, 0xfb, 0x5c, 0x95, 0x39, 0x1a, 0xe6, 0xe8, 0x5d, 0x28, 0x86, 0xa1, 0xee,
-	0x7c, 0x17, 0xa6, 0x2a, 0x73, 0x76, 0xa4, 0xa9, 0xfe, 0x30, 0xee, 0x9d, 0xef, 0x02, 0x55, 0x65,
-	0xce, 0x06, 0x35, 0x7a, 0x1f, 0x96, 0x27, 0x71, 0xe9, 0xfc, 0xf7, 0xa9, 0x2a, 0x0b, 0xb4, 0xac,
-	0x51, 0x1f, 0xd0, 0x14, 0x3c, 0xbb, 0xc0, 0xf5, 0xaa, 0xca, 0x22, 0x1d, 0x6c, 0xd4, 0x86, 0xa5,
-	0x71, 0x90, 0x38, 0xef, 0x75, 0xab, 0xca, 0xdc, 0xdd, 0x6c, 0x3e, 0x4b, 0x18, 0x5c, 0xce, 0x7b,
-	0xfd, 0xaa, 0x32, 0x77, 0x73, 0x1b, 0x9d, 0x00, 0x04, 0xf0, 0xe1, 0x1c, 0xd7, 0xb1, 0x2a, 0xf3,
-	0xb4, 0xb9, 0x91, 0x05, 0x2b, 0xd3, 0x80, 0xe3, 0x22, 0xb7, 0xb3, 0x2a, 0x0b, 0x75, 0xbf, 0xa9,
-	0x3f, 0x87, 0x21, 0xe0, 0x7c, 0xb7, 0xb5, 0x2a, 0x73, 0xb6, 0xc1, 0x77, 0xaa, 0x9f, 0x7f, 0xb5,
-	0x26, 0x7d, 0xf1, 0xd5, 0x9a, 0xf4, 0x8f, 0xaf, 0xd6, 0xa4, 0x4f, 0xbe, 0x5e, 0x8b, 0x7d, 0xf1,
-	0xf5, 0x5a, 0xec, 0x6f, 0x5f, 0xaf, 0xc5, 0x7e, 0xf8, 0xec, 0x99, 0x41, 0xba, 0xc3, 0xd3, 0xcd,
-	0x96, 0xd9, 0xdf, 0x6a, 0x99, 0x7d, 0x4c, 0x4e, 0x3b, 0xc4, 0x7f, 0xf0, 0x2f, 0xdd, 0x9e, 0xa6,
-	0x59, 0x06, 0xbd, 0xf5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0xe9, 0xf6, 0xf4, 0x94, 0x2b,
+	0x97, 0x38, 0xc8, 0xd3, 0x01, 0x0c, 0xbd, 0x06, 0x49, 0x1a, 0xcb, 0x45, 0x58, 0xae, 0x6e, 0xf1,
+	0x40, 0xbf, 0xe5, 0x06, 0xfa, 0xad, 0x96, 0x1b, 0xe8, 0x77, 0xb3, 0x5f, 0x7c, 0xb5, 0x11, 0xfb,
+	0xf4, 0x6f, 0x1b, 0x92, 0xca, 0x24, 0xd0, 0x35, 0x1a, 0xb6, 0x74, 0x63, 0xa8, 0x19, 0x1d, 0xb6,
+	0xe4, 0x1c, 0x8d, 0x49, 0xba, 0x31, 0xdc, 0xeb, 0xa0, 0xfb, 0x20, 0xb7, 0xcd, 0xa1, 0x83, 0x87,
+	0xce, 0xc8, 0xd1, 0x78, 0xaa, 0xa9, 0x24, 0x66, 0x43, 0x2a, 0x4f, 0x78, 0x75, 0x97, 0xf3, 0x88,
+	0x31, 0xaa, 0xe5, 0x76, 0x90, 0x80, 0xee, 0x02, 0x78, 0xf9, 0xc8, 0xa9, 0x24, 0x37, 0x13, 0x37,
+	0xf2, 0x3b, 0x9b, 0x33, 0x1f, 0xfc, 0x81, 0xcb, 0x72, 0x62, 0x75, 0x74, 0x82, 0x77, 0x93, 0x74,
+	0xb9, 0xaa, 0x4f, 0x12, 0x3d, 0x03, 0x65, 0xdd, 0xb2, 0x34, 0x87, 0xe8, 0x04, 0x6b, 0xa7, 0x17,
+	0x04, 0x3b, 0x2c, 0xce, 0x17, 0xd4, 0xa2, 0x6e, 0x59, 0xc7, 0x94, 0xba, 0x4b, 0x89, 0xe8, 0x69,
+	0x28, 0xd1, 0x98, 0x6e, 0xe8, 0x7d, 0xad, 0x87, 0x8d, 0xb3, 0x1e, 0x61, 0xf1, 0x3c, 0xa1, 0x16,
+	0x05, 0xb5, 0xc9, 0x88, 0x4a, 0x07, 0x0a, 0xfe, 0x78, 0x8e, 0x10, 0x24, 0x3b, 0x3a, 0xd1, 0x99,
+	0x25, 0x0b, 0x2a, 0x7b, 0xa6, 0x34, 0x4b, 0x27, 0x3d, 0x61, 0x1f, 0xf6, 0x8c, 0xae, 0x42, 0x5a,
+	0xa8, 0x4d, 0x30, 0xb5, 0x62, 0x84, 0xd6, 0x20, 0x65, 0xd9, 0xe6, 0x39, 0x66, 0x9f, 0x2e, 0xab,
+	0xf2, 0x81, 0xa2, 0x42, 0x29, 0x18, 0xfb, 0x51, 0x09, 0xe2, 0x64, 0x2c, 0x66, 0x89, 0x93, 0x31,
+	0x7a, 0x09, 0x92, 0xd4, 0x90, 0x6c, 0x8e, 0x52, 0x48, 0xb6, 0x13, 0x72, 0xad, 0x0b, 0x0b, 0xab,
+	0x8c, 0x53, 0x29, 0x43, 0x31, 0x90, 0x13, 0x94, 0xab, 0xb0, 0x16, 0x16, 0xe2, 0x95, 0x1e, 0xac,
+	0x85, 0x85, 0x6a, 0xf4, 0x32, 0x64, 0xbd, 0x18, 0xcf, 0x1d, 0xe7, 0xda, 0xcc, 0xb4, 0x2e, 0xb3,
+	0xea, 0xb1, 0x52, 0x8f, 0xa1, 0x1f, 0xa0, 0xa7, 0x8b, 0x8c, 0x5e, 0x50, 0x33, 0xba, 0x65, 0x35,
+	0x75, 0xa7, 0xa7, 0xbc, 0x0f, 0x95, 0xa8, 0xf8, 0xed, 0x33, 0x98, 0xc4, 0xdc, 0x5e, 0x8c, 0x28,
+	0xbd, 0x6b, 0xda, 0x03, 0x9d, 0x30, 0x65, 0x45, 0x55, 0x8c, 0xa8, 0x21, 0x79, 0x2c, 0x4f, 0x30,
+	0x32, 0x1f, 0x28, 0x1a, 0x5c, 0x8b, 0x8c, 0xe1, 0x54, 0xc4, 0x18, 0x76, 0x30, 0x37, 0x6b, 0x51,
+	0xe5, 0x83, 0x89, 0x22, 0xbe, 0x58, 0x3e, 0xa0, 0xd3, 0x3a, 0x6c, 0xaf, 0x4c, 0x7f, 0x4e, 0x15,
+	0x23, 0xe5, 0xb3, 0x04, 0x5c, 0x0d, 0x8f, 0xe4, 0x68, 0x13, 0x0a, 0x03, 0x7d, 0xac, 0x91, 0xb1,
+	0x70, 0x3b, 0x89, 0x7d, 0x78, 0x18, 0xe8, 0xe3, 0xd6, 0x98, 0xfb, 0x9c, 0x0c, 0x09, 0x32, 0x76,
+	0x2a, 0xf1, 0xcd, 0xc4, 0x8d, 0x82, 0x4a, 0x1f, 0xd1, 0x09, 0xac, 0xf4, 0xcd, 0xb6, 0xde, 0xd7,
+	0xfa, 0xba, 0x43, 0x34, 0x91, 0xe2, 0xf9, 0x21, 0x7a, 0x6a, 0xc6, 0xd8, 0x3c, 0x26, 0xe3, 0x0e,
+	0xff, 0x9e, 0x34, 0xe0, 0x08, 0xff, 0x2f, 0x33, 0x1d, 0xf7, 0x75, 0xf7, 0x53, 0xa3, 0x3b, 0x90,
+	0x1f, 0x18, 0xce, 0x29, 0xee, 0xe9, 0xe7, 0x86, 0x69, 0x8b, 0xd3, 0x34, 0xeb, 0x34, 0x6f, 0x4d,
+	0x78, 0x84, 0x26, 0xbf, 0x98, 0xef, 0x93, 0xa4, 0x02, 0x3e, 0xec, 0x46, 0x93, 0xf4, 0xd2, 0xd1,
+	0xe4, 0x25, 0x58, 0x1b, 0xe2, 0x31, 0xd1, 0x26, 0xe7, 0x95, 0xfb, 0x49, 0x86, 0x99, 0x1e, 0xd1,
+	0x77, 0xde, 0x09, 0x77, 0xa8, 0xcb, 0xa0, 0xe7, 0x58, 0x2e, 0xb4, 0x4c, 0x07, 0xdb, 0x9a, 0xde,
+	0xe9, 0xd8, 0xd8, 0x71, 0x58, 0xf9, 0x54, 0x50, 0xcb, 0x2e, 0xbd, 0xc6, 0xc9, 0xca, 0xaf, 0xfc,
+	0x9f, 0x26, 0x98, 0xfb, 0x84, 0xe1, 0xa5, 0x89, 0xe1, 0x8f, 0x61, 0x4d, 0xc8, 0x77, 0x02, 0xb6,
+	0xe7, 0x35, 0xe8, 0xe3, 0xb3, 0xe7, 0x6b, 0xda, 0xe6, 0xc8, 0x15, 0x8f, 0x36, 0x7b, 0xe2, 0xd1,
+	0xcc, 0x8e, 0x20, 0xc9, 0x8c, 0x92, 0xe4, 0x21, 0x86, 0x3e, 0xff, 0xa7, 0x7d, 0x8a, 0xef, 0xc3,
+	0xca, 0x4c, 0x1d, 0xe1, 0xed, 0x4b, 0x0a, 0xdd, 0x57, 0xdc, 0xbf, 0x2f, 0xe5, 0xb7, 0x12, 0x54,
+	0xa3, 0x0b, 0x87, 0x50, 0x55, 0xcf, 0xc3, 0x8a, 0xb7, 0x17, 0x6f, 0x7d, 0xfc, 0x4c, 0xcb, 0xde,
+	0x0b, 0xb1, 0xc0, 0xc8, 0xf0, 0xfc, 0x34, 0x94, 0xa6, 0xca, 0x1a, 0xfe, 0x15, 0x8a, 0xe7, 0xfe,
+	0xf9, 0x95, 0x5f, 0x24, 0x60, 0x2d, 0xac, 0xf6, 0x08, 0x71, 0xb4, 0xb7, 0x61, 0xb5, 0x83, 0xdb,
+	0x46, 0xe7, 0x51, 0xfd, 0x6c, 0x45, 0x48, 0xff, 0xcf, 0xcd, 0x66, 0xdd, 0xec, 0x37, 0x00, 0x59,
+	0x15, 0x3b, 0x96, 0x39, 0x74, 0x30, 0xda, 0x85, 0x1c, 0x1e, 0xb7, 0xb1, 0x45, 0xdc, 0xea, 0x2b,
+	0xbc, 0xba, 0xe5, 0xdc, 0x0d, 0x97, 0x93, 0x62, 0x3b, 0x4f, 0x0c, 0xdd, 0x12, 0xf0, 0x35, 0x1a,
+	0x89, 0x0a, 0x71, 0x3f, 0x7e, 0x7d, 0xc5, 0xc5, 0xaf, 0x89, 0x48, 0x68, 0xc6, 0xa5, 0xa6, 0x00,
+	0xec, 0x2d, 0x01, 0x60, 0x93, 0x73, 0x26, 0x0b, 0x20, 0xd8, 0x7a, 0x00, 0xc1, 0xa6, 0xe7, 0x6c,
+	0x33, 0x02, 0xc2, 0xbe, 0xe2, 0x42, 0xd8, 0xcc, 0x9c, 0x15, 0x4f, 0x61, 0xd8, 0x37, 0x7d, 0x18,
+	0x36, 0xb7, 0x29, 0x85, 0x56, 0x68, 0xae, 0x68, 0x08, 0x88, 0x7d, 0xdd, 0x03, 0xb1, 0x85, 0x48,
+	0x00, 0x2c, 0x84, 0xa7, 0x51, 0xec, 0xe1, 0x0c, 0x8a, 0xe5, 0xa8, 0xf3, 0x99, 0x48, 0x15, 0x73,
+	0x60, 0xec, 0xe1, 0x0c, 0x8c, 0x2d, 0xcd, 0x51, 0x38, 0x07, 0xc7, 0xfe, 0x38, 0x1c, 0xc7, 0x46,
+	0x23, 0x4d, 0xb1, 0xcc, 0xc5, 0x80, 0xac, 0x16, 0x01, 0x64, 0xe5, 0x48, 0xd0, 0xc5, 0xd5, 0x2f,
+	0x8c, 0x64, 0x4f, 0x42, 0x90, 0x2c, 0xc7, 0x9c, 0x37, 0x22, 0x95, 0x2f, 0x00, 0x65, 0x4f, 0x42,
+	0xa0, 0x2c, 0x9a, 0xab, 0x76, 0x2e, 0x96, 0xbd, 0x1b, 0xc4, 0xb2, 0xab, 0x11, 0x05, 0xd3, 0xe4,
+	0xb4, 0x47, 0x80, 0xd9, 0xd3, 0x28, 0x30, 0xcb, 0x01, 0xe7, 0x0b, 0x91, 0x1a, 0x97, 0x40, 0xb3,
+	0x87, 0x33, 0x68, 0xf6, 0xca, 0x1c, 0x4f, 0x5b, 0x1c, 0xce, 0xa6, 0xe4, 0xf4, 0x7e, 0x32, 0x9b,
+	0x95, 0x73, 0x1c, 0xc8, 0xee, 0x27, 0xb3, 0x79, 0xb9, 0xa0, 0x3c, 0x07, 0x2b, 0xae, 0x2a, 0x2f,
+	0xce, 0xd1, 0x32, 0x17, 0xdb, 0xb6, 0x69, 0x0b, 0x60, 0xca, 0x07, 0xca, 0x0d, 0x28, 0x78, 0xac,
+	0x97, 0x43, 0x5f, 0x06, 0x27, 0x7c, 0x71, 0x4c, 0xf9, 0x83, 0x04, 0x05, 0x7f, 0x88, 0x0a, 0x40,
+	0xa3, 0x9c, 0x80, 0x46, 0x3e, 0x40, 0x1c, 0x0f, 0x02, 0xe2, 0x0d, 0xc8, 0x53, 0x98, 0x30, 0x85,
+	0x75, 0x75, 0xcb, 0xc3, 0xba, 0x37, 0x61, 0x85, 0x25, 0x4c, 0x0e, 0x9b, 0x45, 0x5a, 0x4a, 0xb2,
+	0xb4, 0x54, 0xa6, 0x2f, 0xb8, 0x75, 0x18, 0x19, 0xbd, 0x08, 0xab, 0x3e, 0x5e, 0x0f, 0x7e, 0x70,
+	0xe0, 0x27, 0x7b, 0xdc, 0x35, 0x81, 0x43, 0xfe, 0x2c, 0xc1, 0xca, 0x4c, 0x88, 0x0c, 0xc5, 0xb3,
+	0xd2, 0xbf, 0x09, 0xcf, 0xc6, 0x1f, 0x19, 0xcf, 0xfa, 0xe1, 0x54, 0x22, 0x08, 0xa7, 0xfe, 0x29,
+	0x41, 0x31, 0x10, 0xa9, 0xe9, 0x27, 0x68, 0x9b, 0x1d, 0x2c, 0x00, 0x0e, 0x7b, 0xa6, 0x25, 0x49,
+	0xdf, 0x3c, 0x13, 0x30, 0x86, 0x3e, 0x52, 0x2e, 0x2f, 0xf1, 0xe4, 0x44, 0x5e, 0xf1, 0xb0, 0x11,
+	0x4f, 0xfc, 0x7c, 0x40, 0x65, 0x1f, 0x62, 0xde, 0xe9, 0x2c, 0xa8, 0xf4, 0x11, 0xad, 0x09, 0xe7,
+	0x13, 0x09, 0x9c, 0x0f, 0xd0, 0x6b, 0x90, 0x63, 0x7d, 0x6a, 0xcd, 0xb4, 0x9c, 0x4a, 0x76, 0xb6,
+	0xb4, 0xe1, 0xcd, 0xea, 0xad, 0x23, 0xca, 0x73, 0x68, 0x39, 0x6a, 0xd6, 0x12, 0x4f, 0xbe, 0x8a,
+	0x23, 0x17, 0xa8, 0x38, 0xae, 0x43, 0x8e, 0xae, 0xde, 0xb1, 0xf4, 0x36, 0xae, 0x00, 0x5b, 0xe8,
+	0x84, 0x40, 0x9b, 0x1c, 0xe5, 0xa9, 0x44, 0x13, 0xba, 0x77, 0xd7, 0x25, 0xe3, 0x3e, 0xb4, 0xbe,
+	0x98, 0x3d, 0xd6, 0x01, 0xce, 0x74, 0x47, 0xfb, 0x48, 0x1f, 0x12, 0xdc, 0x11, 0x46, 0xf1, 0x51,
+	0x50, 0x15, 0xb2, 0x74, 0x34, 0x72, 0x70, 0x47, 0x34, 0x0e, 0xbc, 0x31, 0x6a, 0x42, 0x1a, 0x9f,
+	0xe3, 0x21, 0x71, 0x2a, 0x19, 0xf6, 0xd9, 0xaf, 0xce, 0x22, 0x39, 0xfa, 0x7a, 0xb7, 0x42, 0x3f,
+	0xf6, 0xb7, 0x5f, 0x6d, 0xc8, 0x9c, 0xfb, 0x05, 0x73, 0x60, 0x10, 0x3c, 0xb0, 0xc8, 0x85, 0x2a,
+	0xe4, 0x83, 0x56, 0xc8, 0x4e, 0x59, 0xc1, 0x87, 0x51, 0x73, 0x7e, 0x8c, 0x4a, 0xd7, 0x66, 0xd9,
+	0x86, 0x69, 0x1b, 0xe4, 0x82, 0x99, 0x2e, 0xa1, 0x7a, 0x63, 0x1e, 0x21, 0xd4, 0xe2, 0x00, 0x0f,
+	0x2c, 0xd3, 0xec, 0x6b, 0x3c, 0x0a, 0xd4, 0xa0, 0xe4, 0x59, 0x93, 0xe7, 0xdb, 0xa7, 0xa0, 0x68,
+	0x63, 0x42, 0xfb, 0x3e, 0x81, 0x32, 0xb9, 0xc0, 0x89, 0xfc, 0xd4, 0xed, 0x27, 0xb3, 0x92, 0x1c,
+	0xdf, 0x4f, 0x66, 0xe3, 0x72, 0x42, 0x39, 0x82, 0x2b, 0xa1, 0x99, 0x17, 0xbd, 0x0a, 0xb9, 0x49,
+	0xd2, 0x96, 0x36, 0x13, 0x97, 0xb7, 0x11, 0x26, 0xbc, 0xca, 0x1f, 0x25, 0xb8, 0x12, 0x9a, 0x7b,
+	0x51, 0x03, 0xd2, 0x36, 0x76, 0x46, 0x7d, 0xde, 0x2a, 0x28, 0xed, 0xbc, 0xb8, 0x58, 0xce, 0xa6,
+	0xd4, 0x51, 0x9f, 0xa8, 0x42, 0x58, 0x79, 0x0f, 0xd2, 0x9c, 0x82, 0xf2, 0x90, 0x39, 0x39, 0xb8,
+	0x77, 0x70, 0xf8, 0xce, 0x81, 0x1c, 0x43, 0x00, 0xe9, 0x5a, 0xbd, 0xde, 0x38, 0x6a, 0xc9, 0x12,
+	0xca, 0x41, 0xaa, 0xb6, 0x7b, 0xa8, 0xb6, 0xe4, 0x38, 0x25, 0xab, 0x8d, 0xfd, 0x46, 0xbd, 0x25,
+	0x27, 0xd0, 0x0a, 0x14, 0xf9, 0xb3, 0x76, 0xf7, 0x50, 0x7d, 0xab, 0xd6, 0x92, 0x93, 0x3e, 0xd2,
+	0x71, 0xe3, 0xe0, 0x4e, 0x43, 0x95, 0x53, 0xca, 0xff, 0xc1, 0x35, 0x77, 0x1d, 0xb3, 0xed, 0x0e,
+	0xaf, 0xeb, 0x20, 0xf9, 0xba, 0x0e, 0xca, 0x67, 0x71, 0xa8, 0xba, 0x32, 0x21, 0x0d, 0x8c, 0xfd,
+	0xa9, 0x8d, 0xef, 0x2c, 0x91, 0xf7, 0xa7, 0x76, 0x4f, 0x91, 0x8e, 0x8d, 0xbb, 0x98, 0xb4, 0x7b,
+	0xbc, 0x94, 0xe0, 0x31, 0xaa, 0xa8, 0x16, 0x05, 0x95, 0x09, 0x39, 0x9c, 0xed, 0x03, 0xdc, 0x26,
+	0x1a, 0x77, 0x2e, 0x87, 0xc1, 0x8d, 0x9c, 0x5a, 0xe4, 0xd4, 0x63, 0x4e, 0x54, 0xde, 0x5f, 0xca,
+	0x96, 0x39, 0x48, 0xa9, 0x8d, 0x96, 0xfa, 0xae, 0x9c, 0x40, 0x08, 0x4a, 0xec, 0x51, 0x3b, 0x3e,
+	0xa8, 0x1d, 0x1d, 0x37, 0x0f, 0xa9, 0x2d, 0x57, 0xa1, 0xec, 0xda, 0xd2, 0x25, 0xa6, 0x94, 0xe7,
+	0xe1, 0xb1, 0x88, 0xba, 0x63, 0x16, 0x74, 0x29, 0xbf, 0x93, 0xfc, 0xdc, 0xc1, 0xda, 0xe1, 0x10,
+	0xd2, 0x0e, 0xd1, 0xc9, 0xc8, 0x11, 0x46, 0x7c, 0x75, 0xd1, 0x42, 0x64, 0xcb, 0x7d, 0x38, 0x66,
+	0xe2, 0xaa, 0x50, 0xa3, 0xbc, 0x0c, 0xa5, 0xe0, 0x9b, 0x68, 0x1b, 0x4c, 0x9c, 0x28, 0xae, 0xdc,
+	0x06, 0x34, 0x5b, 0x9f, 0x84, 0x00, 0x50, 0x29, 0x0c, 0x80, 0xfe, 0x5e, 0x82, 0xc7, 0x2f, 0xa9,
+	0x45, 0xd0, 0xdb, 0x53, 0x9b, 0x7c, 0x7d, 0x99, 0x4a, 0x66, 0x8b, 0xd3, 0xa6, 0xb6, 0x79, 0x0b,
+	0x0a, 0x7e, 0xfa, 0x62, 0x9b, 0xfc, 0x36, 0x0e, 0x57, 0x42, 0xcb, 0x1a, 0x5f, 0x90, 0x94, 0xbe,
+	0x63, 0x90, 0x7c, 0x03, 0x80, 0x8c, 0x35, 0xee, 0xd6, 0x6e, 0xa6, 0x9d, 0x45, 0x53, 0x8d, 0x31,
+	0x6e, 0xb7, 0xc6, 0xe2, 0x10, 0xe4, 0x88, 0x78, 0xa2, 0x8d, 0x20, 0x5f, 0xdb, 0x60, 0xc4, 0xb2,
+	0xb0, 0x53, 0x49, 0x2c, 0x95, 0xae, 0xe5, 0xf3, 0x20, 0xd9, 0x41, 0xef, 0xc2, 0x63, 0x53, 0xa5,
+	0x84, 0xa7, 0x3a, 0xb9, 0x68, 0x45, 0x71, 0x25, 0x58, 0x51, 0xb8, 0xaa, 0xfd, 0xf5, 0x40, 0x2a,
+	0x58, 0x0f, 0xbc, 0x0b, 0x30, 0x69, 0x1f, 0xd0, 0x08, 0x63, 0x9b, 0xa3, 0x61, 0x87, 0x79, 0x40,
+	0x4a, 0xe5, 0x03, 0xfa, 0xf7, 0x92, 0x7a, 0x92, 0x6b, 0xa7, 0xd9, 0x50, 0x4c, 0x3d, 0xc1, 0xd7,
+	0x7e, 0xe0, 0xdc, 0x8a, 0x01, 0x68, 0xb6, 0xfb, 0x18, 0x31, 0xc5, 0x9b, 0xc1, 0x29, 0x9e, 0x8c,
+	0xec, 0x63, 0x86, 0x4f, 0xf5, 0x31, 0xa4, 0xd8, 0x97, 0xa7, 0x69, 0x99, 0xb5, 0xbc, 0x45, 0x3d,
+	0x49, 0x9f, 0xd1, 0x4f, 0x00, 0x74, 0x42, 0x6c, 0xe3, 0x74, 0x34, 0x99, 0x60, 0x23, 0xdc, 0x73,
+	0x6a, 0x2e, 0xdf, 0xee, 0x75, 0xe1, 0x42, 0x6b, 0x13, 0x51, 0x9f, 0x1b, 0xf9, 0x14, 0x2a, 0x07,
+	0x50, 0x0a, 0xca, 0xba, 0x15, 0x10, 0x5f, 0x43, 0xb0, 0x02, 0xe2, 0x05, 0x2d, 0x1f, 0x4c, 0xea,
+	0xa7, 0x04, 0xef, 0xeb, 0xb3, 0x81, 0xf2, 0xd3, 0x38, 0x14, 0xfc, 0x8e, 0xf7, 0xdf, 0x57, 0xa4,
+	0x28, 0xbf, 0x94, 0x20, 0xeb, 0x6d, 0x3f, 0xd8, 0xe4, 0x0f, 0xfc, 0x15, 0xe1, 0xd6, 0x8b, 0xfb,
+	0x3b, 0xf3, 0xfc, 0x1f, 0x48, 0xc2, 0xfb, 0x07, 0x72, 0xdb, 0x4b, 0x7f, 0x51, 0x2d, 0x13, 0xbf,
+	0xad, 0x85, 0x57, 0xb9, 0xd9, 0xfe, 0x36, 0xe4, 0xbc, 0xd3, 0x4b, 0x61, 0x89, 0xdb, 0x5a, 0x92,
+	0xc4, 0x19, 0xe2, 0x43, 0xba, 0x12, 0xcb, 0xfc, 0x48, 0xb4, 0xfd, 0x13, 0x2a, 0x1f, 0x28, 0x1d,
+	0x28, 0x4f, 0x1d, 0x7d, 0x74, 0x1b, 0x32, 0xd6, 0xe8, 0x54, 0x73, 0x9d, 0x63, 0xaa, 0x01, 0xe7,
+	0x16, 0xbc, 0xa3, 0xd3, 0xbe, 0xd1, 0xbe, 0x87, 0x2f, 0xdc, 0xc5, 0x58, 0xa3, 0xd3, 0x7b, 0xdc,
+	0x87, 0xf8, 0x2c, 0x71, 0xff, 0x2c, 0xbf, 0x96, 0x20, 0xeb, 0x9e, 0x09, 0xf4, 0x3d, 0xc8, 0x79,
+	0x61, 0xc5, 0xfb, 0x6f, 0x17, 0x19, 0x8f, 0x84, 0xfe, 0x89, 0x08, 0xaa, 0xb9, 0x3f, 0x1c, 0x8d,
+	0x8e, 0xd6, 0xed, 0xeb, 0xdc, 0x97, 0x4a, 0x41, 0x9b, 0xf1, 0xc0, 0xc3, 0xe2, 0xf1, 0xde, 0x9d,
+	0xbb, 0x7d, 0xfd, 0x4c, 0xcd, 0x33, 0x99, 0xbd, 0x0e, 0x1d, 0x88, 0xca, 0xee, 0x1f, 0x12, 0xc8,
+	0xd3, 0x27, 0xf6, 0x3b, 0xaf, 0x6e, 0x36, 0xcd, 0x25, 0x42, 0xd2, 0x1c, 0xda, 0x86, 0x55, 0x8f,
+	0x43, 0x73, 0x8c, 0xb3, 0xa1, 0x4e, 0x46, 0x36, 0x16, 0x2d, 0x4b, 0xe4, 0xbd, 0x3a, 0x76, 0xdf,
+	0xcc, 0xee, 0x3a, 0xf5, 0x88, 0xbb, 0xfe, 0x79, 0x1c, 0xf2, 0xbe, 0x06, 0x2a, 0xfa, 0x7f, 0x5f,
+	0x30, 0x2a, 0x85, 0x64, 0x06, 0x1f, 0xef, 0xe4, 0x1f, 0x5c, 0xd0, 0x4c, 0xf1, 0xe5, 0xcd, 0x14,
+	0xd5, 0xa6, 0x76, 0xfb, 0xb1, 0xc9, 0xa5, 0xfb, 0xb1, 0x2f, 0x00, 0x22, 0x26, 0xd1, 0xfb, 0xb4,
+	0xe1, 0x61, 0x0c, 0xcf, 0x34, 0xee, 0x86, 0x3c, 0x74, 0xc8, 0xec, 0xcd, 0x03, 0xf6, 0xe2, 0x88,
+	0x79, 0xe4, 0xcf, 0x24, 0xc8, 0x7a, 0x65, 0xf7, 0xb2, 0x7f, 0xe8, 0xae, 0x42, 0x5a, 0x54, 0x96,
+	0xfc, 0x17, 0x9d, 0x18, 0x85, 0x36, 0x9e, 0xab, 0x90, 0x1d, 0x60, 0xa2, 0xb3, 0x38, 0xc8, 0xb3,
+	0x9a, 0x37, 0xbe, 0xf9, 0x3a, 0xe4, 0x7d, 0x7f, 0x37, 0x69, 0x68, 0x3c, 0x68, 0xbc, 0x23, 0xc7,
+	0xaa, 0x99, 0x4f, 0x3e, 0xdf, 0x4c, 0x1c, 0xe0, 0x8f, 0xe8, 0x69, 0x56, 0x1b, 0xf5, 0x66, 0xa3,
+	0x7e, 0x4f, 0x96, 0xaa, 0xf9, 0x4f, 0x3e, 0xdf, 0xcc, 0xa8, 0x98, 0xf5, 0x1c, 0x6f, 0xde, 0x83,
+	0xf2, 0xd4, 0x87, 0x09, 0x96, 0x2d, 0x08, 0x4a, 0x77, 0x4e, 0x8e, 0xee, 0xef, 0xd5, 0x6b, 0xad,
+	0x86, 0xf6, 0xe0, 0xb0, 0xd5, 0x90, 0x25, 0xf4, 0x18, 0xac, 0xde, 0xdf, 0xfb, 0x41, 0xb3, 0xa5,
+	0xd5, 0xef, 0xef, 0x35, 0x0e, 0x5a, 0x5a, 0xad, 0xd5, 0xaa, 0xd5, 0xef, 0xc9, 0xf1, 0x9d, 0xcf,
+	0xf3, 0x90, 0xac, 0xed, 0xd6, 0xf7, 0x50, 0x1d, 0x92, 0xac, 0x59, 0x72, 0xe9, 0xf5, 0xa6, 0xea,
+	0xe5, 0xdd, 0x63, 0x74, 0x17, 0x52, 0xac, 0x8f, 0x82, 0x2e, 0xbf, 0xef, 0x54, 0x9d, 0xd3, 0x4e,
+	0xa6, 0x8b, 0x61, 0x27, 0xf2, 0xd2, 0x0b, 0x50, 0xd5, 0xcb, 0xbb, 0xcb, 0xe8, 0x3e, 0x64, 0x5c,
+	0x18, 0x3d, 0xef, 0x56, 0x52, 0x75, 0x6e, 0xcb, 0x97, 0x6e, 0x8d, 0xb7, 0x23, 0x2e, 0xbf, 0x1b,
+	0x55, 0x9d, 0xd3, 0x77, 0x46, 0x7b, 0x90, 0x16, 0x70, 0x74, 0xce, 0x75, 0xa7, 0xea, 0xbc, 0x4e,
+	0x32, 0x52, 0x21, 0x37, 0x69, 0xf4, 0xcc, 0xbf, 0xf1, 0x55, 0x5d, 0xa0, 0xa5, 0x8e, 0xde, 0x83,
+	0x62, 0x10, 0xea, 0x2e, 0x76, 0xa5, 0xaa, 0xba, 0x60, 0xcf, 0x9a, 0xea, 0x0f, 0xe2, 0xde, 0xc5,
+	0xae, 0x58, 0x55, 0x17, 0x6c, 0x61, 0xa3, 0x0f, 0x60, 0x65, 0x16, 0x97, 0x2e, 0x7e, 0xe3, 0xaa,
+	0xba, 0x44, 0x53, 0x1b, 0x0d, 0x00, 0x85, 0xe0, 0xd9, 0x25, 0x2e, 0x60, 0x55, 0x97, 0xe9, 0x71,
+	0xa3, 0x0e, 0x94, 0xa7, 0x41, 0xe2, 0xa2, 0x17, 0xb2, 0xaa, 0x0b, 0xf7, 0xbb, 0xf9, 0x2c, 0x41,
+	0x70, 0xb9, 0xe8, 0x05, 0xad, 0xea, 0xc2, 0xed, 0x6f, 0x74, 0x02, 0xe0, 0xc3, 0x87, 0x0b, 0x5c,
+	0xd8, 0xaa, 0x2e, 0xd2, 0x08, 0x47, 0x16, 0xac, 0x86, 0x01, 0xc7, 0x65, 0xee, 0x6f, 0x55, 0x97,
+	0xea, 0x8f, 0x53, 0x7f, 0x0e, 0x42, 0xc0, 0xc5, 0xee, 0x73, 0x55, 0x17, 0x6c, 0x94, 0xef, 0xd6,
+	0xbe, 0xf8, 0x7a, 0x5d, 0xfa, 0xf2, 0xeb, 0x75, 0xe9, 0xef, 0x5f, 0xaf, 0x4b, 0x9f, 0x7e, 0xb3,
+	0x1e, 0xfb, 0xf2, 0x9b, 0xf5, 0xd8, 0x5f, 0xbf, 0x59, 0x8f, 0xfd, 0xf0, 0xd9, 0x33, 0x83, 0xf4,
+	0x46, 0xa7, 0x5b, 0x6d, 0x73, 0xb0, 0xdd, 0x36, 0x07, 0x98, 0x9c, 0x76, 0xc9, 0xe4, 0x61, 0x72,
+	0x2d, 0xf7, 0x34, 0xcd, 0x32, 0xe8, 0xad, 0x7f, 0x0d, 0x00, 0xc1, 0xd3, 0x74, 0xcd, 0xb6, 0x2b,
 	0x00, 0x00,
 }
 
@@ -6294,6 +6316,18 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -8253,6 +8287,13 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -13452,6 +13493,57 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
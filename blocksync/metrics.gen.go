@@ -44,6 +44,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "latest_block_height",
 			Help:      "The height of the latest block.",
 		}, labels).With(labelsAndValues...),
+		VerificationLag: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verification_lag",
+			Help:      "Number of blocks between the highest block whose commit was verified and the highest block of the peers.",
+		}, labels).With(labelsAndValues...),
+		ExecutionLag: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "execution_lag",
+			Help:      "Number of blocks between the latest executed block and the highest block of the peers.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -54,5 +66,7 @@ func NopMetrics() *Metrics {
 		TotalTxs:          discard.NewGauge(),
 		BlockSizeBytes:    discard.NewGauge(),
 		LatestBlockHeight: discard.NewGauge(),
+		VerificationLag:   discard.NewGauge(),
+		ExecutionLag:      discard.NewGauge(),
 	}
 }
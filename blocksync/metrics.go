@@ -26,6 +26,12 @@ type Metrics struct {
 	BlockSizeBytes metrics.Gauge
 	// The height of the latest block.
 	LatestBlockHeight metrics.Gauge
+	// Number of blocks between the highest block whose commit was verified
+	// and the highest block of the peers.
+	VerificationLag metrics.Gauge
+	// Number of blocks between the latest executed block and the highest block
+	// of the peers.
+	ExecutionLag metrics.Gauge
 }
 
 func (m *Metrics) recordBlockMetrics(block *types.Block) {
@@ -207,6 +207,17 @@ func (pool *BlockPool) PeekTwoBlocks() (first, second *types.Block, firstExtComm
 	return
 }
 
+// PeekBlock returns the block at the given height if it was received, or nil.
+func (pool *BlockPool) PeekBlock(height int64) *types.Block {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	if r := pool.requesters[height]; r != nil {
+		return r.getBlock()
+	}
+	return nil
+}
+
 // PopRequest pops the first block at pool.height.
 // It must have been validated by the second Commit from PeekTwoBlocks.
 // TODO(thane): (?) and its corresponding ExtendedCommit.
@@ -3,13 +3,13 @@ package blocksync
 import (
 	"fmt"
 	"reflect"
+	"runtime"
 	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
 	bcproto "github.com/cometbft/cometbft/proto/tendermint/blocksync"
 	sm "github.com/cometbft/cometbft/state"
-	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -27,6 +27,9 @@ const (
 	statusUpdateIntervalSeconds = 10
 	// check if we should switch to consensus reactor
 	switchToConsensusIntervalSeconds = 1
+
+	// verify the commits of up to this many blocks ahead of their execution
+	defaultVerificationWindow = 32
 )
 
 type consensusReactor interface {
@@ -61,12 +64,18 @@ type Reactor struct {
 
 	switchToConsensusMs int
 
+	verificationWorkers int
+	verificationWindow  int
+
 	metrics *Metrics
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
 // NewReactor returns new reactor instance.
-func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockStore,
-	blockSync bool, metrics *Metrics,
+func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store sm.BlockStore,
+	blockSync bool, metrics *Metrics, options ...ReactorOption,
 ) *Reactor {
 	if state.LastBlockHeight != store.Height() {
 		panic(fmt.Sprintf("state (%v) and store (%v) height mismatch", state.LastBlockHeight,
@@ -85,19 +94,46 @@ func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockS
 	pool := NewBlockPool(startHeight, requestsCh, errorsCh)
 
 	bcR := &Reactor{
-		initialState: state,
-		blockExec:    blockExec,
-		store:        store,
-		pool:         pool,
-		blockSync:    blockSync,
-		requestsCh:   requestsCh,
-		errorsCh:     errorsCh,
-		metrics:      metrics,
+		initialState:        state,
+		blockExec:           blockExec,
+		store:               store,
+		pool:                pool,
+		blockSync:           blockSync,
+		requestsCh:          requestsCh,
+		errorsCh:            errorsCh,
+		verificationWorkers: runtime.NumCPU(),
+		verificationWindow:  defaultVerificationWindow,
+		metrics:             metrics,
 	}
 	bcR.BaseReactor = *p2p.NewBaseReactor("Reactor", bcR)
+
+	for _, option := range options {
+		option(bcR)
+	}
 	return bcR
 }
 
+// VerificationWorkers sets the number of goroutines verifying the commits of
+// the blocks ahead of their execution. If 0, it defaults to the number of
+// CPUs.
+func VerificationWorkers(workers int) ReactorOption {
+	return func(bcR *Reactor) {
+		if workers > 0 {
+			bcR.verificationWorkers = workers
+		}
+	}
+}
+
+// VerificationWindow sets the maximum number of blocks whose commits are
+// verified ahead of their execution.
+func VerificationWindow(window int) ReactorOption {
+	return func(bcR *Reactor) {
+		if window > 0 {
+			bcR.verificationWindow = window
+		}
+	}
+}
+
 // SetLogger implements service.Service by setting the logger on reactor and pool.
 func (bcR *Reactor) SetLogger(l log.Logger) {
 	bcR.BaseService.Logger = l
@@ -297,6 +333,12 @@ func (bcR *Reactor) poolRoutine(stateSynced bool) {
 
 	initialCommitHasExtensions := (bcR.initialState.LastBlockHeight > 0 && bcR.store.LoadBlockExtendedCommit(bcR.initialState.LastBlockHeight) != nil)
 
+	// verify the commits of the next blocks while the blocks are executed
+	verifierQuit := make(chan struct{})
+	defer close(verifierQuit)
+	verifier := newBlockVerifier(chainID, bcR.verificationWindow)
+	verifier.start(bcR.verificationWorkers, verifierQuit)
+
 	go func() {
 		for {
 			select {
@@ -406,6 +448,13 @@ FOR_LOOP:
 			// coupling them as it's written here.  TODO uncouple from request
 			// routine.
 
+			nextHeight := state.LastBlockHeight + 1
+			if state.LastBlockHeight == 0 {
+				nextHeight = state.InitialHeight
+			}
+			verifier.schedule(bcR.pool, nextHeight, state)
+			bcR.recordLagMetrics(state, verifier)
+
 			// See if there are any blocks to sync.
 			first, second, extCommit := bcR.pool.PeekTwoBlocks()
 			if first == nil || second == nil {
@@ -430,22 +479,14 @@ FOR_LOOP:
 			// Try again quickly next loop.
 			didProcessCh <- struct{}{}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
-			if err != nil {
-				bcR.Logger.Error("failed to make ",
-					"height", first.Height,
-					"err", err.Error())
+			// Finally, verify the first block using the second's commit. The
+			// verification was likely done ahead by the verifier's workers.
+			// TODO(sergio): Should we also validate against the extended commit?
+			verification := verifier.verify(first, second, state.Validators, bcR.Quit())
+			if verification == nil {
 				break FOR_LOOP
 			}
-			firstPartSetHeader := firstParts.Header()
-			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
-			// Finally, verify the first block using the second's commit
-			// NOTE: we can probably make this more efficient, but note that calling
-			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-			// currently necessary.
-			// TODO(sergio): Should we also validate against the extended commit?
-			err = state.Validators.VerifyCommitLight(
-				chainID, firstID, first.Height, second.LastCommit)
+			firstParts, firstID, err := verification.parts, verification.blockID, verification.err
 
 			if err == nil {
 				// validate the block before we persist it
@@ -501,6 +542,7 @@ FOR_LOOP:
 				panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
 			}
 			bcR.metrics.recordBlockMetrics(first)
+			bcR.recordLagMetrics(state, verifier)
 			blocksSynced++
 
 			if blocksSynced%100 == 0 {
@@ -518,6 +560,18 @@ FOR_LOOP:
 	}
 }
 
+// recordLagMetrics records how far the verification of the commits and the
+// execution of the blocks are behind the highest block of the peers.
+func (bcR *Reactor) recordLagMetrics(state sm.State, verifier *blockVerifier) {
+	maxPeerHeight := bcR.pool.MaxPeerHeight()
+	verifiedHeight := verifier.verifiedHeight.Load()
+	if verifiedHeight < state.LastBlockHeight {
+		verifiedHeight = state.LastBlockHeight
+	}
+	bcR.metrics.VerificationLag.Set(float64(max(maxPeerHeight-verifiedHeight, 0)))
+	bcR.metrics.ExecutionLag.Set(float64(max(maxPeerHeight-state.LastBlockHeight, 0)))
+}
+
 // BroadcastStatusRequest broadcasts `BlockStore` base and height.
 func (bcR *Reactor) BroadcastStatusRequest() {
 	bcR.Switch.Broadcast(p2p.Envelope{
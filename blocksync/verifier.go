@@ -0,0 +1,159 @@
+package blocksync
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// blockVerification is the verification of the commit of a block, using the
+// LastCommit of the next block.
+type blockVerification struct {
+	block  *types.Block
+	next   *types.Block
+	valSet *types.ValidatorSet
+	// hash of valSet, set if the verification is scheduled
+	valsHash []byte
+
+	done chan struct{}
+	// set once done is closed
+	parts   *types.PartSet
+	blockID types.BlockID
+	err     error
+}
+
+func newBlockVerification(block, next *types.Block, valSet *types.ValidatorSet) *blockVerification {
+	return &blockVerification{
+		block:  block,
+		next:   next,
+		valSet: valSet,
+		done:   make(chan struct{}),
+	}
+}
+
+func (v *blockVerification) run(chainID string) {
+	defer close(v.done)
+
+	// NOTE: first.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// currently necessary.
+	v.parts, v.err = v.block.MakePartSet(types.BlockPartSizeBytes)
+	if v.err != nil {
+		return
+	}
+	v.blockID = types.BlockID{Hash: v.block.Hash(), PartSetHeader: v.parts.Header()}
+	v.err = v.valSet.VerifyCommitLight(chainID, v.blockID, v.block.Height, v.next.LastCommit)
+}
+
+// blockVerifier verifies in parallel the commits of the blocks ahead of their
+// execution, which is sequential.
+//
+// The validator set of a block is only known once the previous block is
+// executed, so the commits are verified ahead with the validator set of the
+// next block to execute, as long as the headers of the blocks show that the
+// validator set does not change. The verification is redone when the block is
+// executed if the validator set or the blocks in the pool changed in between.
+type blockVerifier struct {
+	chainID string
+	window  int64
+	jobs    chan *blockVerification
+
+	// verifications by height, only accessed by the routine executing the
+	// blocks
+	verifications map[int64]*blockVerification
+	// highest height whose commit was successfully verified
+	verifiedHeight atomic.Int64
+}
+
+func newBlockVerifier(chainID string, window int) *blockVerifier {
+	return &blockVerifier{
+		chainID:       chainID,
+		window:        int64(window),
+		jobs:          make(chan *blockVerification, window),
+		verifications: make(map[int64]*blockVerification, window),
+	}
+}
+
+// start starts the workers verifying the commits until quit is closed.
+func (bv *blockVerifier) start(workers int, quit <-chan struct{}) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-quit:
+					return
+				case v := <-bv.jobs:
+					v.run(bv.chainID)
+					if v.err == nil {
+						bv.setVerified(v.block.Height)
+					}
+				}
+			}
+		}()
+	}
+}
+
+// schedule schedules the verification of the commits of the blocks of the
+// pool from height, the next block to execute, up to the window.
+func (bv *blockVerifier) schedule(pool *BlockPool, height int64, state sm.State) {
+	valsHash := state.Validators.Hash()
+	next := pool.PeekBlock(height)
+	for h := height; h < height+bv.window; h++ {
+		block := next
+		next = pool.PeekBlock(h + 1)
+		if block == nil || next == nil || !bytes.Equal(block.ValidatorsHash, valsHash) {
+			return
+		}
+		if v, ok := bv.verifications[h]; ok && v.block == block && v.next == next {
+			continue
+		}
+
+		v := newBlockVerification(block, next, state.Validators)
+		v.valsHash = valsHash
+		select {
+		case bv.jobs <- v:
+			bv.verifications[h] = v
+		default:
+			// the workers are busy with verifications which were replaced
+			return
+		}
+	}
+}
+
+// verify verifies the commit of block with the LastCommit of next and the
+// given validator set. It waits for the verification scheduled ahead if
+// there is one for the same blocks and validator set, or verifies the commit
+// otherwise. It returns nil if quit is closed while waiting.
+func (bv *blockVerifier) verify(
+	block, next *types.Block,
+	valSet *types.ValidatorSet,
+	quit <-chan struct{},
+) *blockVerification {
+	v, ok := bv.verifications[block.Height]
+	delete(bv.verifications, block.Height)
+	if !ok || v.block != block || v.next != next || !bytes.Equal(v.valsHash, valSet.Hash()) {
+		v = newBlockVerification(block, next, valSet)
+		v.run(bv.chainID)
+		if v.err == nil {
+			bv.setVerified(block.Height)
+		}
+		return v
+	}
+
+	select {
+	case <-v.done:
+		return v
+	case <-quit:
+		return nil
+	}
+}
+
+func (bv *blockVerifier) setVerified(height int64) {
+	for {
+		verified := bv.verifiedHeight.Load()
+		if height <= verified || bv.verifiedHeight.CompareAndSwap(verified, height) {
+			return
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package blocksync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestBlockVerifier(t *testing.T) {
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	// make a chain of blocks, each committing the previous one
+	const numBlocks = 6
+	blocks := make([]*types.Block, 0, numBlocks)
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= numBlocks; height++ {
+		block := state.MakeBlock(height, nil, lastCommit, nil, state.Validators.Proposer.Address)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		vote, err := types.MakeVote(privVals[0], genDoc.ChainID, 0, height, 0, cmtproto.PrecommitType, blockID, time.Now())
+		require.NoError(t, err)
+		lastCommit = &types.Commit{
+			Height:     height,
+			BlockID:    blockID,
+			Signatures: []types.CommitSig{vote.CommitSig()},
+		}
+		blocks = append(blocks, block)
+	}
+
+	pool := NewBlockPool(1, make(chan BlockRequest), make(chan peerError))
+	for _, block := range blocks {
+		pool.requesters[block.Height] = &bpRequester{height: block.Height, block: block}
+	}
+
+	quit := make(chan struct{})
+	defer close(quit)
+	verifier := newBlockVerifier(genDoc.ChainID, 3)
+	verifier.start(2, quit)
+
+	// the commits of the first 3 blocks are verified ahead
+	verifier.schedule(pool, 1, state)
+	require.Len(t, verifier.verifications, 3)
+	scheduled := verifier.verifications[1]
+	v := verifier.verify(blocks[0], blocks[1], state.Validators, quit)
+	require.NotNil(t, v)
+	assert.Same(t, scheduled, v)
+	require.NoError(t, v.err)
+	assert.Equal(t, blocks[0].Hash(), v.blockID.Hash)
+	assert.Len(t, verifier.verifications, 2)
+
+	// a verification with another validator set is redone
+	otherVals, _ := types.RandValidatorSet(1, 30)
+	v = verifier.verify(blocks[1], blocks[2], otherVals, quit)
+	require.NotNil(t, v)
+	require.Error(t, v.err)
+
+	// a verification of blocks which were replaced in the pool is redone
+	verifier.schedule(pool, 3, state)
+	scheduled = verifier.verifications[3]
+	require.NotNil(t, scheduled)
+	v = verifier.verify(blocks[2], blocks[4], state.Validators, quit)
+	require.NotNil(t, v)
+	assert.NotSame(t, scheduled, v)
+	require.Error(t, v.err)
+
+	// the blocks after the last block of the pool are not verified ahead
+	verifier.schedule(pool, 5, state)
+	assert.Contains(t, verifier.verifications, int64(5))
+	assert.NotContains(t, verifier.verifications, int64(6))
+	v = verifier.verify(blocks[4], blocks[5], state.Validators, quit)
+	require.NotNil(t, v)
+	require.NoError(t, v.err)
+	assert.EqualValues(t, 5, verifier.verifiedHeight.Load())
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/state"
+)
+
+var (
+	checkDBStartHeight int64
+	checkDBEndHeight   int64
+	checkDBRepair      bool
+)
+
+func init() {
+	CheckDBCmd.Flags().Int64Var(&checkDBStartHeight, "start-height", 0,
+		"the first block height to check (defaults to the base height of the block store)")
+	CheckDBCmd.Flags().Int64Var(&checkDBEndHeight, "end-height", 0,
+		"the last block height to check (defaults to the latest height of the block store)")
+	CheckDBCmd.Flags().BoolVar(&checkDBRepair, "repair", false,
+		"delete the blocks stored beyond the block following the last state")
+}
+
+// CheckDBCmd checks the consistency of the block store and the state store.
+var CheckDBCmd = &cobra.Command{
+	Use:   "check-db",
+	Short: "Check the consistency of the block store and the state store",
+	Long: `
+Check-db verifies that the blocks of a height range are linked to each other
+by their last block IDs and last commits, and that their validators, next
+validators, consensus params, last results and app hashes match the data of
+the state store. It also verifies that the block store is at most one block
+ahead of the last state, which is replayed on startup.
+
+With the --repair flag, the inconsistencies which can be trivially recovered
+from are repaired: the blocks stored beyond the block following the last
+state, and an incomplete block following the last state, are deleted. They
+are fetched from peers again once the node is restarted. The other
+inconsistencies are only reported.
+
+This command must only be run while the node is stopped.
+`,
+	Example: `
+	cometbft check-db
+	cometbft check-db --start-height 2 --end-height 10
+	cometbft check-db --repair
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		blockStore, stateStore, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = blockStore.Close()
+			_ = stateStore.Close()
+		}()
+
+		issues, err := state.CheckStores(blockStore, stateStore, checkDBStartHeight, checkDBEndHeight, checkDBRepair)
+		for _, issue := range issues {
+			fmt.Println(issue)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to check databases: %w", err)
+		}
+
+		unrepaired := 0
+		for _, issue := range issues {
+			if !issue.Repaired {
+				unrepaired++
+			}
+		}
+		if unrepaired > 0 {
+			return fmt.Errorf("found %d inconsistencies", unrepaired)
+		}
+		if len(issues) > 0 {
+			fmt.Printf("Repaired %d inconsistencies\n", len(issues))
+		} else {
+			fmt.Println("No inconsistencies found")
+		}
+		return nil
+	},
+}
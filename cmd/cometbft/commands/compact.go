@@ -10,6 +10,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
 
+	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
 )
 
@@ -30,13 +31,17 @@ Currently, only GoLevelDB is supported.
 			return errors.New("compaction is currently only supported with goleveldb")
 		}
 
-		compactGoLevelDBs(config.RootDir, logger)
+		compactGoLevelDBs(config.RootDir, config.Storage.BlockStoreBackend, logger)
 		return nil
 	},
 }
 
-func compactGoLevelDBs(rootDir string, logger log.Logger) {
+func compactGoLevelDBs(rootDir, blockStoreBackend string, logger log.Logger) {
 	dbNames := []string{"state", "blockstore"}
+	if blockStoreBackend == cfg.BlockStoreBackendFlatFile {
+		// the blocks are in segment files, only their index is a database
+		dbNames = []string{"state", "blockstore_index"}
+	}
 	o := &opt.Options{
 		DisableSeeksCompaction: true,
 	}
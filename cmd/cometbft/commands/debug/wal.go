@@ -0,0 +1,152 @@
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cfg "github.com/cometbft/cometbft/config"
+	cs "github.com/cometbft/cometbft/consensus"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+var (
+	walHeight int64
+	walOutput string
+
+	flagHeight = "height"
+	flagOutput = "output"
+)
+
+var walCmd = &cobra.Command{
+	Use:   "wal [wal-file]",
+	Short: "Print the timeline of the consensus rounds recorded in the WAL",
+	Long: `Print the timeline of the consensus rounds recorded in the consensus WAL: the
+steps, proposals, +2/3 majorities of prevotes and precommits and the timeouts of
+each round, and the votes which arrived after +2/3 of the voting power had
+already voted.
+
+The WAL of the node is read unless a WAL file is given. The validators are
+loaded from the state database of the node to detect the majorities and the
+late votes, so the node must be stopped.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: walCmdHandler,
+}
+
+func init() {
+	walCmd.Flags().Int64Var(
+		&walHeight,
+		flagHeight,
+		0,
+		"only print the timeline of this height (all the heights if 0)",
+	)
+	walCmd.Flags().StringVar(
+		&walOutput,
+		flagOutput,
+		"table",
+		"output format (table or json)",
+	)
+}
+
+func walCmdHandler(cmd *cobra.Command, args []string) error {
+	if walOutput != "table" && walOutput != "json" {
+		return fmt.Errorf("unknown output format %q", walOutput)
+	}
+	if walHeight < 0 {
+		return errors.New("height must be non-negative")
+	}
+
+	// Load the node's configuration, so that the WAL and the state database
+	// are found where the node keeps them.
+	conf, err := commands.ParseConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	walFile := conf.Consensus.WalFile()
+	if len(args) == 1 {
+		walFile = args[0]
+	}
+	if _, err := os.Stat(walFile); err != nil {
+		return fmt.Errorf("failed to open WAL file: %w", err)
+	}
+	wal, err := cs.NewWAL(walFile)
+	if err != nil {
+		return fmt.Errorf("failed to open WAL: %w", err)
+	}
+	defer wal.Group().Close()
+
+	var rd io.ReadCloser
+	if walHeight > 1 {
+		var found bool
+		rd, found, err = wal.SearchForEndHeight(walHeight-1, &cs.WALSearchOptions{IgnoreDataCorruptionErrors: true})
+		if err != nil {
+			return fmt.Errorf("failed to search WAL for height %d: %w", walHeight-1, err)
+		}
+		if !found {
+			rd = nil
+		}
+	}
+	if rd == nil {
+		if rd, err = wal.Group().NewReader(wal.Group().MinIndex()); err != nil {
+			return fmt.Errorf("failed to read WAL: %w", err)
+		}
+	}
+	defer rd.Close()
+
+	opts := cs.WALTimelineOptions{
+		MinHeight:                  walHeight,
+		MaxHeight:                  walHeight,
+		IgnoreDataCorruptionErrors: true,
+	}
+	stateDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "state", Config: conf})
+	if err != nil {
+		logger.Error("Failed to open the state database, the majorities and late votes are not reported", "err", err)
+	} else {
+		defer stateDB.Close()
+		stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+		opts.Validators = stateStore.LoadValidators
+	}
+
+	timelines, err := cs.BuildWALTimeline(cs.NewWALDecoder(rd), opts)
+	if err != nil {
+		return fmt.Errorf("failed to build the timeline: %w", err)
+	}
+
+	if walOutput == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(timelines)
+	}
+	return printWALTimelines(cmd.OutOrStdout(), timelines)
+}
+
+// printWALTimelines prints the timelines as tables, with the times of the
+// events relative to the start of their height.
+func printWALTimelines(out io.Writer, timelines []*cs.HeightTimeline) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	for _, h := range timelines {
+		took := "not committed"
+		if h.End != nil {
+			took = "committed after " + h.End.Sub(h.Start).String()
+		}
+		fmt.Fprintf(w, "HEIGHT %d\tstarted %s\t%s\n", h.Height, h.Start.Format(time.RFC3339Nano), took)
+		for _, r := range h.Rounds {
+			fmt.Fprintf(w, "  ROUND %d\t\t\n", r.Round)
+			for _, e := range r.Events {
+				fmt.Fprintf(w, "    +%v\t%s\t%s\n", e.Time.Sub(h.Start), e.Type, e.Detail)
+			}
+			for _, v := range r.LateVotes {
+				fmt.Fprintf(w, "    late %s\t%v\t+%v after +2/3\n", v.Type, v.Validator, v.Delay)
+			}
+		}
+	}
+	return w.Flush()
+}
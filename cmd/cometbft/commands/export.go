@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/store/archive"
+)
+
+var (
+	exportStartHeight int64
+	exportEndHeight   int64
+)
+
+func init() {
+	ExportCmd.Flags().Int64Var(&exportStartHeight, "start-height", 0,
+		"the first block height to export (defaults to the base height of the block store)")
+	ExportCmd.Flags().Int64Var(&exportEndHeight, "end-height", 0,
+		"the last block height to export (defaults to the latest height of the block store)")
+}
+
+// ExportCmd exports the blockchain data of a height range into an archive.
+var ExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export blocks, commits and state data into an archive",
+	Long: `
+Export writes the blocks of a height range, along with their seen commits,
+finalize block responses, validator sets and consensus params, and the state
+after the last block of the range, into a checksummed archive. The archive can
+be imported into another node with the import command.
+
+This command must only be run while the node is stopped.
+`,
+	Example: `
+	cometbft export blocks.archive
+	cometbft export blocks.archive --start-height 2 --end-height 10
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		blockStore, stateStore, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = blockStore.Close()
+			_ = stateStore.Close()
+		}()
+
+		from, to := exportStartHeight, exportEndHeight
+		if from == 0 {
+			from = blockStore.Base()
+		}
+		if to == 0 {
+			to = blockStore.Height()
+		}
+
+		f, err := os.Create(args[0])
+		if err != nil {
+			return err
+		}
+		if err := archive.Export(f, blockStore, stateStore, from, to); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to export blocks: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+
+		fmt.Printf("Exported blocks %d to %d to %s\n", from, to, args[0])
+		return nil
+	},
+}
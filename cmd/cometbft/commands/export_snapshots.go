@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/statesync"
+)
+
+var (
+	exportSnapshotsDir    string
+	exportSnapshotsHeight uint64
+)
+
+func init() {
+	ExportSnapshotsCmd.Flags().StringVar(&exportSnapshotsDir, "dir", "",
+		"the snapshot directory (defaults to the snapshots directory of the data directory)")
+	ExportSnapshotsCmd.Flags().Uint64Var(&exportSnapshotsHeight, "height", 0,
+		"the height of the snapshot to export (defaults to all the snapshots)")
+	ExportSnapshotsCmd.Flags().String("proxy_app", config.ProxyApp, "proxy app address")
+	ExportSnapshotsCmd.Flags().String("abci", config.ABCI, "specify abci transport (socket | grpc)")
+}
+
+// ExportSnapshotsCmd exports the state sync snapshots of the application into
+// a snapshot directory served by the snapshot-server command.
+var ExportSnapshotsCmd = &cobra.Command{
+	Use:   "export-snapshots",
+	Short: "Export the state sync snapshots of the application into a snapshot directory",
+	Long: `
+Export-snapshots copies the state sync snapshots of the application, along with
+the light blocks needed to verify them, into a snapshot directory, which can be
+served to the nodes state syncing by the snapshot-server command. The snapshots
+whose light blocks are not available yet, because the two following blocks
+have not been committed, are skipped.
+
+This command connects to the application at the proxy_app address, and must
+only be run while the node is stopped.
+`,
+	Example: `
+	cometbft export-snapshots
+	cometbft export-snapshots --dir /mnt/snapshots --height 1000
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := statesync.NewSnapshotDir(snapshotDirPath(exportSnapshotsDir))
+		if err != nil {
+			return err
+		}
+
+		blockStore, stateStore, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = blockStore.Close()
+			_ = stateStore.Close()
+		}()
+
+		proxyApp := proxy.NewAppConns(proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+			proxy.NopMetrics())
+		proxyApp.SetLogger(logger.With("module", "proxy"))
+		if err := proxyApp.Start(); err != nil {
+			return fmt.Errorf("failed to connect to the application: %w", err)
+		}
+		defer func() {
+			_ = proxyApp.Stop()
+		}()
+
+		snapshots, err := statesync.ExportSnapshots(cmd.Context(), proxyApp.Snapshot(), blockStore, stateStore,
+			dir, exportSnapshotsHeight)
+		for _, snapshot := range snapshots {
+			fmt.Printf("Exported snapshot at height %d in format %d\n", snapshot.Height, snapshot.Format)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to export snapshots: %w", err)
+		}
+		if len(snapshots) == 0 {
+			fmt.Println("No snapshot to export")
+		}
+		return nil
+	},
+}
+
+// snapshotDirPath returns the path of the snapshot directory, defaulting to
+// the snapshots directory of the data directory.
+func snapshotDirPath(dir string) string {
+	if dir == "" {
+		return filepath.Join(config.DBDir(), "snapshots")
+	}
+	return dir
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/archive"
+	"github.com/cometbft/cometbft/types"
+)
+
+var importTrustedHash []byte
+
+func init() {
+	ImportCmd.Flags().BytesHexVar(&importTrustedHash, "trusted-hash", nil,
+		"the trusted hash of the first block of the archive")
+}
+
+// ImportCmd rebuilds the block and state stores of a node from an archive.
+var ImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import blocks, commits and state data from an archive",
+	Long: `
+Import reads an archive written by the export command and saves its blocks,
+commits, finalize block responses, validator sets, consensus params and state
+into the databases of the node. The commit of each block is verified against
+its validator set, and the checksum of the archive is verified before the
+state is saved.
+
+The validator set of the first block of the archive must be trusted: it is
+verified against the last block already stored by the node, against the hash
+given with --trusted-hash, or against the validators of the genesis file if the
+archive starts at the initial height. Each following validator set is verified
+against the next validators hash of the previous block.
+
+The blocks of the archive must directly follow the blocks already stored by
+the node, which usually has no data yet. The application must be restored
+separately at the last height of the archive; if it is empty instead and the
+archive starts at the initial height, the blocks are replayed into it when the
+node starts.
+
+This command must only be run while the node is stopped. If it fails, the
+blocks it imported are deleted.
+`,
+	Example: `
+	cometbft import blocks.archive
+	cometbft import blocks.archive --trusted-hash 28B97BE9F6DE51AC69F70E0B7BFD7E5C9CD1A595B7DC31AFF27C50D4948020CD
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		blockStore, err := store.NewBlockStoreFromConfig(config, cfg.DefaultDBProvider)
+		if err != nil {
+			return err
+		}
+		defer blockStore.Close()
+
+		stateDB, err := dbm.NewDB("state", dbm.BackendType(config.DBBackend), config.DBDir())
+		if err != nil {
+			return err
+		}
+		stateStore := state.NewStore(stateDB, state.StoreOptions{
+			DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		})
+		defer stateStore.Close()
+
+		trust := archive.Trust{Hash: importTrustedHash}
+		if len(importTrustedHash) == 0 {
+			if trust.GenesisDoc, err = types.GenesisDocFromFile(config.GenesisFile()); err != nil {
+				return fmt.Errorf("failed to load the genesis file: %w", err)
+			}
+		}
+		st, err := archive.Import(f, blockStore, stateStore, trust)
+		if err != nil {
+			return fmt.Errorf("failed to import blocks: %w", err)
+		}
+
+		fmt.Printf("Imported blocks up to height %d with app hash %X\n", st.LastBlockHeight, st.AppHash)
+		return nil
+	},
+}
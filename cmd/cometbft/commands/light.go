@@ -54,12 +54,14 @@ for applications built w/ Cosmos SDK).
 }
 
 var (
-	listenAddr         string
-	primaryAddr        string
-	witnessAddrsJoined string
-	chainID            string
-	home               string
-	maxOpenConnections int
+	listenAddr           string
+	primaryAddr          string
+	witnessAddrsJoined   string
+	candidateAddrsJoined string
+	discoverWitnesses    bool
+	chainID              string
+	home                 string
+	maxOpenConnections   int
 
 	sequential     bool
 	trustingPeriod time.Duration
@@ -80,6 +82,10 @@ func init() {
 		"connect to a CometBFT node at this address")
 	LightCmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
 		"CometBFT nodes to cross-check the primary node, comma-separated")
+	LightCmd.Flags().StringVar(&candidateAddrsJoined, "witness-candidates", "",
+		"CometBFT nodes replacing the witnesses removed or promoted to primary, comma-separated")
+	LightCmd.Flags().BoolVar(&discoverWitnesses, "discover-witnesses", false,
+		"discover new witnesses among the peers of the primary when there are no witness candidates left")
 	LightCmd.Flags().StringVar(&home, "home-dir", os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
 		"specify the home directory")
 	LightCmd.Flags().IntVar(
@@ -166,6 +172,13 @@ func runProxy(_ *cobra.Command, args []string) error {
 		}),
 	}
 
+	if candidateAddrsJoined != "" {
+		options = append(options, light.WitnessCandidates(strings.Split(candidateAddrsJoined, ",")))
+	}
+	if discoverWitnesses {
+		options = append(options, light.WitnessDiscovery())
+	}
+
 	if sequential {
 		options = append(options, light.SequentialVerification())
 	} else {
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	mempl "github.com/cometbft/cometbft/mempool"
+	cmtmempool "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	"github.com/cometbft/cometbft/types"
+)
+
+var onlyPending bool
+
+func init() {
+	MempoolWALInspectCmd.Flags().BoolVar(&onlyPending, "pending", false,
+		"only print the transactions that are still pending, as they would be replayed")
+	MempoolWALCmd.AddCommand(MempoolWALInspectCmd, MempoolWALTruncateCmd)
+}
+
+// MempoolWALCmd groups the commands to work with the mempool write-ahead log.
+var MempoolWALCmd = &cobra.Command{
+	Use:     "mempool-wal",
+	Aliases: []string{"mempool_wal"},
+	Short:   "Inspect or truncate the mempool write-ahead log",
+	Long: `
+The mempool write-ahead log records the transactions added to and removed from
+the mempool, so that the transactions still pending are restored when the node
+restarts. It is enabled by setting mempool.wal_dir in the configuration.
+
+These commands must only be run while the node is stopped.
+`,
+}
+
+// MempoolWALInspectCmd prints the content of the mempool write-ahead log.
+var MempoolWALInspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Print the messages in the mempool write-ahead log",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		wal, err := openMempoolWAL()
+		if err != nil {
+			return err
+		}
+		defer wal.Group().Close()
+
+		if onlyPending {
+			txs, err := wal.PendingTxs()
+			if err != nil {
+				return err
+			}
+			var size int
+			for _, tx := range txs {
+				fmt.Printf("%X %d bytes\n", tx.Hash(), len(tx))
+				size += len(tx)
+			}
+			fmt.Printf("%d pending transactions, %d bytes\n", len(txs), size)
+			return nil
+		}
+
+		var added, removed int
+		err = wal.Iterate(func(msg *cmtmempool.TimedWALMessage) error {
+			switch m := msg.Msg.Sum.(type) {
+			case *cmtmempool.WALMessage_AddedTx:
+				added++
+				tx := types.Tx(m.AddedTx.Tx)
+				fmt.Printf("%s added %X %d bytes\n", msg.Time.Format(time.RFC3339Nano), tx.Hash(), len(tx))
+			case *cmtmempool.WALMessage_RemovedTx:
+				removed++
+				fmt.Printf("%s removed %X\n", msg.Time.Format(time.RFC3339Nano), m.RemovedTx.TxKey)
+			}
+			return nil
+		})
+		fmt.Printf("%d added, %d removed\n", added, removed)
+		return err
+	},
+}
+
+// MempoolWALTruncateCmd removes all the messages from the mempool write-ahead
+// log, so that no transaction is restored when the node restarts.
+var MempoolWALTruncateCmd = &cobra.Command{
+	Use:   "truncate",
+	Short: "Remove all the transactions from the mempool write-ahead log",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		wal, err := openMempoolWAL()
+		if err != nil {
+			return err
+		}
+		defer wal.Group().Close()
+
+		if err := wal.Compact(nil); err != nil {
+			return fmt.Errorf("failed to truncate the mempool WAL: %w", err)
+		}
+		fmt.Printf("Truncated mempool WAL in %s\n", config.Mempool.WalDir())
+		return nil
+	},
+}
+
+func openMempoolWAL() (*mempl.WAL, error) {
+	if !config.Mempool.WalEnabled() {
+		return nil, errors.New("the mempool WAL is disabled: mempool.wal_dir is not set")
+	}
+	wal, err := mempl.NewWAL(config.Mempool.WalDir(), config.Mempool.MaxTxBytes)
+	if err != nil {
+		return nil, err
+	}
+	wal.SetLogger(logger)
+	return wal, nil
+}
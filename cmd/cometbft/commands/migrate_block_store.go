@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/store"
+)
+
+// MigrateBlockStoreCmd copies the blocks of the key-value database block
+// store to the flat-file block store.
+var MigrateBlockStoreCmd = &cobra.Command{
+	Use:   "migrate-block-store",
+	Short: "Copy the blocks of the database block store to the flat-file block store",
+	Long: `
+Migrate-block-store copies the blocks, commits and evidence data of the block
+store kept in the key-value database (the "db" block store backend) to the
+segment files and index of the "flatfile" block store backend, using the
+block_store_segment_size of the storage configuration.
+
+This command must only be run while the node is stopped. Once it succeeds,
+set block_store_backend to "flatfile" in the storage configuration, and delete
+data/blockstore.db to reclaim its disk space.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, err := MigrateBlockStore(config)
+		if err != nil {
+			return fmt.Errorf("failed to migrate block store: %w", err)
+		}
+		fmt.Printf("Migrated blocks up to height %d to the flat-file block store\n", height)
+		return nil
+	},
+}
+
+// MigrateBlockStore copies the blocks of the database block store of the node
+// to its flat-file block store, which must be empty, and returns the height of
+// the last block copied.
+func MigrateBlockStore(config *cfg.Config) (int64, error) {
+	if !os.FileExists(filepath.Join(config.DBDir(), "blockstore.db")) {
+		return 0, fmt.Errorf("no blockstore found in %v", config.DBDir())
+	}
+	srcDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return 0, err
+	}
+	src := store.NewBlockStore(srcDB)
+	defer src.Close()
+
+	dst, err := store.NewFlatFileBlockStoreFromConfig(config, cfg.DefaultDBProvider)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+
+	if err := store.MigrateToFlatFile(src, dst); err != nil {
+		return 0, err
+	}
+	return dst.Height(), nil
+}
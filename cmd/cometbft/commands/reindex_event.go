@@ -14,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/libs/progressbar"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/block"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
 	"github.com/cometbft/cometbft/state/txindex"
@@ -116,6 +117,12 @@ func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, t
 			return nil, nil, err
 		}
 		return es.BlockIndexer(), es.TxIndexer(), nil
+	case "sqlite":
+		txIndexer, blockIndexer, err := block.IndexerFromConfig(cfg, cmtcfg.DefaultDBProvider, chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return blockIndexer, txIndexer, nil
 	case "kv":
 		store, err := dbm.NewDB("tx_index", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
 		if err != nil {
@@ -165,6 +172,12 @@ func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
 				Events: resp.Events,
 			}
 
+			// The relational sinks need the block to be indexed before its
+			// transactions.
+			if err := args.blockIndexer.Index(e); err != nil {
+				return fmt.Errorf("block event re-index at height %d failed: %w", height, err)
+			}
+
 			numTxs := len(resp.TxResults)
 
 			var batch *txindex.Batch
@@ -188,10 +201,6 @@ func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
 					return fmt.Errorf("tx event re-index at height %d failed: %w", height, err)
 				}
 			}
-
-			if err := args.blockIndexer.Index(e); err != nil {
-				return fmt.Errorf("block event re-index at height %d failed: %w", height, err)
-			}
 		}
 
 		bar.Play(height)
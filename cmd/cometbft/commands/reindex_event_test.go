@@ -90,12 +90,14 @@ func TestLoadEventSink(t *testing.T) {
 		{"NULL", "", true},
 		{"KV", "", false},
 		{"PSQL", "", true}, // true because empty connect url
+		{"SQLITE", "", false},
 		// skip to test PSQL connect with correct url
 		{"UnsupportedSinkType", "wrongUrl", true},
 	}
 
 	for idx, tc := range testCases {
 		cfg := cmtcfg.TestConfig()
+		cfg.SetRoot(t.TempDir())
 		cfg.TxIndex.Indexer = tc.sinks
 		cfg.TxIndex.PsqlConn = tc.connURL
 		_, _, err := loadEventSinks(cfg, test.DefaultTestChainID)
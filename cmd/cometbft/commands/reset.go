@@ -62,6 +62,7 @@ func resetAllCmd(cmd *cobra.Command, _ []string) (err error) {
 		config.P2P.AddrBookFile(),
 		config.PrivValidatorKeyFile(),
 		config.PrivValidatorStateFile(),
+		config.PrivValidatorFailoverStateFile(),
 		logger,
 	)
 }
@@ -75,11 +76,15 @@ func resetPrivValidator(cmd *cobra.Command, _ []string) (err error) {
 	}
 
 	resetFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile(), logger)
+	removeFailoverState(config.PrivValidatorFailoverStateFile(), logger)
 	return nil
 }
 
 // resetAll removes address book files plus all data, and resets the privValdiator data.
-func resetAll(dbDir, addrBookFile, privValKeyFile, privValStateFile string, logger log.Logger) error {
+func resetAll(
+	dbDir, addrBookFile, privValKeyFile, privValStateFile, privValFailoverStateFile string,
+	logger log.Logger,
+) error {
 	if keepAddrBook {
 		logger.Info("The address book remains intact")
 	} else {
@@ -98,6 +103,7 @@ func resetAll(dbDir, addrBookFile, privValKeyFile, privValStateFile string, logg
 
 	// recreate the dbDir since the privVal state needs to live there
 	resetFilePV(privValKeyFile, privValStateFile, logger)
+	removeFailoverState(privValFailoverStateFile, logger)
 	return nil
 }
 
@@ -175,6 +181,16 @@ func resetFilePV(privValKeyFile, privValStateFile string, logger log.Logger) {
 	}
 }
 
+// removeFailoverState removes the last sign state kept across the remote
+// signers, which would otherwise refuse to sign the heights of a reset chain.
+func removeFailoverState(stateFile string, logger log.Logger) {
+	if err := os.Remove(stateFile); err == nil {
+		logger.Info("Removed remote signers state", "file", stateFile)
+	} else if !os.IsNotExist(err) {
+		logger.Error("Error removing remote signers state", "file", stateFile, "err", err)
+	}
+}
+
 func removeAddrBook(addrBookFile string, logger log.Logger) {
 	if err := os.Remove(addrBookFile); err == nil {
 		logger.Info("Removed existing address book", "file", addrBookFile)
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -19,8 +20,9 @@ func Test_ResetAll(t *testing.T) {
 	pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
 	pv.LastSignState.Height = 10
 	pv.Save()
+	require.NoError(t, os.WriteFile(config.PrivValidatorFailoverStateFile(), []byte(`{"height":"10"}`), 0o600))
 	require.NoError(t, resetAll(config.DBDir(), config.P2P.AddrBookFile(), config.PrivValidatorKeyFile(),
-		config.PrivValidatorStateFile(), logger))
+		config.PrivValidatorStateFile(), config.PrivValidatorFailoverStateFile(), logger))
 	require.DirExists(t, config.DBDir())
 	require.NoFileExists(t, filepath.Join(config.DBDir(), "block.db"))
 	require.NoFileExists(t, filepath.Join(config.DBDir(), "state.db"))
@@ -29,6 +31,7 @@ func Test_ResetAll(t *testing.T) {
 	require.FileExists(t, config.PrivValidatorStateFile())
 	pv = privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
 	require.Equal(t, int64(0), pv.LastSignState.Height)
+	require.NoFileExists(t, config.PrivValidatorFailoverStateFile())
 }
 
 func Test_ResetState(t *testing.T) {
@@ -40,6 +43,7 @@ func Test_ResetState(t *testing.T) {
 	pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
 	pv.LastSignState.Height = 10
 	pv.Save()
+	require.NoError(t, os.WriteFile(config.PrivValidatorFailoverStateFile(), []byte(`{"height":"10"}`), 0o600))
 	require.NoError(t, resetState(config.DBDir(), logger))
 	require.DirExists(t, config.DBDir())
 	require.NoFileExists(t, filepath.Join(config.DBDir(), "block.db"))
@@ -50,4 +54,23 @@ func Test_ResetState(t *testing.T) {
 	pv = privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
 	// private validator state should still be in tact.
 	require.Equal(t, int64(10), pv.LastSignState.Height)
+	require.FileExists(t, config.PrivValidatorFailoverStateFile())
+}
+
+func Test_ResetPrivValidator(t *testing.T) {
+	config := cfg.TestConfig()
+	dir := t.TempDir()
+	config.SetRoot(dir)
+	cfg.EnsureRoot(dir)
+	require.NoError(t, initFilesWithConfig(config))
+	pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	pv.LastSignState.Height = 10
+	pv.Save()
+	require.NoError(t, os.WriteFile(config.PrivValidatorFailoverStateFile(), []byte(`{"height":"10"}`), 0o600))
+
+	t.Setenv("CMTHOME", dir)
+	require.NoError(t, resetPrivValidator(ResetPrivValidatorCmd, nil))
+	pv = privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	require.Equal(t, int64(0), pv.LastSignState.Height)
+	require.NoFileExists(t, config.PrivValidatorFailoverStateFile())
 }
@@ -66,19 +66,22 @@ func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error)
 	return state.Rollback(blockStore, stateStore, removeBlock)
 }
 
-func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
+func loadStateAndBlockStore(config *cfg.Config) (state.BlockStore, state.Store, error) {
 	dbType := dbm.BackendType(config.DBBackend)
 
-	if !os.FileExists(filepath.Join(config.DBDir(), "blockstore.db")) {
+	blockStoreDBName := "blockstore.db"
+	if config.Storage.BlockStoreBackend == cfg.BlockStoreBackendFlatFile {
+		blockStoreDBName = "blockstore_index.db"
+	}
+	if !os.FileExists(filepath.Join(config.DBDir(), blockStoreDBName)) {
 		return nil, nil, fmt.Errorf("no blockstore found in %v", config.DBDir())
 	}
 
 	// Get BlockStore
-	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	blockStore, err := store.NewBlockStoreFromConfig(config, cfg.DefaultDBProvider)
 	if err != nil {
 		return nil, nil, err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB)
 
 	if !os.FileExists(filepath.Join(config.DBDir(), "state.db")) {
 		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	nm "github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/statesync"
+	"github.com/cometbft/cometbft/types"
+)
+
+var snapshotServerDir string
+
+func init() {
+	SnapshotServerCmd.Flags().StringVar(&snapshotServerDir, "dir", "",
+		"the snapshot directory (defaults to the snapshots directory of the data directory)")
+	SnapshotServerCmd.Flags().String("p2p.laddr", config.P2P.ListenAddress,
+		"node listen address. (0.0.0.0:0 means any interface, any port)")
+	SnapshotServerCmd.Flags().String("p2p.persistent_peers", config.P2P.PersistentPeers,
+		"comma-delimited ID@host:port persistent peers")
+}
+
+// SnapshotServerCmd runs a snapshot server.
+var SnapshotServerCmd = &cobra.Command{
+	Use:   "snapshot-server",
+	Short: "Serve the state sync snapshots of a snapshot directory to peers",
+	Long: `
+Snapshot-server serves the snapshots of a snapshot directory, exported with the
+export-snapshots command, to the nodes state syncing over the state sync
+channels of the P2P network. It doesn't run an application nor consensus, only
+the state sync and peer exchange reactors, which makes it a cheap snapshot
+mirror. It also serves the exported light blocks and consensus params to the
+nodes state syncing over P2P, with statesync.use_p2p enabled.
+
+The snapshot server uses the node key, genesis file and P2P settings of the
+configuration, it must not share the listen address of a running node.
+`,
+	Example: `
+	cometbft snapshot-server
+	cometbft snapshot-server --dir /mnt/snapshots --p2p.laddr tcp://0.0.0.0:26666
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := statesync.NewSnapshotDir(snapshotDirPath(snapshotServerDir))
+		if err != nil {
+			return err
+		}
+		nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+		if err != nil {
+			return fmt.Errorf("failed to load node key: %w", err)
+		}
+		genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		s, err := nm.NewSnapshotServer(config, nodeKey, genDoc, dir, logger)
+		if err != nil {
+			return fmt.Errorf("failed to create snapshot server: %w", err)
+		}
+		if err := s.Start(); err != nil {
+			return fmt.Errorf("failed to start snapshot server: %w", err)
+		}
+		logger.Info("Started snapshot server", "nodeInfo", s.NodeInfo())
+
+		// Stop upon receiving SIGTERM or CTRL-C.
+		cmtos.TrapSignal(logger, func() {
+			if s.IsRunning() {
+				if err := s.Stop(); err != nil {
+					logger.Error("unable to stop the snapshot server", "error", err)
+				}
+			}
+		})
+
+		// Run forever.
+		select {}
+	},
+}
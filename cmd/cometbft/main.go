@@ -28,6 +28,13 @@ func main() {
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
 		cmd.InspectCmd,
+		cmd.MempoolWALCmd,
+		cmd.ExportCmd,
+		cmd.ImportCmd,
+		cmd.MigrateBlockStoreCmd,
+		cmd.CheckDBCmd,
+		cmd.ExportSnapshotsCmd,
+		cmd.SnapshotServerCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 	)
@@ -44,3 +44,9 @@ endif
 ifeq (boltdb,$(findstring boltdb,$(COMETBFT_BUILD_OPTIONS)))
   BUILD_TAGS += boltdb
 endif
+
+# handle sqlite
+ifeq (sqlite,$(findstring sqlite,$(COMETBFT_BUILD_OPTIONS)))
+  CGO_ENABLED=1
+  BUILD_TAGS += sqlite
+endif
@@ -39,12 +39,39 @@ const (
 	DefaultPrivValKeyName   = "priv_validator_key.json"
 	DefaultPrivValStateName = "priv_validator_state.json"
 
+	DefaultPrivValFailoverStateName = "priv_validator_failover_state.json"
+
 	DefaultNodeKeyName  = "node_key.json"
 	DefaultAddrBookName = "addrbook.json"
 
 	v0 = "v0"
 	v1 = "v1"
 	v2 = "v2"
+
+	// MempoolTypeFlood is the default mempool, which keeps transactions in the
+	// order in which they were received and gossips them to all peers.
+	MempoolTypeFlood = "flood"
+	// MempoolTypePriority is a mempool that orders transactions by the priority
+	// returned by the application in ResponseCheckTx.
+	MempoolTypePriority = "priority"
+
+	// P2PTransportTCP is the default p2p transport, which multiplexes the
+	// channels of a peer on a TCP connection.
+	P2PTransportTCP = "tcp"
+	// P2PTransportQUIC is a p2p transport which maps the channels of a peer to
+	// their own QUIC streams.
+	P2PTransportQUIC = "quic"
+	// P2PTransportTCPAndQUIC accepts peers over both TCP and QUIC, and dials
+	// them over QUIC first.
+	P2PTransportTCPAndQUIC = "tcp+quic"
+
+	// BlockStoreBackendDB is the default block store backend, which stores
+	// the blocks in the key-value database selected by DBBackend.
+	BlockStoreBackendDB = "db"
+	// BlockStoreBackendFlatFile is a block store backend which appends the
+	// blocks to segment files covering fixed height ranges, indexed by a small
+	// key-value database.
+	BlockStoreBackendFlatFile = "flatfile"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -152,6 +179,12 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "consensus", Err: err}
 	}
+	if err := cfg.Storage.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "storage", Err: err}
+	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "tx_index", Err: err}
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "instrumentation", Err: err}
 	}
@@ -225,7 +258,9 @@ type BaseConfig struct { //nolint: maligned
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
 	// TCP or UNIX socket address for CometBFT to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process. Several
+	// comma-separated addresses may be given, for remote signers holding the
+	// same key: the node then fails over between them.
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
 	// A JSON file containing the private key to use for p2p authenticated encryption
@@ -281,6 +316,13 @@ func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
 }
 
+// PrivValidatorFailoverStateFile returns the full path to the file of the last
+// sign state kept across the remote signers. It is next to the
+// priv_validator_state.json file.
+func (cfg BaseConfig) PrivValidatorFailoverStateFile() string {
+	return filepath.Join(filepath.Dir(cfg.PrivValidatorStateFile()), DefaultPrivValFailoverStateName)
+}
+
 // NodeKeyFile returns the full path to the node_key.json file
 func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
@@ -330,6 +372,22 @@ type RPCConfig struct {
 	// A list of non simple headers the client is allowed to use with cross-domain requests.
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
+	// TCP or UNIX socket address for the gRPC server to listen on.
+	// The gRPC server is disabled if empty.
+	GRPCListenAddress string `mapstructure:"grpc_laddr"`
+
+	// Maximum number of simultaneous connections.
+	// Does not include RPC (HTTP&WebSocket) connections. See max_open_connections
+	// If you want to accept a larger number than the default, make sure
+	// you increase your OS limits.
+	// 0 - unlimited.
+	GRPCMaxOpenConnections int `mapstructure:"grpc_max_open_connections"`
+
+	// TCP or UNIX socket address for the privileged gRPC server to listen on.
+	// It serves the pruning service used by a data companion, and must not
+	// be exposed publicly. The privileged gRPC server is disabled if empty.
+	GRPCPrivilegedListenAddress string `mapstructure:"grpc_privileged_laddr"`
+
 	// Activate unsafe RPC commands like /dial_persistent_peers and /unsafe_flush_mempool
 	Unsafe bool `mapstructure:"unsafe"`
 
@@ -417,6 +475,11 @@ func DefaultRPCConfig() *RPCConfig {
 		CORSAllowedMethods: []string{http.MethodHead, http.MethodGet, http.MethodPost},
 		CORSAllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With", "X-Server-Time"},
 
+		GRPCListenAddress:      "",
+		GRPCMaxOpenConnections: 900,
+
+		GRPCPrivilegedListenAddress: "",
+
 		Unsafe:             false,
 		MaxOpenConnections: 900,
 
@@ -438,6 +501,7 @@ func DefaultRPCConfig() *RPCConfig {
 func TestRPCConfig() *RPCConfig {
 	cfg := DefaultRPCConfig()
 	cfg.ListenAddress = "tcp://127.0.0.1:36657"
+	cfg.GRPCListenAddress = "tcp://127.0.0.1:36658"
 	cfg.Unsafe = true
 	return cfg
 }
@@ -445,6 +509,9 @@ func TestRPCConfig() *RPCConfig {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *RPCConfig) ValidateBasic() error {
+	if cfg.GRPCMaxOpenConnections < 0 {
+		return cmterrors.ErrNegativeField{Field: "grpc_max_open_connections"}
+	}
 	if cfg.MaxOpenConnections < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_open_connections"}
 	}
@@ -515,6 +582,10 @@ type P2PConfig struct { //nolint: maligned
 	// Address to listen for incoming connections
 	ListenAddress string `mapstructure:"laddr"`
 
+	// Transport of the connections to peers: "tcp", "quic" or "tcp+quic".
+	// QUIC listens on the UDP port of ListenAddress.
+	Transport string `mapstructure:"transport"`
+
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
@@ -588,6 +659,7 @@ type P2PConfig struct { //nolint: maligned
 func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
+		Transport:                    P2PTransportTCP,
 		ExternalAddress:              "",
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
@@ -626,6 +698,11 @@ func (cfg *P2PConfig) AddrBookFile() string {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
+	switch cfg.Transport {
+	case P2PTransportTCP, P2PTransportQUIC, P2PTransportTCPAndQUIC:
+	default:
+		return fmt.Errorf("unknown transport: %q", cfg.Transport)
+	}
 	if cfg.MaxNumInboundPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_num_inbound_peers"}
 	}
@@ -678,12 +755,23 @@ func DefaultFuzzConnConfig() *FuzzConnConfig {
 // Note: Until v0.37 there was a `Version` field to select which implementation
 // of the mempool to use. Two versions used to exist: the current, default
 // implementation (previously called v0), and a prioritized mempool (v1), which
-// was removed (see https://github.com/cometbft/cometbft/issues/260).
+// was removed (see https://github.com/cometbft/cometbft/issues/260). The
+// implementation is now selected with the `Type` field.
 type MempoolConfig struct {
 	// RootDir is the root directory for all data. This should be configured via
 	// the $CMTHOME env variable or --home cmd flag rather than overriding this
 	// struct field.
 	RootDir string `mapstructure:"home"`
+	// Type (default: "flood") selects the mempool implementation:
+	//   - "flood": transactions are kept, reaped and gossiped in the order in
+	//     which they were received. New transactions are rejected when the
+	//     mempool is full.
+	//   - "priority": transactions are reaped in decreasing order of the
+	//     priority set by the application in ResponseCheckTx, while keeping
+	//     the order of transactions from the same sender. When the mempool is
+	//     full, transactions with a lower priority are evicted to make room for
+	//     new ones.
+	Type string `mapstructure:"type"`
 	// Recheck (default: true) defines whether CometBFT should recheck the
 	// validity for all remaining transaction in the mempool after a block.
 	// Since a block affects the application state, some transactions in the
@@ -700,6 +788,8 @@ type MempoolConfig struct {
 	// (WAL) for the mempool. The WAL is disabled by default. To enable, set
 	// WalPath to where you want the WAL to be written (e.g.
 	// "data/mempool.wal").
+	// The transactions pending in the WAL are checked again and added
+	// back to the mempool when the node restarts.
 	WalPath string `mapstructure:"wal_dir"`
 	// Maximum number of transactions in the mempool
 	Size int `mapstructure:"size"`
@@ -725,6 +815,7 @@ type MempoolConfig struct {
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
+		Type:      MempoolTypeFlood,
 		Recheck:   true,
 		Broadcast: true,
 		WalPath:   "",
@@ -757,6 +848,11 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
+	switch cfg.Type {
+	case MempoolTypeFlood, MempoolTypePriority:
+	default:
+		return fmt.Errorf("unknown mempool type: %q", cfg.Type)
+	}
 	if cfg.Size < 0 {
 		return cmterrors.ErrNegativeField{Field: "size"}
 	}
@@ -779,6 +875,7 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 type StateSyncConfig struct {
 	Enable              bool          `mapstructure:"enable"`
 	TempDir             string        `mapstructure:"temp_dir"`
+	UseP2P              bool          `mapstructure:"use_p2p"`
 	RPCServers          []string      `mapstructure:"rpc_servers"`
 	TrustPeriod         time.Duration `mapstructure:"trust_period"`
 	TrustHeight         int64         `mapstructure:"trust_height"`
@@ -815,11 +912,12 @@ func TestStateSyncConfig() *StateSyncConfig {
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
 	if cfg.Enable {
-		if len(cfg.RPCServers) == 0 {
+		// The light blocks are fetched from peers instead of RPC servers.
+		if !cfg.UseP2P && len(cfg.RPCServers) == 0 {
 			return cmterrors.ErrRequiredField{Field: "rpc_servers"}
 		}
 
-		if len(cfg.RPCServers) < 2 {
+		if !cfg.UseP2P && len(cfg.RPCServers) < 2 {
 			return ErrNotEnoughRPCServers
 		}
 
@@ -868,12 +966,21 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 // BlockSyncConfig (formerly known as FastSync) defines the configuration for the CometBFT block sync service
 type BlockSyncConfig struct {
 	Version string `mapstructure:"version"`
+
+	// Number of goroutines verifying the commits of the blocks ahead of their
+	// execution. If 0, the number of CPUs is used.
+	VerificationWorkers int `mapstructure:"verification_workers"`
+	// Maximum number of blocks whose commits are verified ahead of their
+	// execution.
+	VerificationWindow int `mapstructure:"verification_window"`
 }
 
 // DefaultBlockSyncConfig returns a default configuration for the block sync service
 func DefaultBlockSyncConfig() *BlockSyncConfig {
 	return &BlockSyncConfig{
-		Version: "v0",
+		Version:             "v0",
+		VerificationWorkers: 0,
+		VerificationWindow:  32,
 	}
 }
 
@@ -884,6 +991,12 @@ func TestBlockSyncConfig() *BlockSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *BlockSyncConfig) ValidateBasic() error {
+	if cfg.VerificationWorkers < 0 {
+		return errors.New("verification_workers can't be negative")
+	}
+	if cfg.VerificationWindow < 1 {
+		return errors.New("verification_window must be positive")
+	}
 	switch cfg.Version {
 	case v0:
 		return nil
@@ -934,6 +1047,12 @@ type ConsensusConfig struct {
 	PeerQueryMaj23SleepDuration      time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
+	// How long the votes received from peers are accumulated before their
+	// signatures are verified in a batch. 0 disables the batch verification.
+	VoteBatchWindow time.Duration `mapstructure:"vote_batch_window"`
+	// Maximum number of votes verified in a batch
+	VoteBatchMaxSize int `mapstructure:"vote_batch_max_size"`
+
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
 }
 
@@ -954,6 +1073,8 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerGossipSleepDuration:          100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
+		VoteBatchWindow:                  0 * time.Millisecond,
+		VoteBatchMaxSize:                 128,
 		DoubleSignCheckHeight:            int64(0),
 	}
 }
@@ -1054,6 +1175,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.PeerQueryMaj23SleepDuration < 0 {
 		return cmterrors.ErrNegativeField{Field: "peer_query_maj23_sleep_duration"}
 	}
+	if cfg.VoteBatchWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "vote_batch_window"}
+	}
+	if cfg.VoteBatchMaxSize < 1 {
+		return errors.New("vote_batch_max_size must be positive")
+	}
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
@@ -1070,13 +1197,28 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+
+	// The block store backend: "db" stores the blocks in the key-value
+	// database, "flatfile" appends them to segment files of
+	// BlockStoreSegmentSize heights, which are deleted as a whole when pruned.
+	BlockStoreBackend string `mapstructure:"block_store_backend"`
+
+	// The number of heights stored in each segment file of the "flatfile"
+	// block store backend. It can't be changed once blocks have been stored.
+	BlockStoreSegmentSize int64 `mapstructure:"block_store_segment_size"`
+
+	// Configuration of the background pruning of blocks and ABCI responses.
+	Pruning *PruningConfig `mapstructure:"pruning"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
 // CometBFT storage optimization.
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
+		DiscardABCIResponses:  false,
+		BlockStoreBackend:     BlockStoreBackendDB,
+		BlockStoreSegmentSize: 1000,
+		Pruning:               DefaultPruningConfig(),
 	}
 }
 
@@ -1084,10 +1226,111 @@ func DefaultStorageConfig() *StorageConfig {
 // testing.
 func TestStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
+		DiscardABCIResponses:  false,
+		BlockStoreBackend:     BlockStoreBackendDB,
+		BlockStoreSegmentSize: 100,
+		Pruning:               TestPruningConfig(),
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StorageConfig) ValidateBasic() error {
+	switch cfg.BlockStoreBackend {
+	case BlockStoreBackendDB, BlockStoreBackendFlatFile:
+	default:
+		return fmt.Errorf("unknown block store backend: %q", cfg.BlockStoreBackend)
+	}
+	if cfg.BlockStoreSegmentSize <= 0 {
+		return errors.New("block_store_segment_size must be positive")
+	}
+	if err := cfg.Pruning.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "pruning", Err: err}
+	}
+	return nil
+}
+
+// PruningConfig defines the configuration of the background pruning of blocks
+// and ABCI responses.
+type PruningConfig struct {
+	// The time period between pruning runs.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Pruning controlled by a data companion.
+	DataCompanion *DataCompanionPruningConfig `mapstructure:"data_companion"`
+}
+
+// DefaultPruningConfig returns the default pruning configuration.
+func DefaultPruningConfig() *PruningConfig {
+	return &PruningConfig{
+		Interval:      10 * time.Second,
+		DataCompanion: DefaultDataCompanionPruningConfig(),
+	}
+}
+
+// TestPruningConfig returns a pruning configuration that can be used for
+// testing.
+func TestPruningConfig() *PruningConfig {
+	return &PruningConfig{
+		Interval:      time.Second,
+		DataCompanion: DefaultDataCompanionPruningConfig(),
 	}
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *PruningConfig) ValidateBasic() error {
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be > 0")
+	}
+	if err := cfg.DataCompanion.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "data_companion", Err: err}
+	}
+	return nil
+}
+
+// DataCompanionPruningConfig defines the configuration of the pruning
+// controlled by a data companion, an off-chain service that exports blocks
+// and ABCI responses before they are pruned.
+type DataCompanionPruningConfig struct {
+	// Whether the retain heights set by the data companion through the
+	// pruning service are taken into account. If enabled, blocks are pruned
+	// up to the minimum of the retain heights of the application and of the
+	// data companion, and ABCI responses up to the retain height of the data
+	// companion.
+	Enabled bool `mapstructure:"enabled"`
+
+	// The initial block retain height of the data companion, used until it
+	// sets one. 0 retains all blocks.
+	InitialBlockRetainHeight int64 `mapstructure:"initial_block_retain_height"`
+
+	// The initial retain height of the ABCI responses of the data companion,
+	// used until it sets one. 0 retains all ABCI responses.
+	InitialBlockResultsRetainHeight int64 `mapstructure:"initial_block_results_retain_height"`
+}
+
+// DefaultDataCompanionPruningConfig returns the default configuration of the
+// pruning controlled by a data companion.
+func DefaultDataCompanionPruningConfig() *DataCompanionPruningConfig {
+	return &DataCompanionPruningConfig{
+		Enabled:                         false,
+		InitialBlockRetainHeight:        0,
+		InitialBlockResultsRetainHeight: 0,
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *DataCompanionPruningConfig) ValidateBasic() error {
+	if cfg.InitialBlockRetainHeight < 0 {
+		return cmterrors.ErrNegativeField{Field: "initial_block_retain_height"}
+	}
+	if cfg.InitialBlockResultsRetainHeight < 0 {
+		return cmterrors.ErrNegativeField{Field: "initial_block_results_retain_height"}
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // TxIndexConfig
 // Remember that Event has the following structure:
@@ -1109,6 +1352,8 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite
+	//      database.
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
@@ -1128,6 +1373,16 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+// ValidateBasic performs basic validation and returns an error if any check
+// fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	if cfg.Indexer == "sqlite" && !sqliteEnabled {
+		return errors.New("the sqlite indexer requires a binary built with the sqlite build tag and cgo " +
+			"(CGO_ENABLED=1 go build -tags sqlite)")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
@@ -70,6 +70,7 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 	assert.NoError(t, cfg.ValidateBasic())
 
 	fieldsToTest := []string{
+		"GRPCMaxOpenConnections",
 		"MaxOpenConnections",
 		"MaxSubscriptionClients",
 		"MaxSubscriptionsPerClient",
@@ -103,6 +104,13 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	for _, transport := range []string{config.P2PTransportQUIC, config.P2PTransportTCPAndQUIC} {
+		cfg.Transport = transport
+		assert.NoError(t, cfg.ValidateBasic())
+	}
+	cfg.Transport = "udp"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -121,11 +129,32 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Type = config.MempoolTypePriority
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Type = "unknown"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Enable = true
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0123456789ABCDEF"
+	assert.Error(t, cfg.ValidateBasic())
+
+	// the RPC servers are not required when fetching light blocks from peers
+	cfg.UseP2P = true
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.RPCServers = []string{"127.0.0.1:26657"}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.UseP2P = false
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestBlockSyncConfigValidateBasic(t *testing.T) {
@@ -138,6 +167,14 @@ func TestBlockSyncConfigValidateBasic(t *testing.T) {
 
 	cfg.Version = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = config.TestBlockSyncConfig()
+	cfg.VerificationWorkers = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = config.TestBlockSyncConfig()
+	cfg.VerificationWindow = 0
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestConsensusConfig_ValidateBasic(t *testing.T) {
@@ -164,6 +201,9 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerGossipSleepDuration negative":     {func(c *config.ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
+		"VoteBatchWindow":                      {func(c *config.ConsensusConfig) { c.VoteBatchWindow = 0 }, false},
+		"VoteBatchWindow negative":             {func(c *config.ConsensusConfig) { c.VoteBatchWindow = -1 }, true},
+		"VoteBatchMaxSize zero":                {func(c *config.ConsensusConfig) { c.VoteBatchMaxSize = 0 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
 	}
 	for desc, tc := range testcases {
@@ -182,6 +222,41 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestStorageConfigValidateBasic(t *testing.T) {
+	cfg := config.TestStorageConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.BlockStoreBackend = "unknown"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.BlockStoreBackend = config.BlockStoreBackendFlatFile
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.BlockStoreSegmentSize = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.BlockStoreSegmentSize = 100
+
+	cfg.Pruning.Interval = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Pruning.Interval = time.Second
+
+	cfg.Pruning.DataCompanion.InitialBlockRetainHeight = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Pruning.DataCompanion.InitialBlockRetainHeight = 0
+
+	cfg.Pruning.DataCompanion.InitialBlockResultsRetainHeight = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := config.TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, indexer := range []string{"null", "psql"} {
+		cfg.Indexer = indexer
+		assert.NoError(t, cfg.ValidateBasic())
+	}
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := config.TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
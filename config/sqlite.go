@@ -0,0 +1,8 @@
+//go:build sqlite && cgo
+// +build sqlite,cgo
+
+package config
+
+// sqliteEnabled reports whether the binary is built with the sqlite build tag
+// and cgo, which the sqlite indexer requires.
+const sqliteEnabled = true
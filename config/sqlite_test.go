@@ -0,0 +1,18 @@
+//go:build sqlite && cgo
+// +build sqlite,cgo
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+func TestTxIndexConfigValidateBasicSQLite(t *testing.T) {
+	cfg := config.TestTxIndexConfig()
+	cfg.Indexer = "sqlite"
+	assert.NoError(t, cfg.ValidateBasic())
+}
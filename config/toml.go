@@ -131,7 +131,12 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
 # TCP or UNIX socket address for CometBFT to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process.
+# Several comma-separated addresses may be given, for remote signers holding
+# the same key. The node then sends its requests to one of them, and fails
+# over to another one if it becomes unavailable. The last sign state is kept
+# across all the signers, even a single one, in priv_validator_failover_state.json,
+# next to the priv_validator_state_file, to prevent double signing on failover.
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
@@ -168,6 +173,25 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 # A list of non simple headers the client is allowed to use with cross-domain requests
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
+# TCP or UNIX socket address for the gRPC server to listen on.
+# The gRPC server is disabled if empty.
+grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
+
+# Maximum number of simultaneous connections.
+# Does not include RPC (HTTP&WebSocket) connections. See max_open_connections
+# If you want to accept a larger number than the default, make sure
+# you increase your OS limits.
+# 0 - unlimited.
+# Should be < {ulimit -Sn} - {MaxNumInboundPeers} - {MaxNumOutboundPeers} - {N of wal, db and other open files}
+# 1024 - 40 - 10 - 50 = 924 = ~900
+grpc_max_open_connections = {{ .RPC.GRPCMaxOpenConnections }}
+
+# TCP or UNIX socket address for the privileged gRPC server to listen on.
+# It serves the pruning service used by a data companion, and must not be
+# exposed publicly.
+# The privileged gRPC server is disabled if empty.
+grpc_privileged_laddr = "{{ .RPC.GRPCPrivilegedListenAddress }}"
+
 # Activate unsafe RPC commands like /dial_seeds and /unsafe_flush_mempool
 unsafe = {{ .RPC.Unsafe }}
 
@@ -254,6 +278,17 @@ pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 # Address to listen for incoming connections
 laddr = "{{ .P2P.ListenAddress }}"
 
+# The transport of the connections to peers.
+#
+#  Possible transports:
+#  - "tcp" : the channels of a peer are multiplexed on a TCP connection.
+#  - "quic" : each channel of a peer is mapped to its own QUIC stream, so
+#    that the channels do not block each other. QUIC listens on the UDP port
+#    of laddr.
+#  - "tcp+quic" : peers are accepted over both TCP and QUIC, and dialed over
+#    QUIC first, falling back to TCP. Use it to migrate a network to QUIC.
+transport = "{{ .P2P.Transport }}"
+
 # Address to advertise to peers for them to dial. If empty, will use the same
 # port as the laddr, and will introspect on the listener to figure out the
 # address. IP and port are required. Example: 159.89.10.97:26656
@@ -320,6 +355,18 @@ dial_timeout = "{{ .P2P.DialTimeout }}"
 #######################################################
 [mempool]
 
+# The type of mempool for this node to use.
+#
+#  Possible types:
+#  - "flood" : transactions are kept, reaped and gossiped in the order in
+#    which they were received. New transactions are rejected when the mempool
+#    is full.
+#  - "priority" : transactions are reaped in decreasing order of the priority
+#    set by the application in ResponseCheckTx, keeping the order of
+#    transactions from the same sender. When the mempool is full, transactions
+#    with a lower priority are evicted to make room for new ones.
+type = "{{ .Mempool.Type }}"
+
 # recheck (default: true) defines whether CometBFT should recheck the
 # validity for all remaining transaction in the mempool after a block.
 # Since a block affects the application state, some transactions in the
@@ -338,6 +385,8 @@ broadcast = {{ .Mempool.Broadcast }}
 # (WAL) for the mempool. The WAL is disabled by default. To enable, set
 # wal_dir to where you want the WAL to be written (e.g.
 # "data/mempool.wal").
+# The transactions pending in the WAL are checked again and added
+# back to the mempool when the node restarts.
 wal_dir = "{{ js .Mempool.WalPath }}"
 
 # Maximum number of transactions in the mempool
@@ -383,6 +432,11 @@ enable = {{ .StateSync.Enable }}
 # For Cosmos SDK-based chains, trust_period should usually be about 2/3 of the unbonding time (~2
 # weeks) during which they can be financially punished (slashed) for misbehavior.
 rpc_servers = "{{ StringsJoin .StateSync.RPCServers "," }}"
+
+# If true, the light blocks and consensus params are fetched from peers over the state sync
+# channels instead of the RPC servers, which are then not required. At least 2 peers serving
+# them must be connected, they are still verified against the trusted height and hash.
+use_p2p = {{ .StateSync.UseP2P }}
 trust_height = {{ .StateSync.TrustHeight }}
 trust_hash = "{{ .StateSync.TrustHash }}"
 trust_period = "{{ .StateSync.TrustPeriod }}"
@@ -391,7 +445,10 @@ trust_period = "{{ .StateSync.TrustPeriod }}"
 discovery_time = "{{ .StateSync.DiscoveryTime }}"
 
 # Temporary directory for state sync snapshot chunks, defaults to the OS tempdir (typically /tmp).
-# Will create a new, randomly named directory within, and remove it when done.
+# If set, the restore in progress is kept in a statesync-restore directory within, so that a
+# restarted node resumes it with the chunks already fetched. Only fetching is skipped: the app
+# restarts the restore, so all chunks are applied again. Otherwise a new, randomly named
+# directory is created within the OS tempdir. In both cases, it is removed when done.
 temp_dir = "{{ .StateSync.TempDir }}"
 
 # The timeout duration before re-requesting a chunk, possibly from a different
@@ -414,6 +471,15 @@ chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 #   1) "v0" - the default block sync implementation
 version = "{{ .BlockSync.Version }}"
 
+# Number of goroutines verifying the commits of the blocks ahead of their
+# execution, which is done one block at a time. If 0, the number of CPUs is
+# used.
+verification_workers = {{ .BlockSync.VerificationWorkers }}
+
+# Maximum number of blocks whose commits are verified ahead of their
+# execution. It bounds the memory used by the verified blocks.
+verification_window = {{ .BlockSync.VerificationWindow }}
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -456,6 +522,15 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# How long the votes received from peers are accumulated before their signatures
+# are verified in a batch, which is faster than verifying them one by one on
+# chains with many validators. 0 disables the batch verification, which is the
+# default; a window of a few milliseconds, e.g. "1ms", is enough to enable it.
+vote_batch_window = "{{ .Consensus.VoteBatchWindow }}"
+
+# Maximum number of votes verified in a batch
+vote_batch_max_size = {{ .Consensus.VoteBatchMaxSize }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -467,6 +542,44 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# The block store backend. Options:
+#   1) "db" (default) - the blocks are stored in the key-value database
+#   selected by db_backend.
+#   2) "flatfile" - the blocks are appended to segment files covering
+#   block_store_segment_size heights each, in data/blockstore.seg, and indexed
+#   by a small key-value database. Pruning deletes whole segment files.
+# Use the migrate-block-store command to move the blocks of an existing node
+# to the "flatfile" backend.
+block_store_backend = "{{ .Storage.BlockStoreBackend }}"
+
+# The number of heights stored in each segment file of the "flatfile" block
+# store backend. It can't be changed once blocks have been stored.
+block_store_segment_size = {{ .Storage.BlockStoreSegmentSize }}
+
+[storage.pruning]
+
+# The time period between pruning runs. Blocks are pruned in the background,
+# up to the retain height requested by the application in ResponseCommit.
+interval = "{{ .Storage.Pruning.Interval }}"
+
+[storage.pruning.data_companion]
+
+# Whether the retain heights set by a data companion through the pruning
+# service of the privileged gRPC server (see grpc_privileged_laddr) are taken
+# into account. If enabled, blocks are pruned up to the minimum of the retain
+# heights of the application and of the data companion, and ABCI responses up
+# to the retain height of the data companion, so that the data companion can
+# export them before they are removed.
+enabled = {{ .Storage.Pruning.DataCompanion.Enabled }}
+
+# The initial block retain height of the data companion, used until it sets
+# one. 0 retains all blocks.
+initial_block_retain_height = {{ .Storage.Pruning.DataCompanion.InitialBlockRetainHeight }}
+
+# The initial retain height of the ABCI responses of the data companion, used
+# until it sets one. 0 retains all ABCI responses.
+initial_block_results_retain_height = {{ .Storage.Pruning.DataCompanion.InitialBlockResultsRetainHeight }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -482,7 +595,10 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an embedded SQLite database
+#      (tx_index.sqlite in the data directory; requires a build with the sqlite
+#      build tag and cgo enabled, e.g. make build COMETBFT_BUILD_OPTIONS=sqlite).
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
 # The PostgreSQL connection configuration, the connection format:
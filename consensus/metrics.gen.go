@@ -192,6 +192,20 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "late_votes",
 			Help:      "LateVotes stores the number of votes that were received by this node that correspond to earlier heights and rounds than this node is currently in.",
 		}, append(labels, "vote_type")).With(labelsAndValues...),
+		VoteBatchSize: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "vote_batch_size",
+			Help:      "Number of votes received from peers whose signatures were verified together in a batch.",
+
+			Buckets: stdprometheus.ExponentialBuckets(1, 2, 10),
+		}, labels).With(labelsAndValues...),
+		VoteBatchTimeSavedSeconds: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "vote_batch_time_saved_seconds",
+			Help:      "Estimated time saved, in seconds, by verifying the signatures of the votes in batches.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -226,5 +240,7 @@ func NopMetrics() *Metrics {
 		ProposalCreateCount:       discard.NewCounter(),
 		RoundVotingPowerPercent:   discard.NewGauge(),
 		LateVotes:                 discard.NewCounter(),
+		VoteBatchSize:             discard.NewHistogram(),
+		VoteBatchTimeSavedSeconds: discard.NewCounter(),
 	}
 }
@@ -48,6 +48,10 @@ type Reactor struct {
 	eventBus *types.EventBus
 	rs       *cstypes.RoundState
 
+	voteBatchWindow  time.Duration
+	voteBatchMaxSize int
+	voteVerifier     *voteBatchVerifier
+
 	Metrics *Metrics
 }
 
@@ -68,6 +72,11 @@ func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption)
 		option(conR)
 	}
 
+	if conR.voteBatchWindow > 0 {
+		conR.voteVerifier = newVoteBatchVerifier(consensusState, conR.Metrics,
+			conR.voteBatchWindow, conR.voteBatchMaxSize, conR.stopPeerForInvalidVote)
+	}
+
 	return conR
 }
 
@@ -82,6 +91,10 @@ func (conR *Reactor) OnStart() error {
 	conR.subscribeToBroadcastEvents()
 	go conR.updateRoundStateRoutine()
 
+	if conR.voteVerifier != nil {
+		go conR.voteVerifier.run(conR.Quit())
+	}
+
 	if !conR.WaitSync() {
 		err := conR.conS.Start()
 		if err != nil {
@@ -325,13 +338,13 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 		switch msg := msg.(type) {
 		case *ProposalMessage:
 			ps.SetHasProposal(msg.Proposal)
-			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+			conR.conS.peerMsgQueue <- msgInfo{Msg: msg, PeerID: e.Src.ID()}
 		case *ProposalPOLMessage:
 			ps.ApplyProposalPOLMessage(msg)
 		case *BlockPartMessage:
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
-			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+			conR.conS.peerMsgQueue <- msgInfo{Msg: msg, PeerID: e.Src.ID()}
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -351,7 +364,11 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
 
-			cs.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+			if conR.voteVerifier != nil {
+				conR.voteVerifier.votesCh <- msgInfo{Msg: msg, PeerID: e.Src.ID()}
+			} else {
+				cs.peerMsgQueue <- msgInfo{Msg: msg, PeerID: e.Src.ID()}
+			}
 
 		default:
 			// don't punish (leave room for soft upgrades)
@@ -471,6 +488,15 @@ func (conR *Reactor) broadcastNewValidBlockMessage(rs *cstypes.RoundState) {
 	})
 }
 
+// stopPeerForInvalidVote stops the peer which sent a vote with an invalid
+// signature.
+func (conR *Reactor) stopPeerForInvalidVote(mi msgInfo, err error) {
+	conR.Logger.Error("Peer sent us a vote with an invalid signature", "peer", mi.PeerID, "err", err)
+	if peer := conR.Switch.Peers().Get(mi.PeerID); peer != nil {
+		conR.Switch.StopPeerForError(peer, err)
+	}
+}
+
 // Broadcasts HasVoteMessage to peers that care.
 func (conR *Reactor) broadcastHasVoteMessage(vote *types.Vote) {
 	msg := &cmtcons.HasVote{
@@ -1050,6 +1076,17 @@ func ReactorMetrics(metrics *Metrics) ReactorOption {
 	return func(conR *Reactor) { conR.Metrics = metrics }
 }
 
+// ReactorVoteBatchVerification sets the reactor to accumulate the votes
+// received from peers for the given window, and to verify the signatures of
+// up to maxSize of them at once. A window of 0 disables the batch
+// verification.
+func ReactorVoteBatchVerification(window time.Duration, maxSize int) ReactorOption {
+	return func(conR *Reactor) {
+		conR.voteBatchWindow = window
+		conR.voteBatchMaxSize = maxSize
+	}
+}
+
 //-----------------------------------------------------------------------------
 
 // PeerState contains the known state of a peer, including its connection and
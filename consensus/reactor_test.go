@@ -45,7 +45,7 @@ import (
 
 var defaultTestTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 
-func startConsensusNet(t *testing.T, css []*State, n int) (
+func startConsensusNet(t *testing.T, css []*State, n int, options ...ReactorOption) (
 	[]*Reactor,
 	[]types.Subscription,
 	[]*types.EventBus,
@@ -56,7 +56,7 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		/*logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		if err != nil {	t.Fatal(err)}*/
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		reactors[i] = NewReactor(css[i], true, options...) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -121,6 +121,22 @@ func TestReactorBasic(t *testing.T) {
 	})
 }
 
+// Ensure the validators make blocks when the reactors verify the votes in batches
+func TestReactorVoteBatchVerification(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore)
+	defer cleanup()
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N,
+		ReactorVoteBatchVerification(5*time.Millisecond, 10))
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+	// wait till everyone makes a few blocks
+	for i := 0; i < 3; i++ {
+		timeoutWaitGroup(N, func(j int) {
+			<-blocksSubs[j].Out()
+		})
+	}
+}
+
 // Ensure we can process blocks with evidence
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
@@ -1204,6 +1204,7 @@ func (bs *mockBlockStore) SaveBlockWithExtendedCommit(*types.Block, *types.PartS
 
 func (bs *mockBlockStore) SaveBlock(*types.Block, *types.PartSet, *types.Commit) {
 }
+func (bs *mockBlockStore) SaveSeenCommit(int64, *types.Commit) error { return nil }
 
 func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 	return bs.extCommits[height-1].ToCommit()
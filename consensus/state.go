@@ -38,6 +38,10 @@ var msgQueueSize = 1000
 type msgInfo struct {
 	Msg    Message `json:"msg"`
 	PeerID p2p.ID  `json:"peer_key"`
+
+	// the signatures of the vote of a VoteMessage were verified by the
+	// reactor. Not written to the WAL, so replayed votes are verified again.
+	preverified bool
 }
 
 // internally generated messages which may update the state
@@ -449,9 +453,9 @@ func (cs *State) OpenWAL(walFile string) (WAL, error) {
 // AddVote inputs a vote.
 func (cs *State) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&VoteMessage{vote}, ""}
+		cs.internalMsgQueue <- msgInfo{Msg: &VoteMessage{vote}, PeerID: ""}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&VoteMessage{vote}, peerID}
+		cs.peerMsgQueue <- msgInfo{Msg: &VoteMessage{vote}, PeerID: peerID}
 	}
 
 	// TODO: wait for event?!
@@ -461,9 +465,9 @@ func (cs *State) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 // SetProposal inputs a proposal.
 func (cs *State) SetProposal(proposal *types.Proposal, peerID p2p.ID) error {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, ""}
+		cs.internalMsgQueue <- msgInfo{Msg: &ProposalMessage{proposal}, PeerID: ""}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID}
+		cs.peerMsgQueue <- msgInfo{Msg: &ProposalMessage{proposal}, PeerID: peerID}
 	}
 
 	// TODO: wait for event?!
@@ -473,9 +477,9 @@ func (cs *State) SetProposal(proposal *types.Proposal, peerID p2p.ID) error {
 // AddProposalBlockPart inputs a part of the proposal block.
 func (cs *State) AddProposalBlockPart(height int64, round int32, part *types.Part, peerID p2p.ID) error {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, ""}
+		cs.internalMsgQueue <- msgInfo{Msg: &BlockPartMessage{height, round, part}, PeerID: ""}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, peerID}
+		cs.peerMsgQueue <- msgInfo{Msg: &BlockPartMessage{height, round, part}, PeerID: peerID}
 	}
 
 	// TODO: wait for event?!
@@ -698,6 +702,7 @@ func (cs *State) updateToState(state sm.State) {
 
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -892,7 +897,7 @@ func (cs *State) handleMsg(mi msgInfo) {
 	case *VoteMessage:
 		// attempt to add the vote and dupeout the validator if its a duplicate signature
 		// if the vote gives us a 2/3-any or 2/3-one, we transition
-		added, err = cs.tryAddVote(msg.Vote, peerID)
+		added, err = cs.tryAddVote(msg.Vote, peerID, mi.preverified)
 		if added {
 			cs.statsMsgQueue <- mi
 		}
@@ -1050,6 +1055,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	if round != 0 {
 		logger.Info("resetting proposal info", "proposer", propAddress)
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1113,6 +1119,17 @@ func (cs *State) enterPropose(height int64, round int32) {
 		return
 	}
 
+	// If we are the proposer of this round and the last block time is not
+	// before our clock, wait until it is: with proposer-based timestamps, the
+	// time of our block must be after the last block time.
+	if cs.isPBTSEnabled(height) && cs.privValidatorPubKey != nil && cs.isProposer(cs.privValidatorPubKey.Address()) {
+		if waitTime := proposerWaitTime(cmttime.Now(), cs.state.LastBlockTime); waitTime > 0 {
+			logger.Debug("waiting for the clock to reach the last block time before proposing", "wait_time", waitTime)
+			cs.scheduleTimeout(waitTime, height, round, cstypes.RoundStepNewRound)
+			return
+		}
+	}
+
 	logger.Debug("entering propose step", "current", log.NewLazySprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
 
 	defer func() {
@@ -1166,6 +1183,19 @@ func (cs *State) isProposer(address []byte) bool {
 	return bytes.Equal(cs.Validators.GetProposer().Address, address)
 }
 
+func (cs *State) isPBTSEnabled(height int64) bool {
+	return cs.state.ConsensusParams.Feature.PbtsEnabled(height)
+}
+
+// proposerWaitTime returns how long the proposer must wait for its clock to
+// be after the last block time, as required by proposer-based timestamps.
+func proposerWaitTime(now, lastBlockTime time.Time) time.Duration {
+	if now.After(lastBlockTime) {
+		return 0
+	}
+	return lastBlockTime.Sub(now) + time.Nanosecond
+}
+
 func (cs *State) defaultDecideProposal(height int64, round int32) {
 	var block *types.Block
 	var blockParts *types.PartSet
@@ -1201,16 +1231,21 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
+	if cs.isPBTSEnabled(height) {
+		// With proposer-based timestamps, the timeliness of the proposal is
+		// that of the block.
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
 
 		// send proposal and block parts on internal msg queue
-		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, ""})
+		cs.sendInternalMessage(msgInfo{Msg: &ProposalMessage{proposal}, PeerID: ""})
 
 		for i := 0; i < int(blockParts.Total()); i++ {
 			part := blockParts.GetPart(i)
-			cs.sendInternalMessage(msgInfo{&BlockPartMessage{cs.Height, cs.Round, part}, ""})
+			cs.sendInternalMessage(msgInfo{Msg: &BlockPartMessage{cs.Height, cs.Round, part}, PeerID: ""})
 		}
 
 		cs.Logger.Debug("signed proposal", "height", height, "round", round, "proposal", proposal)
@@ -1330,6 +1365,27 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	if cs.isPBTSEnabled(height) {
+		if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+			logger.Debug("prevote step: proposal timestamp not equal to block time; prevoting nil",
+				"proposal_time", cs.Proposal.Timestamp, "block_time", cs.ProposalBlock.Time)
+			cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+
+		// The timeliness of a proposal is only checked the first time its
+		// block is proposed (POLRound is -1). A block that was re-proposed
+		// was already prevoted by +2/3 of the validators, and thus deemed
+		// timely by at least one correct validator.
+		if cs.Proposal.POLRound == -1 && cs.LockedRound == -1 &&
+			!cs.Proposal.IsTimely(cs.ProposalReceiveTime, cs.state.ConsensusParams.Synchrony) {
+			logger.Debug("prevote step: proposal is not timely; prevoting nil",
+				"proposal_time", cs.Proposal.Timestamp, "receive_time", cs.ProposalReceiveTime)
+			cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+	}
+
 	/*
 		22: upon <PROPOSAL, h_p, round_p, v, −1> from proposer(h_p, round_p) while step_p = propose do
 		23: if valid(v) && (lockedRound_p = −1 || lockedValue_p = v) then
@@ -1953,6 +2009,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = cmttime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -2083,8 +2140,9 @@ func (cs *State) handleCompleteProposal(blockHeight int64) {
 }
 
 // Attempt to add the vote. if its a duplicate signature, dupeout the validator
-func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
-	added, err := cs.addVote(vote, peerID)
+// If preverified, the signatures of the vote were already verified.
+func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID, preverified bool) (bool, error) {
+	added, err := cs.addVote(vote, peerID, preverified)
 	// NOTE: some of these errors are swallowed here
 	if err != nil {
 		// If the vote height is off, we'll just ignore it,
@@ -2134,7 +2192,7 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 	return added, nil
 }
 
-func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
+func (cs *State) addVote(vote *types.Vote, peerID p2p.ID, preverified bool) (added bool, err error) {
 	cs.Logger.Debug(
 		"adding vote",
 		"vote_height", vote.Height,
@@ -2158,7 +2216,11 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return added, err
 		}
 
-		added, err = cs.LastCommit.AddVote(vote)
+		if preverified {
+			added, err = cs.LastCommit.AddPreverifiedVote(vote)
+		} else {
+			added, err = cs.LastCommit.AddVote(vote)
+		}
 		if !added {
 			// If the vote wasnt added but there's no error, its a duplicate vote
 			if err == nil {
@@ -2211,9 +2273,15 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			// consensus reactor when the vote was received.
 			// Here, we verify the signature of the vote extension included in the vote
 			// message.
-			_, val := cs.state.Validators.GetByIndex(vote.ValidatorIndex)
-			if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
-				return false, err
+			if preverified {
+				if err := vote.EnsureExtension(); err != nil {
+					return false, err
+				}
+			} else {
+				_, val := cs.state.Validators.GetByIndex(vote.ValidatorIndex)
+				if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+					return false, err
+				}
 			}
 
 			err := cs.blockExec.VerifyVoteExtension(context.TODO(), vote)
@@ -2235,7 +2303,11 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 	}
 
 	height := cs.Height
-	added, err = cs.Votes.AddVote(vote, peerID, extEnabled)
+	if preverified {
+		added, err = cs.Votes.AddPreverifiedVote(vote, peerID, extEnabled)
+	} else {
+		added, err = cs.Votes.AddVote(vote, peerID, extEnabled)
+	}
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 
@@ -2403,6 +2475,11 @@ func (cs *State) signVote(
 
 func (cs *State) voteTime() time.Time {
 	now := cmttime.Now()
+	// With proposer-based timestamps, the time of the votes is not used to
+	// compute the block time.
+	if cs.isPBTSEnabled(cs.Height) {
+		return now
+	}
 	minVoteTime := now
 	// Minimum time increment between blocks
 	const timeIota = time.Millisecond
@@ -2455,7 +2532,7 @@ func (cs *State) signAddVote(
 		panic(fmt.Errorf("vote extension absence/presence does not match extensions enabled %t!=%t, height %d, type %v",
 			hasExt, extEnabled, vote.Height, vote.Type))
 	}
-	cs.sendInternalMessage(msgInfo{&VoteMessage{vote}, ""})
+	cs.sendInternalMessage(msgInfo{Msg: &VoteMessage{vote}, PeerID: ""})
 	cs.Logger.Debug("signed and pushed vote", "height", cs.Height, "round", cs.Round, "vote", vote)
 }
 
@@ -26,6 +26,7 @@ import (
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 /*
@@ -262,6 +263,74 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, cmtproto.PrecommitType, propBlock.Hash(), bps2.Header(), true, vs2)
 }
 
+func TestStatePBTSProposalTimeliness(t *testing.T) {
+	for _, testCase := range []struct {
+		name        string
+		timeOffset  time.Duration
+		wantPrevote bool
+	}{
+		{
+			name:        "timely proposal",
+			wantPrevote: true,
+		},
+		{
+			name:        "proposal from the future",
+			timeOffset:  time.Hour,
+			wantPrevote: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cs1, vss := randState(2)
+			height, round := cs1.Height, cs1.Round
+			vs2 := vss[1]
+			cs1.state.ConsensusParams.Feature.PbtsEnableHeight = height
+
+			proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+			voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+			propBlock, err := cs1.createProposalBlock(ctx)
+			require.NoError(t, err)
+
+			// make the second validator the proposer by incrementing round
+			round++
+			incrementRound(vss[1:]...)
+
+			propBlock.Time = propBlock.Time.Add(testCase.timeOffset)
+			propBlockParts, err := propBlock.MakePartSet(types.BlockPartSizeBytes)
+			require.NoError(t, err)
+			blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+			proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+			proposal.Timestamp = propBlock.Time
+			p := proposal.ToProto()
+			require.NoError(t, vs2.SignProposal(cs1.state.ChainID, p))
+			proposal.Signature = p.Signature
+
+			require.NoError(t, cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"))
+
+			startTestRound(cs1, height, round)
+			ensureProposal(proposalCh, height, round, blockID)
+
+			ensurePrevote(voteCh, height, round)
+			if testCase.wantPrevote {
+				validatePrevote(t, cs1, round, vss[0], propBlock.Hash())
+			} else {
+				validatePrevote(t, cs1, round, vss[0], nil)
+			}
+		})
+	}
+}
+
+func TestProposerWaitTime(t *testing.T) {
+	now := cmttime.Now()
+
+	assert.Zero(t, proposerWaitTime(now, now.Add(-time.Second)))
+	assert.Equal(t, time.Nanosecond, proposerWaitTime(now, now))
+	assert.Equal(t, time.Second+time.Nanosecond, proposerWaitTime(now, now.Add(time.Second)))
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	const maxBytes = 2000
 
@@ -2895,26 +2964,26 @@ func TestStateOutputsBlockPartsStats(t *testing.T) {
 	}
 
 	cs.ProposalBlockParts = types.NewPartSetFromHeader(parts.Header())
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{Msg: msg, PeerID: peer.ID()})
 
 	statsMessage := <-cs.statsMsgQueue
 	require.Equal(t, msg, statsMessage.Msg, "")
 	require.Equal(t, peer.ID(), statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(msgInfo{msg, "peer2"})
+	cs.handleMsg(msgInfo{Msg: msg, PeerID: "peer2"})
 
 	// sending the part with the same height, but different round
 	msg.Round = 1
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{Msg: msg, PeerID: peer.ID()})
 
 	// sending the part from the smaller height
 	msg.Height = 0
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{Msg: msg, PeerID: peer.ID()})
 
 	// sending the part from the bigger height
 	msg.Height = 3
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{Msg: msg, PeerID: peer.ID()})
 
 	select {
 	case <-cs.statsMsgQueue:
@@ -2933,20 +3002,20 @@ func TestStateOutputVoteStats(t *testing.T) {
 	vote := signVote(vss[1], cmtproto.PrecommitType, randBytes, types.PartSetHeader{}, true)
 
 	voteMessage := &VoteMessage{vote}
-	cs.handleMsg(msgInfo{voteMessage, peer.ID()})
+	cs.handleMsg(msgInfo{Msg: voteMessage, PeerID: peer.ID()})
 
 	statsMessage := <-cs.statsMsgQueue
 	require.Equal(t, voteMessage, statsMessage.Msg, "")
 	require.Equal(t, peer.ID(), statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(msgInfo{&VoteMessage{vote}, "peer2"})
+	cs.handleMsg(msgInfo{Msg: &VoteMessage{vote}, PeerID: "peer2"})
 
 	// sending the vote for the bigger height
 	incrementHeight(vss[1])
 	vote = signVote(vss[1], cmtproto.PrecommitType, randBytes, types.PartSetHeader{}, true)
 
-	cs.handleMsg(msgInfo{&VoteMessage{vote}, peer.ID()})
+	cs.handleMsg(msgInfo{Msg: &VoteMessage{vote}, PeerID: peer.ID()})
 
 	select {
 	case <-cs.statsMsgQueue:
@@ -131,6 +131,16 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 // Duplicate votes return added=false, err=nil.
 // By convention, peerID is "" if origin is self.
 func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID, extEnabled bool) (added bool, err error) {
+	return hvs.addVote(vote, peerID, extEnabled, false)
+}
+
+// AddPreverifiedVote is like AddVote, except that the signatures of the vote
+// were already verified. See types.VoteSet.AddPreverifiedVote.
+func (hvs *HeightVoteSet) AddPreverifiedVote(vote *types.Vote, peerID p2p.ID, extEnabled bool) (added bool, err error) {
+	return hvs.addVote(vote, peerID, extEnabled, true)
+}
+
+func (hvs *HeightVoteSet) addVote(vote *types.Vote, peerID p2p.ID, extEnabled, preverified bool) (added bool, err error) {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
 	if hvs.extensionsEnabled != extEnabled {
@@ -151,6 +161,9 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID, extEnabled bo
 			return
 		}
 	}
+	if preverified {
+		return voteSet.AddPreverifiedVote(vote)
+	}
 	added, err = voteSet.AddVote(vote)
 	return
 }
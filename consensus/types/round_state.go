@@ -71,14 +71,17 @@ type RoundState struct {
 	StartTime time.Time     `json:"start_time"`
 
 	// Subjective time when +2/3 precommits for Block at Round were found
-	CommitTime         time.Time           `json:"commit_time"`
-	Validators         *types.ValidatorSet `json:"validators"`
-	Proposal           *types.Proposal     `json:"proposal"`
-	ProposalBlock      *types.Block        `json:"proposal_block"`
-	ProposalBlockParts *types.PartSet      `json:"proposal_block_parts"`
-	LockedRound        int32               `json:"locked_round"`
-	LockedBlock        *types.Block        `json:"locked_block"`
-	LockedBlockParts   *types.PartSet      `json:"locked_block_parts"`
+	CommitTime time.Time           `json:"commit_time"`
+	Validators *types.ValidatorSet `json:"validators"`
+	Proposal   *types.Proposal     `json:"proposal"`
+	// Local time when the proposal was received, used by proposer-based
+	// timestamps to check its timeliness
+	ProposalReceiveTime time.Time      `json:"proposal_receive_time"`
+	ProposalBlock       *types.Block   `json:"proposal_block"`
+	ProposalBlockParts  *types.PartSet `json:"proposal_block_parts"`
+	LockedRound         int32          `json:"locked_round"`
+	LockedBlock         *types.Block   `json:"locked_block"`
+	LockedBlockParts    *types.PartSet `json:"locked_block_parts"`
 
 	// The variables below starting with "Valid..." derive their name from
 	// the algorithm presented in this paper:
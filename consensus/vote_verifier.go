@@ -0,0 +1,215 @@
+package consensus
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/batch"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// voteBatchVerifier accumulates the votes received from peers for a short
+// window, and verifies their signatures and extension signatures in batches
+// before passing them to the consensus state, which does not verify them
+// again. The votes of a batch which fails the verification are verified one
+// by one, to drop the invalid ones and punish the peers which sent them.
+//
+// The votes which can't be verified in a batch, e.g. because they are not
+// for the current or the previous height, are passed as is to the consensus
+// state.
+type voteBatchVerifier struct {
+	conS    *State
+	metrics *Metrics
+	window  time.Duration
+	maxSize int
+	votesCh chan msgInfo
+
+	// called with the invalid votes and their verification error
+	onInvalidVote func(mi msgInfo, err error)
+
+	// moving average of the duration of the verification of a single vote,
+	// only accessed by the routine verifying the votes
+	singleVoteDuration time.Duration
+}
+
+func newVoteBatchVerifier(
+	conS *State,
+	metrics *Metrics,
+	window time.Duration,
+	maxSize int,
+	onInvalidVote func(mi msgInfo, err error),
+) *voteBatchVerifier {
+	return &voteBatchVerifier{
+		conS:          conS,
+		metrics:       metrics,
+		window:        window,
+		maxSize:       maxSize,
+		votesCh:       make(chan msgInfo, msgQueueSize),
+		onInvalidVote: onInvalidVote,
+	}
+}
+
+// run accumulates and verifies the votes until quit is closed.
+func (vv *voteBatchVerifier) run(quit <-chan struct{}) {
+	var (
+		votes   []msgInfo
+		timer   *time.Timer
+		timerCh <-chan time.Time
+	)
+	for {
+		select {
+		case <-quit:
+			return
+		case mi := <-vv.votesCh:
+			votes = append(votes, mi)
+			if len(votes) == 1 {
+				timer = time.NewTimer(vv.window)
+				timerCh = timer.C
+			}
+			if len(votes) < vv.maxSize {
+				continue
+			}
+			timer.Stop()
+		case <-timerCh:
+		}
+
+		timerCh = nil
+		for _, mi := range vv.verify(votes) {
+			select {
+			case vv.conS.peerMsgQueue <- mi:
+			case <-quit:
+				return
+			}
+		}
+		votes = votes[:0]
+	}
+}
+
+// voteBatch is a batch of votes whose public keys have the same type.
+type voteBatch struct {
+	verifier crypto.BatchVerifier
+	// indexes of the votes in the verified votes
+	votes []int
+}
+
+// verify verifies the signatures of the votes and returns the valid votes, in
+// the same order, marked as preverified if they were verified.
+func (vv *voteBatchVerifier) verify(votes []msgInfo) []msgInfo {
+	cs := vv.conS
+	cs.mtx.RLock()
+	height, chainID := cs.Height, cs.state.ChainID
+	vals, lastVals := cs.Validators, cs.LastValidators
+	cs.mtx.RUnlock()
+
+	pubKeys := make([]crypto.PubKey, len(votes))
+	batches := make(map[string]*voteBatch)
+	for i, mi := range votes {
+		vote := mi.Msg.(*VoteMessage).Vote
+		var valSet *types.ValidatorSet
+		switch vote.Height {
+		case height:
+			valSet = vals
+		case height - 1:
+			valSet = lastVals
+		default:
+			continue
+		}
+		_, val := valSet.GetByIndex(vote.ValidatorIndex)
+		if val == nil || !bytes.Equal(val.PubKey.Address(), vote.ValidatorAddress) {
+			continue
+		}
+		pubKeys[i] = val.PubKey
+
+		b, ok := batches[val.PubKey.Type()]
+		if !ok {
+			verifier, ok := batch.CreateBatchVerifier(val.PubKey)
+			if !ok {
+				// verified one by one
+				continue
+			}
+			b = &voteBatch{verifier: verifier}
+			batches[val.PubKey.Type()] = b
+		}
+		if b.verifier != nil {
+			if err := addVoteSignatures(b.verifier, chainID, vote, val.PubKey); err != nil {
+				// the batch can't be verified anymore, verify its votes one by one
+				b.verifier = nil
+			}
+		}
+		b.votes = append(b.votes, i)
+	}
+
+	verified := make([]bool, len(votes))
+	for _, b := range batches {
+		vv.metrics.VoteBatchSize.Observe(float64(len(b.votes)))
+		if b.verifier == nil || len(b.votes) == 1 {
+			continue
+		}
+		start := time.Now()
+		if ok, _ := b.verifier.Verify(); !ok {
+			continue
+		}
+		if vv.singleVoteDuration > 0 {
+			saved := time.Duration(len(b.votes))*vv.singleVoteDuration - time.Since(start)
+			if saved > 0 {
+				vv.metrics.VoteBatchTimeSavedSeconds.Add(saved.Seconds())
+			}
+		}
+		for _, i := range b.votes {
+			verified[i] = true
+		}
+	}
+
+	valid := make([]msgInfo, 0, len(votes))
+	for i, mi := range votes {
+		if pubKeys[i] != nil && !verified[i] {
+			start := time.Now()
+			if err := verifyVoteSignatures(chainID, mi.Msg.(*VoteMessage).Vote, pubKeys[i]); err != nil {
+				vv.onInvalidVote(mi, err)
+				continue
+			}
+			vv.recordSingleVoteDuration(time.Since(start))
+			verified[i] = true
+		}
+		mi.preverified = verified[i]
+		valid = append(valid, mi)
+	}
+	return valid
+}
+
+func (vv *voteBatchVerifier) recordSingleVoteDuration(d time.Duration) {
+	if vv.singleVoteDuration == 0 {
+		vv.singleVoteDuration = d
+		return
+	}
+	vv.singleVoteDuration = (9*vv.singleVoteDuration + d) / 10
+}
+
+// hasExtensionSignature returns true if the extension signature of the vote
+// must be verified along with its signature.
+func hasExtensionSignature(vote *types.Vote) bool {
+	return vote.Type == cmtproto.PrecommitType && !vote.BlockID.IsNil() && len(vote.ExtensionSignature) > 0
+}
+
+func addVoteSignatures(verifier crypto.BatchVerifier, chainID string, vote *types.Vote, pubKey crypto.PubKey) error {
+	v := vote.ToProto()
+	if err := verifier.Add(pubKey, types.VoteSignBytes(chainID, v), vote.Signature); err != nil {
+		return err
+	}
+	if hasExtensionSignature(vote) {
+		return verifier.Add(pubKey, types.VoteExtensionSignBytes(chainID, v), vote.ExtensionSignature)
+	}
+	return nil
+}
+
+func verifyVoteSignatures(chainID string, vote *types.Vote, pubKey crypto.PubKey) error {
+	if err := vote.Verify(chainID, pubKey); err != nil {
+		return err
+	}
+	if hasExtensionSignature(vote) {
+		return vote.VerifyExtension(chainID, pubKey)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestVoteBatchVerifier(t *testing.T) {
+	cs, vss := randState(4)
+	blockHash := tmhash.Sum([]byte("block"))
+	header := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))}
+
+	var invalid []msgInfo
+	vv := newVoteBatchVerifier(cs, NopMetrics(), 1, 100, func(mi msgInfo, err error) {
+		assert.ErrorIs(t, err, types.ErrVoteInvalidSignature)
+		invalid = append(invalid, mi)
+	})
+
+	var votes []msgInfo
+	addVote := func(vote *types.Vote, peerID p2p.ID) {
+		votes = append(votes, msgInfo{Msg: &VoteMessage{vote}, PeerID: peerID})
+	}
+	for i, vote := range signVotes(cmtproto.PrevoteType, blockHash, header, false, vss[1:]...) {
+		addVote(vote, p2p.ID(rune('a'+i)))
+	}
+	// a precommit with an invalid extension signature
+	badExt := signVote(vss[1], cmtproto.PrecommitType, blockHash, header, true)
+	badExt.ExtensionSignature = badExt.Signature
+	addVote(badExt, "bad-ext")
+	// a precommit with an invalid signature
+	badSig := signVote(vss[2], cmtproto.PrecommitType, blockHash, header, true)
+	badSig.Signature = badSig.ExtensionSignature
+	addVote(badSig, "bad-sig")
+	// a valid precommit
+	addVote(signVote(vss[3], cmtproto.PrecommitType, blockHash, header, true), "good")
+	// a vote of a future height is not verified
+	incrementHeight(vss[3])
+	addVote(signVote(vss[3], cmtproto.PrevoteType, blockHash, header, false), "future")
+
+	valid := vv.verify(votes)
+	require.Len(t, valid, 5)
+	for i, mi := range append(votes[:3:3], votes[5:]...) {
+		assert.Equal(t, mi.Msg, valid[i].Msg)
+		assert.Equal(t, mi.PeerID, valid[i].PeerID)
+		assert.Equal(t, i < 4, valid[i].preverified, i)
+	}
+	require.Len(t, invalid, 2)
+	assert.Equal(t, p2p.ID("bad-ext"), invalid[0].PeerID)
+	assert.Equal(t, p2p.ID("bad-sig"), invalid[1].PeerID)
+
+	// the preverified votes are added to the consensus state
+	cs.handleMsg(valid[0])
+	prevotes := cs.Votes.Prevotes(0)
+	require.NotNil(t, prevotes)
+	assert.True(t, prevotes.BitArray().GetIndex(int(vss[1].Index)))
+}
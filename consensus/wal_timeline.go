@@ -0,0 +1,319 @@
+package consensus
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Types of the events of a WAL timeline.
+const (
+	// The node entered a new step of the round.
+	TimelineEventStep = "step"
+	// A proposal was received or created by the node.
+	TimelineEventProposal = "proposal"
+	// All the parts of the proposal block were received.
+	TimelineEventBlockComplete = "block_complete"
+	// +2/3 of the voting power prevoted (for a block, nil or any).
+	TimelineEventPrevoteMaj23 = "prevote_maj23"
+	// +2/3 of the voting power precommitted (for a block, nil or any).
+	TimelineEventPrecommitMaj23 = "precommit_maj23"
+	// A timeout fired.
+	TimelineEventTimeout = "timeout"
+)
+
+// WALTimelineOptions are optional arguments to BuildWALTimeline.
+type WALTimelineOptions struct {
+	// MinHeight and MaxHeight bound the heights of the timeline. They are
+	// unbounded if 0.
+	MinHeight int64
+	MaxHeight int64
+	// Validators returns the validator set of the given height. It is needed
+	// to detect the +2/3 majorities and the late votes, which are not detected
+	// at the heights whose validators can't be loaded.
+	Validators func(height int64) (*types.ValidatorSet, error)
+	// IgnoreDataCorruptionErrors set to true will result in skipping data
+	// corruption errors.
+	IgnoreDataCorruptionErrors bool
+}
+
+// HeightTimeline is the timeline of the rounds of a height, reconstructed
+// from the messages of the WAL.
+type HeightTimeline struct {
+	Height int64     `json:"height"`
+	Start  time.Time `json:"start"`
+	// End is the time the block of the height was committed, or nil if it was
+	// not.
+	End    *time.Time       `json:"end,omitempty"`
+	Rounds []*RoundTimeline `json:"rounds"`
+}
+
+// RoundTimeline is the timeline of a round.
+type RoundTimeline struct {
+	Round  int32           `json:"round"`
+	Events []TimelineEvent `json:"events"`
+	// LateVotes are the votes received after +2/3 of the voting power had
+	// already voted, in the order they were received.
+	LateVotes []LateVote `json:"late_votes,omitempty"`
+}
+
+// TimelineEvent is an event of a round.
+type TimelineEvent struct {
+	Time   time.Time `json:"time"`
+	Type   string    `json:"type"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// LateVote is a vote received after +2/3 of the voting power had voted.
+type LateVote struct {
+	Type      string        `json:"type"`
+	Validator types.Address `json:"validator"`
+	// Delay between the +2/3 majority and the reception of the vote.
+	Delay time.Duration `json:"delay"`
+}
+
+// BuildWALTimeline decodes the messages of a WAL until the end of the file and
+// reconstructs the timeline of the heights. If opts.MaxHeight is set, it
+// stops decoding at the end of the following height, during which the late
+// precommits of opts.MaxHeight are received.
+func BuildWALTimeline(dec *WALDecoder, opts WALTimelineOptions) ([]*HeightTimeline, error) {
+	b := &walTimelineBuilder{
+		opts:    opts,
+		heights: make(map[int64]*heightState),
+	}
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if opts.IgnoreDataCorruptionErrors && IsDataCorruptionError(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if m, ok := msg.Msg.(EndHeightMessage); ok && opts.MaxHeight > 0 && m.Height > opts.MaxHeight {
+			break
+		}
+		b.add(msg)
+	}
+	return b.timelines(), nil
+}
+
+type walTimelineBuilder struct {
+	opts    WALTimelineOptions
+	heights map[int64]*heightState
+}
+
+type heightState struct {
+	timeline *HeightTimeline
+	rounds   map[int32]*roundState
+	valSet   *types.ValidatorSet
+	// the validators of the height could not be loaded
+	noValSet bool
+}
+
+type roundState struct {
+	timeline      *RoundTimeline
+	votes         map[cmtproto.SignedMsgType]*voteTally
+	partsTotal    uint32
+	parts         map[uint32]struct{}
+	blockComplete bool
+}
+
+// voteTally counts the votes of a type in a round.
+type voteTally struct {
+	voted   map[int32]struct{}
+	power   int64
+	byBlock map[string]int64
+	// time +2/3 of the voting power had voted, zero if not yet
+	maj23 time.Time
+}
+
+func (b *walTimelineBuilder) add(msg *TimedWALMessage) {
+	switch m := msg.Msg.(type) {
+	case types.EventDataRoundState:
+		b.round(m.Height, m.Round, msg.Time).addEvent(msg.Time, TimelineEventStep, m.Step)
+
+	case timeoutInfo:
+		b.round(m.Height, m.Round, msg.Time).addEvent(msg.Time, TimelineEventTimeout,
+			fmt.Sprintf("%v after %v", m.Step, m.Duration))
+
+	case EndHeightMessage:
+		if h := b.height(m.Height, msg.Time); h != nil {
+			end := msg.Time
+			h.timeline.End = &end
+		}
+
+	case msgInfo:
+		switch cm := m.Msg.(type) {
+		case *ProposalMessage:
+			p := cm.Proposal
+			r := b.round(p.Height, p.Round, msg.Time)
+			if r == nil {
+				return
+			}
+			r.addEvent(msg.Time, TimelineEventProposal, fmt.Sprintf("block %v (POL round %d) from %s",
+				p.BlockID.Hash, p.POLRound, peerName(m.PeerID)))
+			r.partsTotal = p.BlockID.PartSetHeader.Total
+			r.checkBlockComplete(msg.Time)
+
+		case *BlockPartMessage:
+			r := b.round(cm.Height, cm.Round, msg.Time)
+			if r == nil {
+				return
+			}
+			r.parts[cm.Part.Index] = struct{}{}
+			r.checkBlockComplete(msg.Time)
+
+		case *VoteMessage:
+			b.addVote(cm.Vote, msg.Time)
+		}
+	}
+}
+
+// height returns the state of the given height, or nil if the height is out
+// of the bounds of the timeline.
+func (b *walTimelineBuilder) height(height int64, t time.Time) *heightState {
+	if height < 1 || height < b.opts.MinHeight || (b.opts.MaxHeight > 0 && height > b.opts.MaxHeight) {
+		return nil
+	}
+	h, ok := b.heights[height]
+	if !ok {
+		h = &heightState{
+			timeline: &HeightTimeline{Height: height, Start: t},
+			rounds:   make(map[int32]*roundState),
+		}
+		b.heights[height] = h
+	}
+	return h
+}
+
+func (b *walTimelineBuilder) round(height int64, round int32, t time.Time) *roundState {
+	h := b.height(height, t)
+	if h == nil {
+		return nil
+	}
+	r, ok := h.rounds[round]
+	if !ok {
+		r = &roundState{
+			timeline: &RoundTimeline{Round: round},
+			votes:    make(map[cmtproto.SignedMsgType]*voteTally),
+			parts:    make(map[uint32]struct{}),
+		}
+		h.rounds[round] = r
+	}
+	return r
+}
+
+func (b *walTimelineBuilder) addVote(vote *types.Vote, t time.Time) {
+	h := b.height(vote.Height, t)
+	if h == nil || b.opts.Validators == nil {
+		return
+	}
+	if h.valSet == nil && !h.noValSet {
+		valSet, err := b.opts.Validators(vote.Height)
+		if err != nil {
+			h.noValSet = true
+			return
+		}
+		h.valSet = valSet
+	}
+	if h.noValSet {
+		return
+	}
+	_, val := h.valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return
+	}
+
+	r := b.round(vote.Height, vote.Round, t)
+	tally, ok := r.votes[vote.Type]
+	if !ok {
+		tally = &voteTally{voted: make(map[int32]struct{}), byBlock: make(map[string]int64)}
+		r.votes[vote.Type] = tally
+	}
+	if _, ok := tally.voted[vote.ValidatorIndex]; ok {
+		return
+	}
+	tally.voted[vote.ValidatorIndex] = struct{}{}
+
+	voteType := "prevote"
+	event := TimelineEventPrevoteMaj23
+	if vote.Type == cmtproto.PrecommitType {
+		voteType = "precommit"
+		event = TimelineEventPrecommitMaj23
+	}
+	if !tally.maj23.IsZero() {
+		r.timeline.LateVotes = append(r.timeline.LateVotes, LateVote{
+			Type:      voteType,
+			Validator: val.Address,
+			Delay:     t.Sub(tally.maj23),
+		})
+		return
+	}
+
+	key := vote.BlockID.Key()
+	tally.power += val.VotingPower
+	tally.byBlock[key] += val.VotingPower
+	quorum := h.valSet.TotalVotingPower()*2/3 + 1
+	if tally.power < quorum {
+		return
+	}
+	tally.maj23 = t
+	detail := "any"
+	switch {
+	case tally.byBlock[key] < quorum:
+	case vote.BlockID.IsNil():
+		detail = "nil"
+	default:
+		detail = fmt.Sprintf("block %v", vote.BlockID.Hash)
+	}
+	r.addEvent(t, event, detail)
+}
+
+func (r *roundState) addEvent(t time.Time, eventType, detail string) {
+	if r == nil {
+		return
+	}
+	r.timeline.Events = append(r.timeline.Events, TimelineEvent{Time: t, Type: eventType, Detail: detail})
+}
+
+// checkBlockComplete adds the event of the completion of the proposal block
+// once all its parts are received.
+func (r *roundState) checkBlockComplete(t time.Time) {
+	if r.blockComplete || r.partsTotal == 0 || uint32(len(r.parts)) != r.partsTotal {
+		return
+	}
+	r.blockComplete = true
+	r.addEvent(t, TimelineEventBlockComplete, fmt.Sprintf("%d parts", r.partsTotal))
+}
+
+func (b *walTimelineBuilder) timelines() []*HeightTimeline {
+	timelines := make([]*HeightTimeline, 0, len(b.heights))
+	for _, h := range b.heights {
+		for _, r := range h.rounds {
+			h.timeline.Rounds = append(h.timeline.Rounds, r.timeline)
+		}
+		sort.Slice(h.timeline.Rounds, func(i, j int) bool {
+			return h.timeline.Rounds[i].Round < h.timeline.Rounds[j].Round
+		})
+		timelines = append(timelines, h.timeline)
+	}
+	sort.Slice(timelines, func(i, j int) bool {
+		return timelines[i].Height < timelines[j].Height
+	})
+	return timelines
+}
+
+func peerName(peerID p2p.ID) string {
+	if peerID == "" {
+		return "this node"
+	}
+	return "peer " + string(peerID)
+}
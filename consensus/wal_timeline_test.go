@@ -0,0 +1,124 @@
+package consensus
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestBuildWALTimeline(t *testing.T) {
+	const chainID = "timeline-chain"
+	valSet, privVals := types.RandValidatorSet(4, 10)
+
+	parts := types.NewPartSetFromData(bytes.Repeat([]byte("block"), 100), 128)
+	blockID := types.BlockID{Hash: []byte("blockhash_______________________"), PartSetHeader: parts.Header()}
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var msgs []*TimedWALMessage
+	at := func(ms int, msg WALMessage) {
+		msgs = append(msgs, &TimedWALMessage{Time: start.Add(time.Duration(ms) * time.Millisecond), Msg: msg})
+	}
+	vote := func(ms int, val int, round int32, voteType cmtproto.SignedMsgType, blockID types.BlockID) {
+		addr, err := privVals[val].GetPubKey()
+		require.NoError(t, err)
+		v := &types.Vote{
+			Type:             voteType,
+			Height:           2,
+			Round:            round,
+			BlockID:          blockID,
+			Timestamp:        start,
+			ValidatorAddress: addr.Address(),
+			ValidatorIndex:   int32(val),
+		}
+		pv := v.ToProto()
+		require.NoError(t, privVals[val].SignVote(chainID, pv))
+		v.Signature = pv.Signature
+		at(ms, msgInfo{Msg: &VoteMessage{Vote: v}, PeerID: "peer"})
+	}
+
+	at(0, EndHeightMessage{Height: 1})
+	at(1, types.EventDataRoundState{Height: 2, Round: 0, Step: "RoundStepNewHeight"})
+	at(2, types.EventDataRoundState{Height: 2, Round: 0, Step: "RoundStepPropose"})
+	// round 0 times out without a proposal, the validators prevote nil
+	at(3000, timeoutInfo{Duration: 3 * time.Second, Height: 2, Round: 0, Step: cstypes.RoundStepPropose})
+	for i := 0; i < 4; i++ {
+		vote(3010+i, i, 0, cmtproto.PrevoteType, types.BlockID{})
+	}
+	at(3020, timeoutInfo{Duration: time.Second, Height: 2, Round: 0, Step: cstypes.RoundStepPrecommitWait})
+
+	// round 1 commits the block
+	at(4000, types.EventDataRoundState{Height: 2, Round: 1, Step: "RoundStepPropose"})
+	proposal := types.NewProposal(2, 1, -1, blockID)
+	pp := proposal.ToProto()
+	require.NoError(t, privVals[0].SignProposal(chainID, pp))
+	proposal.Signature = pp.Signature
+	at(4100, msgInfo{Msg: &ProposalMessage{Proposal: proposal}, PeerID: "proposer"})
+	for i := 0; i < int(parts.Total()); i++ {
+		at(4200+i, msgInfo{Msg: &BlockPartMessage{Height: 2, Round: 1, Part: parts.GetPart(i)}, PeerID: "proposer"})
+	}
+	for i := 0; i < 3; i++ {
+		vote(4300+i, i, 1, cmtproto.PrevoteType, blockID)
+		vote(4400+i, i, 1, cmtproto.PrecommitType, blockID)
+	}
+	at(4500, EndHeightMessage{Height: 2})
+	at(4501, types.EventDataRoundState{Height: 3, Round: 0, Step: "RoundStepNewHeight"})
+	// the fourth validator is late, and a duplicate vote is ignored
+	vote(4600, 3, 1, cmtproto.PrecommitType, blockID)
+	vote(4700, 3, 1, cmtproto.PrecommitType, blockID)
+	at(5000, EndHeightMessage{Height: 3})
+	at(5001, types.EventDataRoundState{Height: 4, Round: 0, Step: "RoundStepNewHeight"})
+
+	buf := new(bytes.Buffer)
+	enc := NewWALEncoder(buf)
+	for _, msg := range msgs {
+		require.NoError(t, enc.Encode(msg))
+	}
+
+	timelines, err := BuildWALTimeline(NewWALDecoder(buf), WALTimelineOptions{
+		MinHeight: 2,
+		MaxHeight: 2,
+		Validators: func(height int64) (*types.ValidatorSet, error) {
+			assert.EqualValues(t, 2, height)
+			return valSet, nil
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, timelines, 1)
+	h := timelines[0]
+	assert.EqualValues(t, 2, h.Height)
+	assert.Equal(t, start.Add(time.Millisecond), h.Start)
+	require.NotNil(t, h.End)
+	assert.Equal(t, start.Add(4500*time.Millisecond), *h.End)
+	require.Len(t, h.Rounds, 2)
+
+	eventTypes := func(r *RoundTimeline) []string {
+		evTypes := make([]string, len(r.Events))
+		for i, e := range r.Events {
+			evTypes[i] = e.Type
+		}
+		return evTypes
+	}
+	assert.Equal(t, []string{
+		TimelineEventStep, TimelineEventStep, TimelineEventTimeout, TimelineEventPrevoteMaj23, TimelineEventTimeout,
+	}, eventTypes(h.Rounds[0]))
+	assert.Equal(t, "nil", h.Rounds[0].Events[3].Detail)
+	assert.Equal(t, start.Add(3012*time.Millisecond), h.Rounds[0].Events[3].Time)
+	require.Len(t, h.Rounds[0].LateVotes, 1)
+	assert.Equal(t, LateVote{Type: "prevote", Validator: valSet.Validators[3].Address, Delay: time.Millisecond},
+		h.Rounds[0].LateVotes[0])
+
+	assert.Equal(t, []string{
+		TimelineEventStep, TimelineEventProposal, TimelineEventBlockComplete, TimelineEventPrevoteMaj23,
+		TimelineEventPrecommitMaj23,
+	}, eventTypes(h.Rounds[1]))
+	assert.Equal(t, start.Add(4402*time.Millisecond), h.Rounds[1].Events[4].Time)
+	assert.Equal(t, []LateVote{{Type: "precommit", Validator: valSet.Validators[3].Address, Delay: 198 * time.Millisecond}},
+		h.Rounds[1].LateVotes)
+}
@@ -2,8 +2,10 @@ package evidence
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,14 +18,16 @@ import (
 
 	clist "github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
 )
 
 const (
-	baseKeyCommitted = byte(0x00)
-	baseKeyPending   = byte(0x01)
+	baseKeyCommitted   = byte(0x00)
+	baseKeyPending     = byte(0x01)
+	baseKeyCommittedIn = byte(0x02)
 )
 
 // Pool maintains a pool of valid evidence to be broadcasted and committed
@@ -81,6 +85,10 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool,
 		pool.evidenceList.PushBack(ev)
 	}
 
+	if err := pool.indexCommittedEvidence(); err != nil {
+		return nil, fmt.Errorf("cannot index committed evidence: %w", err)
+	}
+
 	return pool, nil
 }
 
@@ -96,6 +104,184 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	return evidence, size
 }
 
+// PendingEvidenceInRange returns the pending evidence of misbehavior which
+// happened between minHeight and maxHeight (inclusive), from oldest to newest.
+func (evpool *Pool) PendingEvidenceInRange(minHeight, maxHeight int64) ([]types.Evidence, error) {
+	iter, err := evpool.evidenceStore.Iterator(keyRange(baseKeyPending, minHeight, maxHeight))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+
+	var evidence []types.Evidence
+	for ; iter.Valid(); iter.Next() {
+		ev, err := bytesToEv(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, ev)
+	}
+	return evidence, iter.Error()
+}
+
+// CommittedEvidence is evidence committed in the block at Height.
+type CommittedEvidence struct {
+	Evidence types.Evidence
+	Height   int64
+}
+
+// CommittedEvidenceInRange returns a page of the committed evidence of
+// misbehavior which happened between minHeight and maxHeight (inclusive) and
+// is matched by match (any evidence if nil), from oldest to newest: it skips
+// the first skip entries and returns at most limit entries, along with the
+// total count. The evidence is loaded from the block store, so the evidence
+// of the pruned blocks is not returned. Only the blocks of the returned
+// evidence are loaded, unless match is set.
+func (evpool *Pool) CommittedEvidenceInRange(
+	minHeight, maxHeight int64,
+	match func(types.Evidence) bool,
+	skip, limit int,
+) ([]CommittedEvidence, int, error) {
+	iter, err := evpool.evidenceStore.Iterator(keyRange(baseKeyCommittedIn, minHeight, maxHeight))
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+
+	var (
+		evidence []CommittedEvidence
+		total    int
+		block    *types.Block
+		base     = evpool.blockStore.Base()
+	)
+	for ; iter.Valid(); iter.Next() {
+		var h gogotypes.Int64Value
+		if err := proto.Unmarshal(iter.Value(), &h); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling committed evidence height: %w", err)
+		}
+		// The height is 0 if the block was not found when indexing the
+		// evidence committed by older versions.
+		height := h.Value
+		if height == 0 || height < base {
+			continue
+		}
+		if match == nil && (total < skip || len(evidence) >= limit) {
+			total++
+			continue
+		}
+
+		hash, err := hashFromKey(iter.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+		if block == nil || block.Height != height {
+			if block = evpool.blockStore.LoadBlock(height); block == nil {
+				continue
+			}
+		}
+		ev := findEvidence(block, hash)
+		if ev == nil || (match != nil && !match(ev)) {
+			continue
+		}
+		if total >= skip && len(evidence) < limit {
+			evidence = append(evidence, CommittedEvidence{Evidence: ev, Height: height})
+		}
+		total++
+	}
+	return evidence, total, iter.Error()
+}
+
+// indexCommittedEvidence records the height of the block in which the
+// evidence committed by older versions, which only record the height of the
+// misbehavior, was committed. The blocks with evidence are searched once, from
+// the oldest misbehavior. The height is set to 0 for the evidence which is not
+// found, e.g. because the block was pruned.
+func (evpool *Pool) indexCommittedEvidence() error {
+	iter, err := evpool.evidenceStore.Iterator(keyRange(baseKeyCommitted, 0, math.MaxInt64))
+	if err != nil {
+		return fmt.Errorf("database error: %v", err)
+	}
+	unindexed := make(map[string][]byte)
+	minHeight := int64(math.MaxInt64)
+	for ; iter.Valid(); iter.Next() {
+		key := append([]byte{baseKeyCommittedIn}, iter.Key()[1:]...)
+		has, err := evpool.evidenceStore.Has(key)
+		if err != nil {
+			iter.Close()
+			return fmt.Errorf("database error: %v", err)
+		}
+		if has {
+			continue
+		}
+		hash, err := hashFromKey(iter.Key())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		var h gogotypes.Int64Value
+		if err := proto.Unmarshal(iter.Value(), &h); err != nil {
+			iter.Close()
+			return fmt.Errorf("unmarshaling committed evidence height: %w", err)
+		}
+		unindexed[string(hash)] = key
+		minHeight = cmtmath.MinInt64(minHeight, h.Value)
+	}
+	if err := iter.Error(); err != nil {
+		iter.Close()
+		return err
+	}
+	iter.Close()
+	if len(unindexed) == 0 {
+		return nil
+	}
+
+	evpool.logger.Info("Indexing the blocks of the committed evidence", "evidence", len(unindexed))
+	emptyEvidenceHash := (&types.EvidenceData{}).Hash()
+	setHeight := func(key []byte, height int64) error {
+		bz, err := proto.Marshal(&gogotypes.Int64Value{Value: height})
+		if err != nil {
+			return err
+		}
+		return evpool.evidenceStore.Set(key, bz)
+	}
+	for h := cmtmath.MaxInt64(minHeight+1, evpool.blockStore.Base()); h <= evpool.blockStore.Height() && len(unindexed) > 0; h++ {
+		meta := evpool.blockStore.LoadBlockMeta(h)
+		if meta == nil || bytes.Equal(meta.Header.EvidenceHash, emptyEvidenceHash) {
+			continue
+		}
+		block := evpool.blockStore.LoadBlock(h)
+		if block == nil {
+			continue
+		}
+		for _, ev := range block.Evidence.Evidence {
+			key, ok := unindexed[string(ev.Hash())]
+			if !ok {
+				continue
+			}
+			if err := setHeight(key, h); err != nil {
+				return err
+			}
+			delete(unindexed, string(ev.Hash()))
+		}
+	}
+	for _, key := range unindexed {
+		if err := setHeight(key, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findEvidence returns the evidence of the block with the given hash, or nil.
+func findEvidence(block *types.Block, hash []byte) types.Evidence {
+	for _, ev := range block.Evidence.Evidence {
+		if bytes.Equal(ev.Hash(), hash) {
+			return ev
+		}
+	}
+	return nil
+}
+
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
 //  1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
@@ -122,7 +308,7 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.updateState(state)
 
 	// move committed evidence out from the pending pool and into the committed pool
-	evpool.markEvidenceAsCommitted(ev)
+	evpool.markEvidenceAsCommitted(ev, state.LastBlockHeight)
 
 	// prune pending evidence when it has expired. This also updates when the next evidence will expire
 	if evpool.Size() > 0 && state.LastBlockHeight > evpool.pruningHeight &&
@@ -324,9 +510,9 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 	}
 }
 
-// markEvidenceAsCommitted processes all the evidence in the block, marking it as
-// committed and removing it from the pending database.
-func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
+// markEvidenceAsCommitted processes all the evidence in the block at the given
+// height, marking it as committed and removing it from the pending database.
+func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height int64) {
 	blockEvidenceMap := make(map[string]struct{}, len(evidence))
 	for _, ev := range evidence {
 		if evpool.isPending(ev) {
@@ -335,7 +521,8 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 		}
 
 		// Add evidence to the committed list. As the evidence is stored in the block store
-		// we only need to record the height that it was saved at.
+		// we only need to record the height that it was saved at, and the height of the
+		// block that it was committed in.
 		key := keyCommitted(ev)
 
 		h := gogotypes.Int64Value{Value: ev.Height()}
@@ -348,6 +535,15 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 		if err := evpool.evidenceStore.Set(key, evBytes); err != nil {
 			evpool.logger.Error("Unable to save committed evidence", "err", err, "key(height/hash)", key)
 		}
+
+		h = gogotypes.Int64Value{Value: height}
+		if evBytes, err = proto.Marshal(&h); err != nil {
+			evpool.logger.Error("failed to marshal committed evidence height", "err", err, "key(height/hash)", key)
+			continue
+		}
+		if err := evpool.evidenceStore.Set(keyCommittedIn(ev), evBytes); err != nil {
+			evpool.logger.Error("Unable to save committed evidence height", "err", err, "key(height/hash)", key)
+		}
 	}
 
 	// remove committed evidence from the clist
@@ -565,6 +761,10 @@ func keyCommitted(evidence types.Evidence) []byte {
 	return append([]byte{baseKeyCommitted}, keySuffix(evidence)...)
 }
 
+func keyCommittedIn(evidence types.Evidence) []byte {
+	return append([]byte{baseKeyCommittedIn}, keySuffix(evidence)...)
+}
+
 func keyPending(evidence types.Evidence) []byte {
 	return append([]byte{baseKeyPending}, keySuffix(evidence)...)
 }
@@ -572,3 +772,22 @@ func keyPending(evidence types.Evidence) []byte {
 func keySuffix(evidence types.Evidence) []byte {
 	return []byte(fmt.Sprintf("%s/%X", bE(evidence.Height()), evidence.Hash()))
 }
+
+// keyRange returns the range of the keys with the given prefix of the evidence
+// between minHeight and maxHeight (inclusive).
+func keyRange(prefixKey byte, minHeight, maxHeight int64) (start, end []byte) {
+	start = append([]byte{prefixKey}, bE(minHeight)...)
+	if maxHeight == math.MaxInt64 {
+		return start, []byte{prefixKey + 1}
+	}
+	return start, append([]byte{prefixKey}, bE(maxHeight+1)...)
+}
+
+// hashFromKey returns the hash of the evidence from its key.
+func hashFromKey(key []byte) ([]byte, error) {
+	i := bytes.IndexByte(key, '/')
+	if i < 0 {
+		return nil, fmt.Errorf("invalid evidence key %X", key)
+	}
+	return hex.DecodeString(string(key[i+1:]))
+}
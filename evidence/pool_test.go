@@ -1,10 +1,14 @@
 package evidence_test
 
 import (
+	"fmt"
+	"math"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -217,6 +221,102 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	}
 }
 
+func TestEvidenceInRange(t *testing.T) {
+	height := int64(21)
+	val := types.NewMockPV()
+	stateStore := initializeValidatorState(val, height)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	blockStore, err := initializeBlockStore(dbm.NewMemDB(), state, val.PrivKey.PubKey().Address())
+	require.NoError(t, err)
+	evidenceDB := dbm.NewMemDB()
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+
+	evs := make([]types.Evidence, 0, 3)
+	for _, h := range []int64{5, 10, 15} {
+		ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(h, defaultEvidenceTime.Add(time.Duration(h)*time.Minute),
+			val, evidenceChainID)
+		require.NoError(t, err)
+		require.NoError(t, pool.AddEvidence(ev))
+		evs = append(evs, ev)
+	}
+
+	pending, err := pool.PendingEvidenceInRange(0, math.MaxInt64)
+	require.NoError(t, err)
+	assert.Equal(t, evs, pending)
+	pending, err = pool.PendingEvidenceInRange(6, 15)
+	require.NoError(t, err)
+	assert.Equal(t, evs[1:], pending)
+	pending, err = pool.PendingEvidenceInRange(5, 14)
+	require.NoError(t, err)
+	assert.Equal(t, evs[:2], pending)
+
+	// commit the evidence at height 10 in the next block
+	lastExtCommit := makeExtCommit(height, val.PrivKey.PubKey().Address())
+	lastExtCommit.BlockID = blockStore.LoadBlockMeta(height).BlockID
+	block := state.MakeBlock(height+1, []types.Tx{}, lastExtCommit.ToCommit(), evs[1:2], state.Validators.Proposer.Address)
+	block.Header.Version = cmtversion.Consensus{Block: version.BlockProtocol, App: 1}
+	partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockStore.SaveBlockWithExtendedCommit(block, partSet, makeExtCommit(height+1, val.PrivKey.PubKey().Address()))
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = defaultEvidenceTime.Add(22 * time.Minute)
+	pool.Update(state, block.Evidence.Evidence)
+
+	pending, err = pool.PendingEvidenceInRange(0, math.MaxInt64)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Evidence{evs[0], evs[2]}, pending)
+
+	committed, total, err := pool.CommittedEvidenceInRange(0, math.MaxInt64, nil, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, committed, 1)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, evs[1].Hash(), committed[0].Evidence.Hash())
+	assert.Equal(t, height+1, committed[0].Height)
+	committed, total, err = pool.CommittedEvidenceInRange(11, 20, nil, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, committed)
+	assert.Zero(t, total)
+
+	// Only the evidence of the page is returned, with the total count.
+	committed, total, err = pool.CommittedEvidenceInRange(0, math.MaxInt64, nil, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, committed)
+	assert.Equal(t, 1, total)
+	committed, total, err = pool.CommittedEvidenceInRange(0, math.MaxInt64, func(types.Evidence) bool {
+		return false
+	}, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, committed)
+	assert.Zero(t, total)
+
+	// The committed evidence is recorded with the height of the misbehavior, as
+	// by older versions, which don't record the height of the block it was
+	// committed in.
+	suffix := fmt.Sprintf("%0.16X/%X", evs[1].Height(), evs[1].Hash())
+	bz, err := evidenceDB.Get(append([]byte{0x00}, suffix...))
+	require.NoError(t, err)
+	var h gogotypes.Int64Value
+	require.NoError(t, proto.Unmarshal(bz, &h))
+	assert.Equal(t, evs[1].Height(), h.Value)
+
+	// The block of the evidence committed by older versions is searched when
+	// the pool is created.
+	has, err := evidenceDB.Has(append([]byte{0x02}, suffix...))
+	require.NoError(t, err)
+	require.True(t, has)
+	require.NoError(t, evidenceDB.Delete(append([]byte{0x02}, suffix...)))
+	pool, err = evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+	committed, total, err = pool.CommittedEvidenceInRange(0, math.MaxInt64, nil, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, committed, 1)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, evs[1].Hash(), committed[0].Evidence.Hash())
+	assert.Equal(t, height+1, committed[0].Height)
+}
+
 func TestVerifyPendingEvidencePasses(t *testing.T) {
 	var height int64 = 1
 	pool, val := defaultTestPool(t, height)
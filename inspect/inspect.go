@@ -69,11 +69,10 @@ func New(
 
 // NewFromConfig constructs an Inspector using the values defined in the passed in config.
 func NewFromConfig(cfg *config.Config) (*Inspector, error) {
-	bsDB, err := config.DefaultDBProvider(&config.DBContext{ID: "blockstore", Config: cfg})
+	bs, err := store.NewBlockStoreFromConfig(cfg, config.DefaultDBProvider)
 	if err != nil {
 		return nil, err
 	}
-	bs := store.NewBlockStore(bsDB)
 	sDB, err := config.DefaultDBProvider(&config.DBContext{ID: "state", Config: cfg})
 	if err != nil {
 		return nil, err
@@ -326,6 +326,24 @@ func (g *Group) RotateFile() {
 	g.maxIndex++
 }
 
+// RemoveFilesBefore removes all the rotated files of the group with an index
+// lower than index. The head can not be removed this way.
+func (g *Group) RemoveFilesBefore(index int) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if index > g.maxIndex {
+		index = g.maxIndex
+	}
+	for ; g.minIndex < index; g.minIndex++ {
+		pathToRemove := filePathForIndex(g.Head.Path, g.minIndex, g.maxIndex)
+		if err := os.Remove(pathToRemove); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewReader returns a new group reader.
 // CONTRACT: Caller must close the returned GroupReader.
 func (g *Group) NewReader(index int) (*GroupReader, error) {
@@ -288,3 +288,32 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestRemoveFilesBefore(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+
+	for i := 0; i < 3; i++ {
+		err := g.WriteLine("Line")
+		require.NoError(t, err)
+		g.RotateFile()
+	}
+	err := g.WriteLine("Head")
+	require.NoError(t, err)
+	err = g.FlushAndSync()
+	require.NoError(t, err)
+	assertGroupInfo(t, g.ReadGroupInfo(), 0, 3, 20, 5)
+
+	err = g.RemoveFilesBefore(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, g.MinIndex())
+	assertGroupInfo(t, g.ReadGroupInfo(), 2, 3, 10, 5)
+
+	// The head is never removed.
+	err = g.RemoveFilesBefore(10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, g.MinIndex())
+	assertGroupInfo(t, g.ReadGroupInfo(), 0, 0, 5, 5)
+
+	// Cleanup
+	destroyTestGroup(t, g)
+}
@@ -2,6 +2,7 @@
 // subscriptions in CometBFT.
 //
 //	abci.invoice.number=22 AND abci.invoice.owner=Ivan
+//	transfer.sender='x' OR (transfer.recipient='x' AND NOT transfer.amount < 10)
 //
 // Query expressions can handle attribute values encoding numbers, strings,
 // dates, and timestamps.  The complete query grammar is described in the
@@ -25,7 +26,7 @@ var All *Query
 // A Query is the compiled form of a query.
 type Query struct {
 	ast   syntax.Query
-	conds []condition
+	match matcher
 }
 
 // New parses and compiles the query expression into an executable query.
@@ -52,15 +53,14 @@ func MustCompile(query string) *Query {
 
 // Compile compiles the given query AST so it can be used to match events.
 func Compile(ast syntax.Query) (*Query, error) {
-	conds := make([]condition, len(ast))
-	for i, q := range ast {
-		cond, err := compileCondition(q)
-		if err != nil {
-			return nil, fmt.Errorf("compile %s: %w", q, err)
-		}
-		conds[i] = cond
+	if ast.Expr == nil {
+		return &Query{ast: ast, match: func([]types.Event) bool { return true }}, nil
 	}
-	return &Query{ast: ast, conds: conds}, nil
+	match, err := compileExpr(ast.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Query{ast: ast, match: match}, nil
 }
 
 func ExpandEvents(flattenedEvents map[string][]string) []types.Event {
@@ -106,19 +106,75 @@ func (q *Query) String() string {
 // Syntax returns the syntax tree representation of q.
 func (q *Query) Syntax() syntax.Query {
 	if q == nil {
-		return nil
+		return syntax.Query{}
 	}
 	return q.ast
 }
 
-// matchesEvents reports whether all the conditions match the given events.
+// matchesEvents reports whether the query matches the given events.
 func (q *Query) matchesEvents(events []types.Event) bool {
-	for _, cond := range q.conds {
-		if !cond.matchesAny(events) {
+	return len(events) != 0 && q.match(events)
+}
+
+// A matcher is a compiled expression of a query, which reports whether it
+// matches the given events.
+type matcher func(events []types.Event) bool
+
+func compileExpr(expr syntax.Expr) (matcher, error) {
+	switch expr := expr.(type) {
+	case syntax.Condition:
+		cond, err := compileCondition(expr)
+		if err != nil {
+			return nil, fmt.Errorf("compile %s: %w", expr, err)
+		}
+		return cond.matchesAny, nil
+	case syntax.Not:
+		match, err := compileExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(events []types.Event) bool { return !match(events) }, nil
+	case syntax.And:
+		matchers, err := compileExprs(expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(events []types.Event) bool {
+			for _, match := range matchers {
+				if !match(events) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case syntax.Or:
+		matchers, err := compileExprs(expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(events []types.Event) bool {
+			for _, match := range matchers {
+				if match(events) {
+					return true
+				}
+			}
 			return false
+		}, nil
+	default:
+		return nil, fmt.Errorf("compile %s: unexpected expression %T", expr, expr)
+	}
+}
+
+func compileExprs(exprs []syntax.Expr) ([]matcher, error) {
+	matchers := make([]matcher, len(exprs))
+	for i, expr := range exprs {
+		match, err := compileExpr(expr)
+		if err != nil {
+			return nil, err
 		}
+		matchers[i] = match
 	}
-	return len(events) != 0
+	return matchers, nil
 }
 
 // A condition is a compiled match condition.  A condition matches an event if
@@ -378,6 +378,28 @@ func TestCompiledMatches(t *testing.T) {
 			apiEvents, false},
 		{`tm.event = 'Tx' AND rewards.withdraw.source = 'W'`,
 			apiEvents, false},
+
+		// Disjunctions, negations and groups.
+		{`transfer.sender = 'AddrD' OR transfer.recipient = 'AddrD'`,
+			apiEvents, true},
+		{`transfer.sender = 'AddrZ' OR transfer.recipient = 'AddrZ'`,
+			apiEvents, false},
+		{`NOT transfer.sender = 'AddrZ'`,
+			apiEvents, true},
+		{`NOT transfer.sender = 'AddrC'`,
+			apiEvents, false},
+		{`NOT slash.reason EXISTS`,
+			apiEvents, true},
+		{`tm.event = 'Tx' AND (transfer.sender = 'AddrZ' OR rewards.withdraw.source = 'SrcY')`,
+			apiEvents, true},
+		{`tm.event = 'Tx' AND NOT (transfer.sender = 'AddrZ' OR rewards.withdraw.source = 'SrcY')`,
+			apiEvents, false},
+		{`tm.event = 'Block' AND transfer.sender = 'AddrC' OR transfer.amount > 100`,
+			apiEvents, true},
+		{`tm.event = 'Block' AND (transfer.sender = 'AddrC' OR transfer.amount > 100)`,
+			apiEvents, false},
+		{`NOT slash.reason EXISTS`,
+			map[string][]string{}, false},
 	}
 
 	// NOTE: The original implementation allowed arbitrary prefix matches on
@@ -5,14 +5,20 @@
 //
 // The grammar of the query language is defined by the following EBNF:
 //
-//	query      = conditions EOF
-//	conditions = condition {"AND" condition}
-//	condition  = tag comparison
-//	comparison = equal / order / contains / "EXISTS"
-//	equal      = "=" (date / number / time / value)
-//	order      = cmp (date / number / time)
-//	contains   = "CONTAINS" value
-//	cmp        = "<" / "<=" / ">" / ">="
+//	query       = expr EOF
+//	expr        = conjunction {"OR" conjunction}
+//	conjunction = term {"AND" term}
+//	term        = "NOT" term / "(" expr ")" / condition
+//	condition   = tag comparison
+//	comparison  = equal / order / contains / "EXISTS"
+//	equal       = "=" (date / number / time / value)
+//	order       = cmp (date / number / time)
+//	contains    = "CONTAINS" value
+//	cmp         = "<" / "<=" / ">" / ">="
+//
+// NOT binds tighter than AND, which binds tighter than OR, so that
+// "a EXISTS OR NOT b EXISTS AND c EXISTS" is equivalent to
+// "a EXISTS OR ((NOT b EXISTS) AND c EXISTS)".
 //
 // The lexical terms are defined here using RE2 regular expression notation:
 //
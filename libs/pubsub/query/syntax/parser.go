@@ -14,18 +14,176 @@ func Parse(s string) (Query, error) {
 	return NewParser(strings.NewReader(s)).Parse()
 }
 
-// Query is the root of the parse tree for a query.  A query is the conjunction
-// of one or more conditions.
-type Query []Condition
+// MaxConjunctions is the maximum number of conjunctions in the disjunctive
+// normal form of a query. See Query.Conjunctions.
+const MaxConjunctions = 256
+
+// maxNesting is the maximum depth of nested NOT operators and parenthesised
+// expressions in a query.
+const maxNesting = 64
+
+// Query is the root of the parse tree for a query.  A query is a boolean
+// expression of one or more conditions.
+type Query struct {
+	Expr Expr
+}
 
 func (q Query) String() string {
-	ss := make([]string, len(q))
-	for i, cond := range q {
-		ss[i] = cond.String()
+	if q.Expr == nil {
+		return ""
+	}
+	return q.Expr.String()
+}
+
+// Conjunctions returns the disjunctive normal form of the query: the query
+// matches if any of the returned conjunctions does. An error is reported if
+// there are more than MaxConjunctions of them.
+func (q Query) Conjunctions() ([]Conjunction, error) {
+	if q.Expr == nil {
+		return nil, nil
+	}
+	return conjunctions(q.Expr, false)
+}
+
+// An Expr is a node of the parse tree of a query: a Condition, or the
+// conjunction, disjunction or negation of other expressions.
+type Expr interface {
+	String() string
+
+	isExpr()
+}
+
+// And is the conjunction of two or more expressions.
+type And []Expr
+
+func (And) isExpr() {}
+
+func (a And) String() string {
+	ss := make([]string, len(a))
+	for i, expr := range a {
+		if _, ok := expr.(Or); ok {
+			ss[i] = "(" + expr.String() + ")"
+		} else {
+			ss[i] = expr.String()
+		}
 	}
 	return strings.Join(ss, " AND ")
 }
 
+// Or is the disjunction of two or more expressions.
+type Or []Expr
+
+func (Or) isExpr() {}
+
+func (o Or) String() string {
+	ss := make([]string, len(o))
+	for i, expr := range o {
+		ss[i] = expr.String()
+	}
+	return strings.Join(ss, " OR ")
+}
+
+// Not is the negation of an expression.
+type Not struct {
+	Expr Expr
+}
+
+func (Not) isExpr() {}
+
+func (n Not) String() string {
+	switch n.Expr.(type) {
+	case And, Or:
+		return "NOT (" + n.Expr.String() + ")"
+	default:
+		return "NOT " + n.Expr.String()
+	}
+}
+
+// A Conjunction is a conjunction of conditions, some of which are negated. It
+// matches if all of its Conditions match and none of its Negated conditions
+// does.
+type Conjunction struct {
+	Conditions []Condition
+	Negated    []Condition
+}
+
+func (c Conjunction) and(other Conjunction) Conjunction {
+	return Conjunction{
+		Conditions: append(append([]Condition(nil), c.Conditions...), other.Conditions...),
+		Negated:    append(append([]Condition(nil), c.Negated...), other.Negated...),
+	}
+}
+
+var errTooManyConjunctions = fmt.Errorf("query has more than %d conjunctions", MaxConjunctions)
+
+// conjunctions returns the disjunctive normal form of expr, or of its negation
+// if negated is true.
+func conjunctions(expr Expr, negated bool) ([]Conjunction, error) {
+	switch expr := expr.(type) {
+	case Condition:
+		if negated {
+			return []Conjunction{{Negated: []Condition{expr}}}, nil
+		}
+		return []Conjunction{{Conditions: []Condition{expr}}}, nil
+	case Not:
+		return conjunctions(expr.Expr, !negated)
+	case And:
+		if negated {
+			// NOT (a AND b) = NOT a OR NOT b
+			return unionConjunctions(expr, true)
+		}
+		return productConjunctions(expr, false)
+	case Or:
+		if negated {
+			// NOT (a OR b) = NOT a AND NOT b
+			return productConjunctions(expr, true)
+		}
+		return unionConjunctions(expr, false)
+	default:
+		return nil, fmt.Errorf("unexpected expression %T", expr)
+	}
+}
+
+// unionConjunctions returns the disjunctive normal form of the disjunction of
+// exprs.
+func unionConjunctions(exprs []Expr, negated bool) ([]Conjunction, error) {
+	var out []Conjunction
+	for _, expr := range exprs {
+		cs, err := conjunctions(expr, negated)
+		if err != nil {
+			return nil, err
+		}
+		if len(out)+len(cs) > MaxConjunctions {
+			return nil, errTooManyConjunctions
+		}
+		out = append(out, cs...)
+	}
+	return out, nil
+}
+
+// productConjunctions returns the disjunctive normal form of the conjunction
+// of exprs, by distributing the conjunction over the disjunctions.
+func productConjunctions(exprs []Expr, negated bool) ([]Conjunction, error) {
+	out := []Conjunction{{}}
+	for _, expr := range exprs {
+		cs, err := conjunctions(expr, negated)
+		if err != nil {
+			return nil, err
+		}
+		if len(out)*len(cs) > MaxConjunctions {
+			return nil, errTooManyConjunctions
+		}
+		next := make([]Conjunction, 0, len(out)*len(cs))
+		for _, a := range out {
+			for _, b := range cs {
+				next = append(next, a.and(b))
+			}
+		}
+		out = next
+	}
+	return out, nil
+}
+
 // A Condition is a single conditional expression, consisting of a tag, a
 // comparison operator, and an optional argument. The type of the argument
 // depends on the operator.
@@ -37,6 +195,8 @@ type Condition struct {
 	opText string
 }
 
+func (Condition) isExpr() {}
+
 func (c Condition) String() string {
 	s := c.Tag + " " + c.opText
 	if c.Arg != nil {
@@ -130,6 +290,13 @@ func (a *Arg) Value() string {
 // defined in the syntax package documentation.
 type Parser struct {
 	scanner *Scanner
+
+	// unread reports whether the current token of the scanner was pushed back,
+	// and err is the error reported by the scanner for it.
+	unread bool
+	err    error
+
+	nesting int
 }
 
 // NewParser constructs a new parser that reads the input from r.
@@ -139,22 +306,91 @@ func NewParser(r io.Reader) *Parser {
 
 // Parse parses the complete input and returns the resulting query.
 func (p *Parser) Parse() (Query, error) {
-	cond, err := p.parseCond()
+	expr, err := p.parseExpr()
+	if err != nil {
+		return Query{}, err
+	}
+	if err := p.next(); err != io.EOF {
+		if err != nil {
+			return Query{}, fmt.Errorf("offset %d: %w", p.scanner.Pos(), err)
+		}
+		return Query{}, fmt.Errorf("offset %d: got %v, want %s",
+			p.scanner.Pos(), p.scanner.Token(), tokLabel([]Token{TAnd, TOr}))
+	}
+	return Query{Expr: expr}, nil
+}
+
+// parseExpr parses a disjunction of conjunctions: conj OR conj ...
+func (p *Parser) parseExpr() (Expr, error) {
+	expr, err := p.parseConjunction()
 	if err != nil {
 		return nil, err
 	}
-	conds := []Condition{cond}
-	for p.scanner.Next() != io.EOF {
-		if tok := p.scanner.Token(); tok != TAnd {
-			return nil, fmt.Errorf("offset %d: got %v, want %v", p.scanner.Pos(), tok, TAnd)
+	or := Or{expr}
+	for p.accept(TOr) {
+		expr, err := p.parseConjunction()
+		if err != nil {
+			return nil, err
 		}
-		cond, err := p.parseCond()
+		or = append(or, expr)
+	}
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+// parseConjunction parses a conjunction of terms: term AND term ...
+func (p *Parser) parseConjunction() (Expr, error) {
+	expr, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	and := And{expr}
+	for p.accept(TAnd) {
+		expr, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, expr)
+	}
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+// parseTerm parses a negated term, a parenthesised expression or a condition.
+func (p *Parser) parseTerm() (Expr, error) {
+	if p.nesting >= maxNesting {
+		return nil, fmt.Errorf("offset %d: expression nested too deeply", p.scanner.Pos())
+	}
+	p.nesting++
+	defer func() { p.nesting-- }()
+
+	if err := p.require(TNot, TLParen, TTag); err != nil {
+		return nil, err
+	}
+	switch p.scanner.Token() {
+	case TNot:
+		expr, err := p.parseTerm()
 		if err != nil {
 			return nil, err
 		}
-		conds = append(conds, cond)
+		return Not{Expr: expr}, nil
+	case TLParen:
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.require(TRParen); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	default:
+		p.unread = true
+		return p.parseCond()
 	}
-	return conds, nil
 }
 
 // parseCond parses a conditional expression: tag OP value.
@@ -191,10 +427,30 @@ func (p *Parser) parseCond() (Condition, error) {
 	return cond, nil
 }
 
+// next advances the scanner, unless its current token was pushed back.
+func (p *Parser) next() error {
+	if p.unread {
+		p.unread = false
+		return p.err
+	}
+	p.err = p.scanner.Next()
+	return p.err
+}
+
+// accept advances the scanner if its next token is of the given type, and
+// reports whether it did.
+func (p *Parser) accept(tok Token) bool {
+	if p.next() == nil && p.scanner.Token() == tok {
+		return true
+	}
+	p.unread = true
+	return false
+}
+
 // require advances the scanner and requires that the resulting token is one of
 // the specified token types.
 func (p *Parser) require(tokens ...Token) error {
-	if err := p.scanner.Next(); err != nil {
+	if err := p.next(); err != nil {
 		return fmt.Errorf("offset %d: %w", p.scanner.Pos(), err)
 	}
 	got := p.scanner.Token()
@@ -21,6 +21,8 @@ const (
 	TTime            // timestamp: TIME yyyy-mm-ddThh:mm:ss([-+]hh:mm|Z)
 	TDate            // datestamp: DATE yyyy-mm-dd
 	TAnd             // operator: AND
+	TOr              // operator: OR
+	TNot             // operator: NOT
 	TContains        // operator: CONTAINS
 	TExists          // operator: EXISTS
 	TEq              // operator: =
@@ -28,6 +30,8 @@ const (
 	TLeq             // operator: <=
 	TGt              // operator: >
 	TGeq             // operator: >=
+	TLParen          // grouping: (
+	TRParen          // grouping: )
 
 	// Do not reorder these values without updating the scanner code.
 )
@@ -40,6 +44,8 @@ var tString = [...]string{
 	TTime:     "timestamp",
 	TDate:     "datestamp",
 	TAnd:      "AND operator",
+	TOr:       "OR operator",
+	TNot:      "NOT operator",
 	TContains: "CONTAINS operator",
 	TExists:   "EXISTS operator",
 	TEq:       "= operator",
@@ -47,6 +53,8 @@ var tString = [...]string{
 	TLeq:      "<= operator",
 	TGt:       "> operator",
 	TGeq:      ">= operator",
+	TLParen:   "left parenthesis",
+	TRParen:   "right parenthesis",
 }
 
 func (t Token) String() string {
@@ -107,6 +115,14 @@ func (s *Scanner) Next() error {
 			return s.scanString(ch)
 		case '<', '>', '=':
 			return s.scanCompare(ch)
+		case '(':
+			s.buf.WriteRune(ch)
+			s.tok = TLParen
+			return nil
+		case ')':
+			s.buf.WriteRune(ch)
+			s.tok = TRParen
+			return nil
 		default:
 			return s.invalid(ch)
 		}
@@ -224,6 +240,10 @@ func (s *Scanner) scanTagLike(first rune) error {
 		s.tok = TTag
 	case "AND":
 		s.tok = TAnd
+	case "OR":
+		s.tok = TOr
+	case "NOT":
+		s.tok = TNot
 	case "EXISTS":
 		s.tok = TExists
 	case "CONTAINS":
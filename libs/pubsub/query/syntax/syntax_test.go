@@ -40,6 +40,9 @@ func TestScanner(t *testing.T) {
 		{`x.y CONTAINS 'z'`, []syntax.Token{syntax.TTag, syntax.TContains, syntax.TString}},
 		{`foo EXISTS`, []syntax.Token{syntax.TTag, syntax.TExists}},
 		{`and AND`, []syntax.Token{syntax.TTag, syntax.TAnd}},
+		{`x OR NOT y`, []syntax.Token{syntax.TTag, syntax.TOr, syntax.TNot, syntax.TTag}},
+		{`(x.y EXISTS)`, []syntax.Token{syntax.TLParen, syntax.TTag, syntax.TExists, syntax.TRParen}},
+		{`(x.y='z')`, []syntax.Token{syntax.TLParen, syntax.TTag, syntax.TEq, syntax.TString, syntax.TRParen}},
 
 		// Timestamp
 		{`TIME 2021-11-23T15:16:17Z`, []syntax.Token{syntax.TTime}},
@@ -167,6 +170,27 @@ func TestParseValid(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"transfer.sender='x' OR transfer.recipient='x'", true},
+		{"a.b=1 OR a.c=2 AND a.d=3", true},
+		{"(a.b=1 OR a.c=2) AND a.d=3", true},
+		{"a.b=1 AND (a.c=2 OR (a.d=3 AND a.e EXISTS))", true},
+		{"NOT a.b EXISTS", true},
+		{"NOT NOT a.b EXISTS", true},
+		{"a.b=1 AND NOT (a.c=2 OR a.d=3)", true},
+		{"((a.b=1))", true},
+		{"tx.date > DATE 2013-05-03 OR (tx.date < TIME 2013-05-03T14:45:00Z)", true},
+		{"a.b=1 OR", false},
+		{"OR a.b=1", false},
+		{"a.b=1 OR OR a.c=2", false},
+		{"a.b=1 NOT a.c=2", false},
+		{"NOT", false},
+		{"a.b NOT EXISTS", false},
+		{"(a.b=1", false},
+		{"a.b=1)", false},
+		{"()", false},
+		{"(a.b=1) (a.c=2)", false},
+		{strings.Repeat("(", 100) + "a.b=1" + strings.Repeat(")", 100), false},
 	}
 
 	for _, test := range tests {
@@ -188,3 +212,82 @@ func TestParseValid(t *testing.T) {
 		}
 	}
 }
+
+func TestParseTree(t *testing.T) {
+	a, err := syntax.Parse("a.b EXISTS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cond := a.Expr.(syntax.Condition)
+
+	tests := []struct {
+		input string
+		want  syntax.Expr
+	}{
+		{"a.b EXISTS", cond},
+		{"a.b EXISTS AND a.b EXISTS OR a.b EXISTS", syntax.Or{syntax.And{cond, cond}, cond}},
+		{"a.b EXISTS OR a.b EXISTS AND a.b EXISTS", syntax.Or{cond, syntax.And{cond, cond}}},
+		{"a.b EXISTS AND (a.b EXISTS OR a.b EXISTS)", syntax.And{cond, syntax.Or{cond, cond}}},
+		{"NOT a.b EXISTS AND a.b EXISTS", syntax.And{syntax.Not{Expr: cond}, cond}},
+		{"NOT (a.b EXISTS AND a.b EXISTS)", syntax.Not{Expr: syntax.And{cond, cond}}},
+	}
+	for _, test := range tests {
+		q, err := syntax.Parse(test.input)
+		if err != nil {
+			t.Fatalf("Parse %#q: %v", test.input, err)
+		}
+		if !reflect.DeepEqual(q.Expr, test.want) {
+			t.Errorf("Parse %#q:\ngot:  %#v\nwant: %#v", test.input, q.Expr, test.want)
+		}
+	}
+}
+
+func TestConjunctions(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string // conditions, with negated ones prefixed by "!"
+	}{
+		{"a.a EXISTS", []string{"a.a EXISTS"}},
+		{"a.a EXISTS AND b.b EXISTS", []string{"a.a EXISTS, b.b EXISTS"}},
+		{"a.a EXISTS OR b.b EXISTS", []string{"a.a EXISTS", "b.b EXISTS"}},
+		{"(a.a EXISTS OR b.b EXISTS) AND c.c EXISTS", []string{
+			"a.a EXISTS, c.c EXISTS",
+			"b.b EXISTS, c.c EXISTS",
+		}},
+		{"a.a EXISTS AND NOT (b.b EXISTS OR c.c EXISTS)", []string{"a.a EXISTS, !b.b EXISTS, !c.c EXISTS"}},
+		{"NOT (a.a EXISTS AND NOT b.b EXISTS)", []string{"!a.a EXISTS", "b.b EXISTS"}},
+	}
+	for _, test := range tests {
+		q, err := syntax.Parse(test.input)
+		if err != nil {
+			t.Fatalf("Parse %#q: %v", test.input, err)
+		}
+		conjs, err := q.Conjunctions()
+		if err != nil {
+			t.Fatalf("Conjunctions %#q: %v", test.input, err)
+		}
+		got := make([]string, len(conjs))
+		for i, conj := range conjs {
+			var ss []string
+			for _, c := range conj.Conditions {
+				ss = append(ss, c.String())
+			}
+			for _, c := range conj.Negated {
+				ss = append(ss, "!"+c.String())
+			}
+			got[i] = strings.Join(ss, ", ")
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Conjunctions %#q:\ngot:  %q\nwant: %q", test.input, got, test.want)
+		}
+	}
+
+	// The disjunctive normal form of the query below has 2^10 conjunctions.
+	q, err := syntax.Parse(strings.Repeat("(a.a EXISTS OR b.b EXISTS) AND ", 9) + "(a.a EXISTS OR b.b EXISTS)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Conjunctions(); err == nil {
+		t.Error("Conjunctions: got no error, want an error for too many conjunctions")
+	}
+}
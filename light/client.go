@@ -125,6 +125,35 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// NewProviderFn option sets the function creating the providers from the
+// addresses of the witness pool (see WitnessCandidates and WitnessDiscovery).
+// It is set by NewHTTPClient and NewHTTPClientFromTrustedStore.
+func NewProviderFn(fn NewProviderFunc) Option {
+	return func(c *Client) {
+		c.pool.newProvider = fn
+	}
+}
+
+// WitnessCandidates option sets the addresses of the providers which replace
+// the witnesses removed for misbehaving or promoted to primary, so that the
+// client keeps as many witnesses as it was created with.
+func WitnessCandidates(addrs []string) Option {
+	return func(c *Client) {
+		c.pool.candidates = append(c.pool.candidates, addrs...)
+	}
+}
+
+// WitnessDiscovery option makes the client discover new witnesses among the
+// peers of the primary (from its net_info) when it runs out of witness
+// candidates. The discovery runs in the background, so the witnesses are only
+// replaced once it completes. The primary must implement PeerRPCAddresses,
+// which the HTTP provider does.
+func WitnessDiscovery() Option {
+	return func(c *Client) {
+		c.pool.discover = true
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -145,6 +174,11 @@ type Client struct {
 	primary provider.Provider
 	// Providers used to "witness" new headers.
 	witnesses []provider.Provider
+	// Number of witnesses the client was created with, which the witness pool
+	// maintains.
+	numWitnesses int
+	// Scores the providers and replaces the witnesses removed.
+	pool *witnessPool
 
 	// Where trusted light blocks are stored.
 	trustedStore store.Store
@@ -227,6 +261,8 @@ func NewClientFromTrustedStore(
 		maxBlockLag:      defaultMaxBlockLag,
 		primary:          primary,
 		witnesses:        witnesses,
+		numWitnesses:     len(witnesses),
+		pool:             newWitnessPool(chainID),
 		trustedStore:     trustedStore,
 		pruningSize:      defaultPruningSize,
 		confirmationFn:   func(action string) bool { return true },
@@ -256,6 +292,10 @@ func NewClientFromTrustedStore(
 		return nil, err
 	}
 
+	if err := c.pool.validate(); err != nil {
+		return nil, err
+	}
+
 	if err := c.restoreTrustedLightBlock(); err != nil {
 		return nil, err
 	}
@@ -748,7 +788,7 @@ func (c *Client) verifySkipping(
 			if depth == len(blockCache)-1 {
 				pivotHeight := verifiedBlock.Height + (blockCache[depth].Height-verifiedBlock.
 					Height)*verifySkippingNumerator/verifySkippingDenominator
-				interimBlock, providerErr := source.LightBlock(ctx, pivotHeight)
+				interimBlock, providerErr := c.lightBlockFrom(ctx, source, pivotHeight)
 				switch providerErr {
 				case nil:
 					blockCache = append(blockCache, interimBlock)
@@ -868,6 +908,25 @@ func (c *Client) Witnesses() []provider.Provider {
 	return c.witnesses
 }
 
+// WitnessPoolStatus returns the scores of the primary and witnesses, and the
+// addresses of the providers which can replace the witnesses removed.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) WitnessPoolStatus() WitnessPoolStatus {
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+
+	status := WitnessPoolStatus{
+		Primary:    c.pool.status(c.primary),
+		Witnesses:  make([]ProviderStatus, len(c.witnesses)),
+		Candidates: c.pool.candidateAddresses(),
+	}
+	for i, w := range c.witnesses {
+		status.Witnesses[i] = c.pool.status(w)
+	}
+	return status
+}
+
 // Cleanup removes all the data (headers and validator sets) stored. Note: the
 // client must be stopped at this point.
 func (c *Client) Cleanup() error {
@@ -989,7 +1048,8 @@ func (c *Client) backwards(
 //     any other error, the primary is permanently dropped and is replaced by a witness.
 func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*types.LightBlock, error) {
 	c.providerMutex.Lock()
-	l, err := c.primary.LightBlock(ctx, height)
+	c.rotatePrimary()
+	l, err := c.lightBlockFrom(ctx, c.primary, height)
 	c.providerMutex.Unlock()
 
 	switch err {
@@ -1015,10 +1075,46 @@ func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*type
 	}
 }
 
+// lightBlockFrom retrieves the light block at the specified height from p,
+// recording the latency and outcome of the request in the witness pool.
+func (c *Client) lightBlockFrom(ctx context.Context, p provider.Provider, height int64) (*types.LightBlock, error) {
+	start := time.Now()
+	l, err := p.LightBlock(ctx, height)
+	c.pool.observe(p, time.Since(start), err)
+	return l, err
+}
+
+// rotatePrimary swaps the primary with the best scored witness if the primary
+// has degraded, i.e. its score is much worse than the witness'.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) rotatePrimary() {
+	i := c.pool.bestWitness(c.primary, c.witnesses)
+	if i < 0 {
+		return
+	}
+	c.logger.Info("primary has degraded, swapping it with a witness",
+		"primary", c.primary, "witness", c.witnesses[i])
+	c.primary, c.witnesses[i] = c.witnesses[i], c.primary
+}
+
+// removeWitnesses removes the witnesses at the given indexes, except the one
+// just promoted to primary, which are never used again. They are replaced by
+// new witnesses from the witness candidates, if any, or else by the ones
+// discovered in the background (see discoverWitnesses).
+//
 // NOTE: requires a providerMutex lock
 func (c *Client) removeWitnesses(indexes []int) error {
+	replacements := c.pool.newWitnesses(c.numWitnesses-len(c.witnesses)+len(indexes), c.primary, c.witnesses)
+
 	// check that we will still have witnesses remaining
-	if len(c.witnesses) <= len(indexes) {
+	if len(c.witnesses)+len(replacements) <= len(indexes) {
+		// The discovered witnesses replace the ones which can't be removed yet.
+		stale := make([]provider.Provider, len(indexes))
+		for i, index := range indexes {
+			stale[i] = c.witnesses[index]
+		}
+		c.discoverWitnesses(stale)
 		return ErrNoWitnesses
 	}
 
@@ -1026,13 +1122,74 @@ func (c *Client) removeWitnesses(indexes []int) error {
 	// order so as to not affect the indexes themselves
 	sort.Ints(indexes)
 	for i := len(indexes) - 1; i >= 0; i-- {
-		c.witnesses[indexes[i]] = c.witnesses[len(c.witnesses)-1]
-		c.witnesses = c.witnesses[:len(c.witnesses)-1]
+		c.removeWitness(indexes[i])
+	}
+
+	for _, w := range replacements {
+		c.logger.Info("adding new witness from the witness pool", "witness", w)
+		c.witnesses = append(c.witnesses, w)
 	}
 
+	if len(c.witnesses) < c.numWitnesses {
+		c.discoverWitnesses(nil)
+	}
 	return nil
 }
 
+// removeWitness removes the witness at the given index, which is never used
+// again unless it is the primary.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) removeWitness(index int) {
+	if w := c.witnesses[index]; w != c.primary {
+		c.pool.remove(w)
+	}
+	c.witnesses[index] = c.witnesses[len(c.witnesses)-1]
+	c.witnesses = c.witnesses[:len(c.witnesses)-1]
+}
+
+// discoverWitnesses discovers new witnesses among the peers of the primary in
+// the background, if enabled, to replace the stale witnesses and the ones
+// missing. The discovery takes too long to hold the providerMutex lock, which
+// is only taken to swap in the new witnesses.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) discoverWitnesses(stale []provider.Provider) {
+	if !c.pool.discover {
+		return
+	}
+	primary := c.primary
+	go func() {
+		if !c.pool.discoverCandidates(primary) {
+			return
+		}
+
+		c.providerMutex.Lock()
+		defer c.providerMutex.Unlock()
+
+		var indexes []int
+		for i, w := range c.witnesses {
+			for _, s := range stale {
+				if w == s {
+					indexes = append(indexes, i)
+					break
+				}
+			}
+		}
+		replacements := c.pool.newWitnesses(c.numWitnesses-len(c.witnesses)+len(indexes), c.primary, c.witnesses)
+		if len(replacements) == 0 {
+			return
+		}
+		for i := len(indexes) - 1; i >= 0; i-- {
+			c.removeWitness(indexes[i])
+		}
+		for _, w := range replacements {
+			c.logger.Info("adding new witness discovered among the peers of the primary", "witness", w)
+			c.witnesses = append(c.witnesses, w)
+		}
+	}()
+}
+
 type witnessResponse struct {
 	lb           *types.LightBlock
 	witnessIndex int
@@ -1066,7 +1223,7 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 		go func(witnessIndex int, witnessResponsesC chan witnessResponse) {
 			defer wg.Done()
 
-			lb, err := c.witnesses[witnessIndex].LightBlock(subctx, height)
+			lb, err := c.lightBlockFrom(subctx, c.witnesses[witnessIndex], height)
 			witnessResponsesC <- witnessResponse{lb, witnessIndex, err}
 		}(index, witnessResponsesC)
 	}
@@ -1084,6 +1241,8 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 			// if we are not intending on removing the primary then append the old primary to the end of the witness slice
 			if !remove {
 				c.witnesses = append(c.witnesses, c.primary)
+			} else {
+				c.pool.remove(c.primary)
 			}
 
 			// promote respondent as the new primary
@@ -1005,6 +1005,128 @@ func TestClient_TrustedValidatorSet(t *testing.T) {
 	assert.Equal(t, 1, len(c.Witnesses()))
 }
 
+// namedProvider is a provider identified by its name, like an HTTP provider
+// by its address.
+type namedProvider struct {
+	provider.Provider
+	name  string
+	peers []string
+	// If not nil, listing the peers blocks until it is closed.
+	discovered chan struct{}
+}
+
+func (p namedProvider) String() string { return p.name }
+
+func (p namedProvider) PeerRPCAddresses(context.Context) ([]string, error) {
+	if p.discovered != nil {
+		<-p.discovered
+	}
+	return p.peers, nil
+}
+
+func namedProviderFn(providers ...namedProvider) light.NewProviderFunc {
+	return func(_, address string) (provider.Provider, error) {
+		for _, p := range providers {
+			if p.name == address {
+				return &p, nil
+			}
+		}
+		return nil, errors.New("unknown provider")
+	}
+}
+
+func TestClient_WitnessPoolReplacesRemovedWitness(t *testing.T) {
+	differentVals, _ := types.RandValidatorSet(10, 100)
+	badWitness := &namedProvider{name: "bad", Provider: mockp.New(
+		chainID,
+		map[int64]*types.SignedHeader{
+			1: h1,
+			2: keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+				hash("app_hash2"), hash("cons_hash"), hash("results_hash"),
+				0, len(keys), types.BlockID{Hash: h1.Hash()}),
+		},
+		map[int64]*types.ValidatorSet{
+			1: vals,
+			2: differentVals,
+		},
+	)}
+	primary := &namedProvider{name: "primary", Provider: fullNode}
+
+	testCases := map[string]struct {
+		primary *namedProvider
+		options []light.Option
+	}{
+		"candidates": {
+			primary: primary,
+			options: []light.Option{
+				// The primary and the removed witness are never used again.
+				light.WitnessCandidates([]string{"primary", "unknown", "bad", "good", "other"}),
+			},
+		},
+		"discovery": {
+			primary: &namedProvider{name: "primary", Provider: fullNode, peers: []string{"bad", "good"},
+				discovered: make(chan struct{})},
+			options: []light.Option{light.WitnessDiscovery()},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				trustOptions,
+				tc.primary,
+				[]provider.Provider{badWitness},
+				dbs.New(dbm.NewMemDB(), chainID),
+				append(tc.options,
+					light.Logger(log.TestingLogger()),
+					light.NewProviderFn(namedProviderFn(*badWitness, namedProvider{name: "good", Provider: fullNode})),
+				)...,
+			)
+			require.NoError(t, err)
+
+			// The bad witness is removed, so the light block can't be cross-checked.
+			_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+			if tc.primary.discovered == nil {
+				assert.Equal(t, light.ErrFailedHeaderCrossReferencing, err)
+			} else {
+				// The last witness is only replaced once new ones are discovered,
+				// which doesn't block the client meanwhile.
+				assert.Equal(t, light.ErrNoWitnesses, err)
+				assert.Equal(t, "bad", c.WitnessPoolStatus().Witnesses[0].Provider)
+				close(tc.primary.discovered)
+				require.Eventually(t, func() bool {
+					return c.WitnessPoolStatus().Witnesses[0].Provider == "good"
+				}, time.Second, 10*time.Millisecond)
+			}
+
+			// It was replaced by a good witness, which the next light blocks are
+			// cross-checked with.
+			_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(2*time.Hour))
+			require.NoError(t, err)
+
+			status := c.WitnessPoolStatus()
+			assert.Equal(t, "primary", status.Primary.Provider)
+			require.Len(t, status.Witnesses, 1)
+			assert.Equal(t, "good", status.Witnesses[0].Provider)
+			assert.EqualValues(t, 1, status.Witnesses[0].Requests)
+			assert.Zero(t, status.Witnesses[0].Errors)
+		})
+	}
+}
+
+func TestClient_WitnessPoolRequiresProviderFn(t *testing.T) {
+	_, err := light.NewClientFromTrustedStore(
+		chainID,
+		trustPeriod,
+		fullNode,
+		[]provider.Provider{fullNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.WitnessCandidates([]string{"good"}),
+	)
+	require.Error(t, err)
+}
+
 func TestClientPrunesHeadersAndValidatorSets(t *testing.T) {
 	c, err := light.NewClient(
 		ctx,
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -107,6 +109,34 @@ func (p *http) ReportEvidence(ctx context.Context, ev types.Evidence) error {
 	return err
 }
 
+// PeerRPCAddresses calls `/net_info` and returns the RPC addresses advertised
+// by the peers of the node. Unspecified and loopback hosts are replaced by the
+// IP of the peer.
+func (p *http) PeerRPCAddresses(ctx context.Context) ([]string, error) {
+	res, err := p.client.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(res.Peers))
+	for _, peer := range res.Peers {
+		u, err := url.Parse(peer.NodeInfo.Other.RPCAddress)
+		if err != nil || u.Port() == "" {
+			continue
+		}
+		host := u.Hostname()
+		if ip := net.ParseIP(host); ip != nil && (ip.IsUnspecified() || ip.IsLoopback()) {
+			host = peer.RemoteIP
+		}
+		scheme := "http"
+		if u.Scheme == "https" {
+			scheme = u.Scheme
+		}
+		addrs = append(addrs, scheme+"://"+net.JoinHostPort(host, u.Port()))
+	}
+	return addrs, nil
+}
+
 func (p *http) validatorSet(ctx context.Context, height *int64) (*types.ValidatorSet, error) {
 	// Since the malicious node could report a massive number of pages, making us
 	// spend a considerable time iterating, we restrict the number of pages here.
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/light"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -21,6 +22,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"health":               rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
 		"status":               rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
 		"net_info":             rpcserver.NewRPCFunc(makeNetInfoFunc(c), ""),
+		"witness_pool":         rpcserver.NewRPCFunc(makeWitnessPoolFunc(c), ""),
 		"blockchain":           rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight", rpcserver.Cacheable()),
 		"genesis":              rpcserver.NewRPCFunc(makeGenesisFunc(c), "", rpcserver.Cacheable()),
 		"genesis_chunked":      rpcserver.NewRPCFunc(makeGenesisChunkedFunc(c), "", rpcserver.Cacheable()),
@@ -51,6 +53,8 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"evidence_pending":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), "min_height,max_height,type,page,per_page"),
+		"evidence_committed": rpcserver.NewRPCFunc(makeCommittedEvidenceFunc(c), "min_height,max_height,type,page,per_page"),
 	}
 }
 
@@ -78,6 +82,14 @@ func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
 	}
 }
 
+type rpcWitnessPoolFunc func(ctx *rpctypes.Context) (*light.WitnessPoolStatus, error)
+
+func makeWitnessPoolFunc(c *lrpc.Client) rpcWitnessPoolFunc {
+	return func(ctx *rpctypes.Context) (*light.WitnessPoolStatus, error) {
+		return c.WitnessPool(ctx.Context())
+	}
+}
+
 type rpcBlockchainInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error)
 
 func makeBlockchainInfoFunc(c *lrpc.Client) rpcBlockchainInfoFunc {
@@ -300,3 +312,39 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(
+	ctx *rpctypes.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(
+		ctx *rpctypes.Context,
+		minHeight, maxHeight int64,
+		evType string,
+		page, perPage *int,
+	) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(ctx.Context(), minHeight, maxHeight, evType, page, perPage)
+	}
+}
+
+type rpcCommittedEvidenceFunc func(
+	ctx *rpctypes.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultCommittedEvidence, error)
+
+func makeCommittedEvidenceFunc(c *lrpc.Client) rpcCommittedEvidenceFunc {
+	return func(
+		ctx *rpctypes.Context,
+		minHeight, maxHeight int64,
+		evType string,
+		page, perPage *int,
+	) (*ctypes.ResultCommittedEvidence, error) {
+		return c.CommittedEvidence(ctx.Context(), minHeight, maxHeight, evType, page, perPage)
+	}
+}
@@ -6,12 +6,19 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
 	service "github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/light"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -22,6 +29,22 @@ import (
 
 var errNegOrZeroHeight = errors.New("negative or zero height")
 
+// The keys of the events committed by the headers, which are the only ones
+// search queries can use.
+var (
+	txSearchKeys    = []string{types.TxHashKey, types.TxHeightKey}
+	blockSearchKeys = []string{types.BlockHeightKey}
+)
+
+var (
+	// searchResultsTimeout is how long TxSearch waits for the header following
+	// the block of a tx found, needed to verify the block's results.
+	searchResultsTimeout = 10 * time.Second
+	// lightBlockRetry is the interval between the attempts to fetch a light
+	// block not available yet.
+	lightBlockRetry = 500 * time.Millisecond
+)
+
 // KeyPathFunc builds a merkle path out of the given path and key.
 type KeyPathFunc func(path string, key []byte) (merkle.KeyPath, error)
 
@@ -33,6 +56,7 @@ type LightClient interface {
 	Update(ctx context.Context, now time.Time) (*types.LightBlock, error)
 	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*types.LightBlock, error)
 	TrustedLightBlock(height int64) (*types.LightBlock, error)
+	WitnessPoolStatus() light.WitnessPoolStatus
 }
 
 var _ rpcclient.Client = (*Client)(nil)
@@ -218,6 +242,13 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+// WitnessPool returns the scores of the primary and witnesses of the light
+// client, and the addresses of the providers which can replace the witnesses.
+func (c *Client) WitnessPool(context.Context) (*light.WitnessPoolStatus, error) {
+	status := c.lc.WitnessPoolStatus()
+	return &status, nil
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
@@ -493,6 +524,13 @@ func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.Resul
 	return res, res.Proof.Validate(l.DataHash)
 }
 
+// TxSearch calls rpcclient#TxSearch, always requesting the proofs, and then
+// verifies each tx against the data hash of its trusted header, its result
+// against the trusted results of its block, and that it matches the query. As
+// the events emitted by the application can't be verified, the query can only
+// use the tx hash and height keys, and the non-deterministic fields of the
+// results, including their events, are dropped. The proofs are only returned
+// if requested.
 func (c *Client) TxSearch(
 	ctx context.Context,
 	query string,
@@ -500,16 +538,127 @@ func (c *Client) TxSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	q, err := verifiableQuery(query, txSearchKeys)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.next.TxSearch(ctx, query, true, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	blockResults := make(map[int64]*ctypes.ResultBlockResults)
+	for _, tx := range res.Txs {
+		// Validate tx.
+		if tx.Height <= 0 {
+			return nil, errNegOrZeroHeight
+		}
+		if tx.Proof.Proof.Total == 0 || len(tx.Proof.RootHash) == 0 {
+			return nil, fmt.Errorf("full node omitted the inclusion proof of tx %X", tx.Hash)
+		}
+		if !bytes.Equal(tx.Tx, tx.Proof.Data) || !bytes.Equal(tx.Hash, tx.Tx.Hash()) {
+			return nil, fmt.Errorf("tx %X does not match with its proof", tx.Hash)
+		}
+		// The index selects the result the tx is verified against.
+		if int64(tx.Index) != tx.Proof.Proof.Index {
+			return nil, fmt.Errorf("tx %X index %d does not match with its proof (%d)", tx.Hash, tx.Index, tx.Proof.Proof.Index)
+		}
+
+		// Update the light client if we're behind and verify the proof.
+		l, err := c.updateLightClientIfNeededTo(ctx, &tx.Height)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Proof.Validate(l.DataHash); err != nil {
+			return nil, fmt.Errorf("invalid inclusion proof of tx %X: %w", tx.Hash, err)
+		}
+
+		// Verify the result against the results of the block, which are
+		// verified against the last results hash of the next header.
+		results, ok := blockResults[tx.Height]
+		if !ok {
+			results, err = c.searchBlockResults(ctx, tx.Height)
+			if err != nil {
+				return nil, fmt.Errorf("can't verify the result of tx %X: %w", tx.Hash, err)
+			}
+			blockResults[tx.Height] = results
+		}
+		if int(tx.Index) >= len(results.TxsResults) {
+			return nil, fmt.Errorf("tx %X index %d is out of range of the block results", tx.Hash, tx.Index)
+		}
+		txResult := abci.DeterministicExecTxResult(&tx.TxResult)
+		trustedResult := abci.DeterministicExecTxResult(results.TxsResults[tx.Index])
+		if !proto.Equal(txResult, trustedResult) {
+			return nil, fmt.Errorf("result of tx %X does not match with trusted results", tx.Hash)
+		}
+		tx.TxResult = *txResult
+
+		events := map[string][]string{
+			types.TxHashKey:   {fmt.Sprintf("%X", tx.Tx.Hash())},
+			types.TxHeightKey: {strconv.FormatInt(tx.Height, 10)},
+		}
+		if matches, err := q.Matches(events); err != nil || !matches {
+			return nil, fmt.Errorf("tx %X does not match the query %q", tx.Hash, query)
+		}
+
+		if !prove {
+			tx.Proof = types.TxProof{}
+		}
+	}
+
+	return res, nil
 }
 
+// BlockSearch calls rpcclient#BlockSearch and then verifies each block against
+// its trusted header, and that it matches the query. As no header commits to
+// the block events, the query can only use the block height key.
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	q, err := verifiableQuery(query, blockSearchKeys)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range res.Blocks {
+		// Validate block.
+		if err := block.BlockID.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if err := block.Block.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if bmH, bH := block.BlockID.Hash, block.Block.Hash(); !bytes.Equal(bmH, bH) {
+			return nil, fmt.Errorf("blockID %X does not match with block %X",
+				bmH, bH)
+		}
+
+		// Update the light client if we're behind and verify the block.
+		l, err := c.updateLightClientIfNeededTo(ctx, &block.Block.Height)
+		if err != nil {
+			return nil, err
+		}
+		if bH, tH := block.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
+			return nil, fmt.Errorf("block header %X does not match with trusted header %X",
+				bH, tH)
+		}
+
+		events := map[string][]string{
+			types.BlockHeightKey: {strconv.FormatInt(block.Block.Height, 10)},
+		}
+		if matches, err := q.Matches(events); err != nil || !matches {
+			return nil, fmt.Errorf("block %d does not match the query %q", block.Block.Height, query)
+		}
+	}
+
+	return res, nil
 }
 
 // Validators fetches and verifies validators.
@@ -547,6 +696,58 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx, minHeight, maxHeight, evType, page, perPage)
+}
+
+// CommittedEvidence calls rpcclient#CommittedEvidence and then verifies that
+// the evidence was committed in the blocks.
+func (c *Client) CommittedEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultCommittedEvidence, error) {
+	res, err := c.next.CommittedEvidence(ctx, minHeight, maxHeight, evType, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var block *types.Block
+	for _, item := range res.Evidence {
+		if item.Evidence == nil {
+			return nil, errors.New("nil evidence")
+		}
+		if block == nil || block.Height != item.Height {
+			resBlock, err := c.Block(ctx, &item.Height)
+			if err != nil {
+				return nil, err
+			}
+			block = resBlock.Block
+		}
+		// The evidence of the verified block is returned, as the hash of the
+		// evidence does not cover all its fields.
+		var committed types.Evidence
+		for _, ev := range block.Evidence.Evidence {
+			if bytes.Equal(ev.Hash(), item.Evidence.Hash()) {
+				committed = ev
+				break
+			}
+		}
+		if committed == nil {
+			return nil, fmt.Errorf("evidence %X is not committed in block %d", item.Evidence.Hash(), item.Height)
+		}
+		item.Evidence = committed
+		item.ValidatorAddresses = types.EvidenceValidatorAddresses(committed)
+	}
+	return res, nil
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
 	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
@@ -576,33 +777,153 @@ func (c *Client) updateLightClientIfNeededTo(ctx context.Context, height *int64)
 	return l, nil
 }
 
+// waitForLightBlock verifies the light block at the given height, waiting up
+// to timeout for it to be available.
+func (c *Client) waitForLightBlock(ctx context.Context, height int64, timeout time.Duration) (*types.LightBlock, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		l, err := c.lc.VerifyLightBlockAtHeight(ctx, height, time.Now())
+		if err == nil {
+			return l, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to update light client to %d: %w", height, err)
+		case <-time.After(lightBlockRetry):
+		}
+	}
+}
+
+// searchBlockResults returns the verified results of a block found by a
+// search. As the results are verified against the next header, and a block
+// found is often the latest one, it waits for the next header to be committed.
+func (c *Client) searchBlockResults(ctx context.Context, height int64) (*ctypes.ResultBlockResults, error) {
+	if _, err := c.waitForLightBlock(ctx, height+1, searchResultsTimeout); err != nil {
+		return nil, err
+	}
+	return c.BlockResults(ctx, &height)
+}
+
+// errUnverifiableQuery is returned for the queries which use keys not
+// committed by the headers.
+var errUnverifiableQuery = errors.New("query can't be verified")
+
+// verifiableQuery parses the query, checking that it only uses the given keys,
+// which must be committed by the headers so that the results can be verified
+// to match it.
+func verifiableQuery(query string, keys []string) (*cmtquery.Query, error) {
+	q, err := cmtquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+	var check func(expr syntax.Expr) error
+	check = func(expr syntax.Expr) error {
+		var exprs []syntax.Expr
+		switch e := expr.(type) {
+		case syntax.And:
+			exprs = e
+		case syntax.Or:
+			exprs = e
+		case syntax.Not:
+			exprs = []syntax.Expr{e.Expr}
+		case syntax.Condition:
+			for _, key := range keys {
+				if e.Tag == key {
+					return nil
+				}
+			}
+			return fmt.Errorf("%w: %s isn't committed by the headers, only %v are",
+				errUnverifiableQuery, e.Tag, keys)
+		}
+		for _, expr := range exprs {
+			if err := check(expr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if expr := q.Syntax().Expr; expr != nil {
+		if err := check(expr); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
 func (c *Client) RegisterOpDecoder(typ string, dec merkle.OpDecoder) {
 	c.prt.RegisterOpDecoder(typ, dec)
 }
 
 // SubscribeWS subscribes for events using the given query and remote address as
-// a subscriber, but does not verify responses (UNSAFE)!
-// TODO: verify data
+// a subscriber. Events are verified before being forwarded to the subscriber,
+// those which can't be verified are dropped. The events whose results are
+// verified against the next header are delayed until it is committed. As the
+// events emitted by the application can't be verified, the query can only use
+// the event type, tx hash and tx height keys.
+//
+// The events are verified concurrently, so that those waiting for the same
+// header are delayed only once, and forwarded in the order they were received.
 func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+	q, err := verifiableQuery(query, subscriptionKeys)
+	if err != nil {
+		return nil, err
+	}
 	out, err := c.next.Subscribe(context.Background(), ctx.RemoteAddr(), query)
 	if err != nil {
 		return nil, err
 	}
 
+	type pendingEvent struct {
+		event ctypes.ResultEvent
+		err   error
+		done  chan struct{}
+	}
+	pending := make(chan *pendingEvent, maxPendingEvents)
+
 	go func() {
+		// Abort the verification of the events when the client stops.
+		vctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		defer close(pending)
+
+		verifier := &eventVerifier{c: c, query: q}
 		for {
 			select {
 			case resultEvent := <-out:
-				// We should have a switch here that performs a validation
-				// depending on the event's type.
-				ctx.WSConn.TryWriteRPCResponse(
-					rpctypes.NewRPCSuccessResponse(
-						rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
-						resultEvent,
-					))
+				pe := &pendingEvent{done: make(chan struct{})}
+				go func() {
+					pe.event, pe.err = verifier.verify(vctx, resultEvent)
+					close(pe.done)
+				}()
+				select {
+				case pending <- pe:
+				case <-c.Quit():
+					return
+				}
+			case <-c.Quit():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for pe := range pending {
+			select {
+			case <-pe.done:
 			case <-c.Quit():
 				return
 			}
+			if pe.err != nil {
+				c.Logger.Info("Dropping unverified event",
+					"query", pe.event.Query, "type", fmt.Sprintf("%T", pe.event.Data), "err", pe.err)
+				continue
+			}
+			ctx.WSConn.TryWriteRPCResponse(
+				rpctypes.NewRPCSuccessResponse(
+					rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
+					pe.event,
+				))
 		}
 	}()
 
@@ -0,0 +1,435 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// trustedHeader returns a light block with the given header, as returned by
+// the light client once verified.
+func trustedHeader(header types.Header) *types.LightBlock {
+	return &types.LightBlock{SignedHeader: &types.SignedHeader{Header: &header}}
+}
+
+func TestTxSearch(t *testing.T) {
+	txs := types.Txs{types.Tx("alice"), types.Tx("bob")}
+	results := []*abci.ExecTxResult{{Code: 0, Data: []byte{1}}, {Code: 1}}
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).
+		Return(trustedHeader(types.Header{Height: 5, DataHash: txs.Hash()}), nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).
+		Return(trustedHeader(types.Header{Height: 6, LastResultsHash: types.NewResults(results).Hash()}), nil)
+
+	testCases := map[string]struct {
+		modify func(tx *ctypes.ResultTx)
+		err    string
+	}{
+		"valid":           {func(*ctypes.ResultTx) {}, ""},
+		"omitted proof":   {func(tx *ctypes.ResultTx) { tx.Proof = types.TxProof{} }, "omitted the inclusion proof"},
+		"tampered tx":     {func(tx *ctypes.ResultTx) { tx.Tx = types.Tx("eve") }, "does not match with its proof"},
+		"tampered proof":  {func(tx *ctypes.ResultTx) { tx.Proof.RootHash = []byte{1} }, "invalid inclusion proof"},
+		"tampered result": {func(tx *ctypes.ResultTx) { tx.TxResult.Code = 0 }, "does not match with trusted results"},
+		"unverified fields": {func(tx *ctypes.ResultTx) {
+			tx.TxResult.Log = "forged"
+			tx.TxResult.Events = []abci.Event{{Type: "transfer"}}
+		}, ""},
+		"unknown index": {func(tx *ctypes.ResultTx) { tx.Index = 2 }, "does not match with its proof"},
+		// the failed tx is paired with the result of the successful one
+		"tampered index": {func(tx *ctypes.ResultTx) {
+			tx.Index = 0
+			tx.TxResult = *results[0]
+		}, "does not match with its proof"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tx := &ctypes.ResultTx{
+				Hash:     txs[1].Hash(),
+				Height:   5,
+				Index:    1,
+				TxResult: *results[1],
+				Tx:       txs[1],
+				Proof:    txs.Proof(1),
+			}
+			tc.modify(tx)
+
+			next := &rpcmock.Client{}
+			next.On("TxSearch", mock.Anything, "tx.height=5", true, (*int)(nil), (*int)(nil), "").
+				Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx}, TotalCount: 1}, nil)
+			next.On("BlockResults", mock.Anything, mock.Anything).
+				Return(&ctypes.ResultBlockResults{Height: 5, TxsResults: results}, nil)
+
+			c := NewClient(next, lc)
+			res, err := c.TxSearch(context.Background(), "tx.height=5", false, nil, nil, "")
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.Txs, 1)
+			// the proof is verified even when not requested, but not returned
+			assert.Equal(t, types.TxProof{}, res.Txs[0].Proof)
+			assert.Equal(t, *abci.DeterministicExecTxResult(results[1]), res.Txs[0].TxResult)
+		})
+	}
+}
+
+func TestTxSearchQuery(t *testing.T) {
+	txs := types.Txs{types.Tx("alice")}
+	results := []*abci.ExecTxResult{{Code: 0}}
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).
+		Return(trustedHeader(types.Header{Height: 5, DataHash: txs.Hash()}), nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).
+		Return(trustedHeader(types.Header{Height: 6, LastResultsHash: types.NewResults(results).Hash()}), nil)
+
+	testCases := map[string]struct {
+		query string
+		err   string
+	}{
+		"matching":     {fmt.Sprintf("tx.height=5 AND tx.hash='%X'", txs[0].Hash()), ""},
+		"not matching": {"tx.height=5 AND tx.hash='00'", "does not match the query"},
+		"unverifiable": {"tx.height=5 AND transfer.sender='alice'", "transfer.sender isn't committed by the headers"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tx := &ctypes.ResultTx{
+				Hash:     txs[0].Hash(),
+				Height:   5,
+				TxResult: *results[0],
+				Tx:       txs[0],
+				Proof:    txs.Proof(0),
+			}
+			next := &rpcmock.Client{}
+			next.On("TxSearch", mock.Anything, tc.query, true, (*int)(nil), (*int)(nil), "").
+				Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx}, TotalCount: 1}, nil)
+			next.On("BlockResults", mock.Anything, mock.Anything).
+				Return(&ctypes.ResultBlockResults{Height: 5, TxsResults: results}, nil)
+
+			c := NewClient(next, lc)
+			res, err := c.TxSearch(context.Background(), tc.query, false, nil, nil, "")
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, res.Txs, 1)
+		})
+	}
+}
+
+func TestBlockSearch(t *testing.T) {
+	makeBlock := func(txs types.Txs) *types.Block {
+		block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+		block.ProposerAddress = make([]byte, 20)
+		block.ValidatorsHash = make([]byte, 32)
+		return block
+	}
+	block := makeBlock(types.Txs{types.Tx("alice")})
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).
+		Return(trustedHeader(block.Header), nil)
+
+	testCases := map[string]struct {
+		query string
+		block *types.Block
+		err   string
+	}{
+		"trusted":      {"block.height=1", block, ""},
+		"untrusted":    {"block.height=1", makeBlock(nil), "does not match with trusted header"},
+		"not matching": {"block.height>1", block, "does not match the query"},
+		"unverifiable": {"block.height=1 AND transfer.sender='alice'", block, "transfer.sender isn't committed by the headers"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			next := &rpcmock.Client{}
+			next.On("BlockSearch", mock.Anything, tc.query, (*int)(nil), (*int)(nil), "").
+				Return(&ctypes.ResultBlockSearch{
+					Blocks:     []*ctypes.ResultBlock{{BlockID: types.BlockID{Hash: tc.block.Hash()}, Block: tc.block}},
+					TotalCount: 1,
+				}, nil)
+
+			c := NewClient(next, lc)
+			res, err := c.BlockSearch(context.Background(), tc.query, nil, nil, "")
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, res.Blocks, 1)
+		})
+	}
+}
+
+func TestTxSearchLatestBlock(t *testing.T) {
+	txs := types.Txs{types.Tx("alice")}
+	results := []*abci.ExecTxResult{{Code: 0}}
+
+	defer func(timeout, retry time.Duration) {
+		searchResultsTimeout, lightBlockRetry = timeout, retry
+	}(searchResultsTimeout, lightBlockRetry)
+	searchResultsTimeout, lightBlockRetry = 100*time.Millisecond, time.Millisecond
+
+	testCases := map[string]struct {
+		pending int // number of attempts before the next header is available
+		err     string
+	}{
+		"next header committed": {0, ""},
+		"next header pending":   {3, ""},
+		"next header missing":   {-1, "failed to update light client to 6"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lc := &lcmock.LightClient{}
+			lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).
+				Return(trustedHeader(types.Header{Height: 5, DataHash: txs.Hash()}), nil)
+			if tc.pending != 0 {
+				call := lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).
+					Return(nil, errors.New("height 6 must be less than or equal to head (5)"))
+				if tc.pending > 0 {
+					call.Times(tc.pending)
+				}
+			}
+			lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).
+				Return(trustedHeader(types.Header{Height: 6, LastResultsHash: types.NewResults(results).Hash()}), nil)
+
+			tx := &ctypes.ResultTx{
+				Hash:     txs[0].Hash(),
+				Height:   5,
+				TxResult: *results[0],
+				Tx:       txs[0],
+				Proof:    txs.Proof(0),
+			}
+			next := &rpcmock.Client{}
+			next.On("TxSearch", mock.Anything, "tx.height=5", true, (*int)(nil), (*int)(nil), "").
+				Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx}, TotalCount: 1}, nil)
+			next.On("BlockResults", mock.Anything, mock.Anything).
+				Return(&ctypes.ResultBlockResults{Height: 5, TxsResults: results}, nil)
+
+			c := NewClient(next, lc)
+			res, err := c.TxSearch(context.Background(), "tx.height=5", false, nil, nil, "")
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, res.Txs, 1)
+		})
+	}
+}
+
+func TestVerifyEvent(t *testing.T) {
+	block := types.MakeBlock(1, types.Txs{types.Tx("alice")}, &types.Commit{}, nil)
+	block.ProposerAddress = make([]byte, 20)
+	block.ValidatorsHash = make([]byte, 32)
+	results := []*abci.ExecTxResult{{Code: 0}}
+	vals, _ := types.RandValidatorSet(2, 10)
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).
+		Return(trustedHeader(block.Header), nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).
+		Return(trustedHeader(types.Header{
+			Height:             2,
+			LastResultsHash:    types.NewResults(results).Hash(),
+			NextValidatorsHash: vals.Hash(),
+		}), nil)
+
+	next := &rpcmock.Client{}
+	next.On("Block", mock.Anything, mock.Anything).
+		Return(&ctypes.ResultBlock{BlockID: types.BlockID{Hash: block.Hash()}, Block: block}, nil)
+	next.On("BlockResults", mock.Anything, mock.Anything).
+		Return(&ctypes.ResultBlockResults{Height: 1, TxsResults: results}, nil)
+	next.On("Validators", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&ctypes.ResultValidators{BlockHeight: 3, Validators: vals.Validators, Count: 2, Total: 2}, nil)
+
+	otherHeader := block.Header
+	otherHeader.AppHash = []byte{1}
+	txEvent := func(tx types.Tx, code uint32) types.EventDataTx {
+		return types.EventDataTx{TxResult: abci.TxResult{Height: 1, Tx: tx, Result: abci.ExecTxResult{Code: code}}}
+	}
+	updates := func(height int64, power int64) types.EventDataValidatorSetUpdates {
+		val := *vals.Validators[0]
+		val.VotingPower = power
+		return types.EventDataValidatorSetUpdates{Height: height, ValidatorUpdates: []*types.Validator{&val}}
+	}
+	newBlock := func(power int64) types.EventDataNewBlock {
+		val := *vals.Validators[0]
+		val.VotingPower = power
+		return types.EventDataNewBlock{
+			Block:   block,
+			BlockID: types.BlockID{Hash: block.Hash()},
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+				TxResults:        results,
+				ValidatorUpdates: types.TM2PB.ValidatorUpdates(types.NewValidatorSet([]*types.Validator{&val})),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		data types.TMEventData
+		err  string
+	}{
+		"new block":              {newBlock(10), ""},
+		"tampered block updates": {newBlock(20), "does not match with the validator set"},
+		"header":                 {types.EventDataNewBlockHeader{Header: block.Header}, ""},
+		"tampered header":        {types.EventDataNewBlockHeader{Header: otherHeader}, "does not match with trusted header"},
+		"tx":                     {txEvent(types.Tx("alice"), 0), ""},
+		"tampered tx":            {txEvent(types.Tx("eve"), 0), "is not included in block"},
+		"tampered tx result":     {txEvent(types.Tx("alice"), 1), "does not match with trusted results"},
+		"validator updates":      {updates(1, 10), ""},
+		"tampered updates":       {updates(1, 20), "does not match with the validator set"},
+		"updates without height": {updates(0, 10), errUnverifiableEvent.Error()},
+		"unverifiable":           {types.EventDataRoundState{Height: 1}, errUnverifiableEvent.Error()},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			verifier := &eventVerifier{c: NewClient(next, lc), query: cmtquery.MustCompile("tm.event EXISTS")}
+			_, err := verifier.verify(context.Background(), ctypes.ResultEvent{Data: tc.data})
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyNewBlockEventDropsUnverifiedData(t *testing.T) {
+	block := types.MakeBlock(1, types.Txs{types.Tx("alice")}, &types.Commit{}, nil)
+	block.ProposerAddress = make([]byte, 20)
+	block.ValidatorsHash = make([]byte, 32)
+	results := []*abci.ExecTxResult{{
+		Code:   0,
+		Log:    "transferred",
+		Events: []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "bob"}}}},
+	}}
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).
+		Return(trustedHeader(block.Header), nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).
+		Return(trustedHeader(types.Header{Height: 2, LastResultsHash: types.NewResults(results).Hash()}), nil)
+
+	data := types.EventDataNewBlock{
+		Block:   block,
+		BlockID: types.BlockID{Hash: block.Hash()},
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+			TxResults:             results,
+			Events:                []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10"}}}},
+			ConsensusParamUpdates: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 1}},
+		},
+	}
+	verifier := &eventVerifier{c: NewClient(&rpcmock.Client{}, lc), query: cmtquery.MustCompile("tm.event='NewBlock'")}
+	event, err := verifier.verify(context.Background(), ctypes.ResultEvent{
+		Data: data,
+		Events: map[string][]string{
+			types.EventTypeKey: {types.EventNewBlock},
+			"transfer.amount":  {"10"},
+		},
+	})
+	require.NoError(t, err)
+
+	verified := event.Data.(types.EventDataNewBlock)
+	assert.Equal(t, block, verified.Block)
+	assert.Equal(t, []*abci.ExecTxResult{{Code: 0}}, verified.ResultFinalizeBlock.TxResults)
+	assert.Nil(t, verified.ResultFinalizeBlock.Events)
+	assert.Nil(t, verified.ResultFinalizeBlock.ConsensusParamUpdates)
+	assert.Equal(t, map[string][]string{types.EventTypeKey: {types.EventNewBlock}}, event.Events)
+}
+
+func TestVerifyTxEventDropsUnverifiedData(t *testing.T) {
+	tx := types.Tx("alice")
+	block := types.MakeBlock(1, types.Txs{tx}, &types.Commit{}, nil)
+	block.ProposerAddress = make([]byte, 20)
+	block.ValidatorsHash = make([]byte, 32)
+	transfer := func(recipient string) []abci.Event {
+		return []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: recipient}}}}
+	}
+	results := []*abci.ExecTxResult{{Code: 0, Events: transfer("bob")}}
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).
+		Return(trustedHeader(block.Header), nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).
+		Return(trustedHeader(types.Header{Height: 2, LastResultsHash: types.NewResults(results).Hash()}), nil)
+	next := &rpcmock.Client{}
+	next.On("Block", mock.Anything, mock.Anything).
+		Return(&ctypes.ResultBlock{BlockID: types.BlockID{Hash: block.Hash()}, Block: block}, nil)
+	next.On("BlockResults", mock.Anything, mock.Anything).
+		Return(&ctypes.ResultBlockResults{Height: 1, TxsResults: results}, nil)
+
+	// The primary changes the recipient of an otherwise valid tx event.
+	txEvent := ctypes.ResultEvent{
+		Data: types.EventDataTx{TxResult: abci.TxResult{Height: 1, Tx: tx, Result: abci.ExecTxResult{Events: transfer("eve")}}},
+		Events: map[string][]string{
+			types.EventTypeKey:   {types.EventTx},
+			types.TxHashKey:      {fmt.Sprintf("%X", tx.Hash())},
+			types.TxHeightKey:    {"1"},
+			"transfer.recipient": {"eve"},
+		},
+	}
+
+	// The events of the application aren't verified, so the event is dropped
+	// if the query matches them.
+	verifier := &eventVerifier{c: NewClient(next, lc), query: cmtquery.MustCompile("tm.event='Tx' AND transfer.recipient='eve'")}
+	_, err := verifier.verify(context.Background(), txEvent)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match the query")
+
+	// Otherwise, it is forwarded without them.
+	verifier = &eventVerifier{c: NewClient(next, lc), query: cmtquery.MustCompile("tm.event='Tx' AND tx.height=1")}
+	event, err := verifier.verify(context.Background(), txEvent)
+	require.NoError(t, err)
+	assert.Nil(t, event.Data.(types.EventDataTx).Result.Events)
+	assert.Equal(t, map[string][]string{
+		types.EventTypeKey: {types.EventTx},
+		types.TxHashKey:    {fmt.Sprintf("%X", tx.Hash())},
+		types.TxHeightKey:  {"1"},
+	}, event.Events)
+}
+
+func TestVerifiableQuery(t *testing.T) {
+	testCases := map[string]struct {
+		query string
+		err   bool
+	}{
+		"verifiable keys":           {"tm.event='Tx' AND tx.height>5", false},
+		"disjunction":               {"tx.hash='AB' OR (tm.event='Tx' AND NOT tx.height=1)", false},
+		"application event":         {"tm.event='Tx' AND transfer.recipient='bob'", true},
+		"negated application event": {"tm.event='Tx' AND NOT transfer.recipient='bob'", true},
+		"invalid":                   {"tm.event=", true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := verifiableQuery(tc.query, subscriptionKeys)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	// eventVerificationTimeout is how long the verification of an event waits
+	// for the light blocks it needs, e.g. the next one to verify the results of
+	// a block.
+	eventVerificationTimeout = time.Minute
+)
+
+// maxPendingEvents is the maximum number of events of a subscription being
+// verified at once.
+const maxPendingEvents = 100
+
+// errUnverifiableEvent is returned for the events which can't be verified.
+var errUnverifiableEvent = errors.New("event can't be verified")
+
+// subscriptionKeys are the keys of the events committed by the headers, which
+// are the only ones subscription queries can use.
+var subscriptionKeys = []string{types.EventTypeKey, types.TxHashKey, types.TxHeightKey}
+
+// eventVerifier verifies the events of a subscription, concurrently. It caches
+// the block and block results of the latest tx event, as a block's tx events
+// are received one after another.
+type eventVerifier struct {
+	c     *Client
+	query *cmtquery.Query
+
+	mtx     cmtsync.Mutex
+	block   *ctypes.ResultBlock
+	results *ctypes.ResultBlockResults
+}
+
+// verify verifies an event against the light blocks, returning an error if it
+// is invalid or can't be verified, or if it doesn't match the query. It
+// returns the event stripped of the data which can't be verified: the events,
+// logs and other fields of the results outside the deterministic ones aren't
+// committed by any header, so they are dropped, and the event keys are
+// rebuilt from the verified data.
+func (v *eventVerifier) verify(ctx context.Context, event ctypes.ResultEvent) (ctypes.ResultEvent, error) {
+	var (
+		err       error
+		eventType string
+	)
+	switch data := event.Data.(type) {
+	case types.EventDataNewBlock:
+		eventType = types.EventNewBlock
+		event.Data, err = v.verifyNewBlock(ctx, data)
+	case types.EventDataNewBlockHeader:
+		eventType = types.EventNewBlockHeader
+		err = v.verifyHeader(ctx, data.Header)
+	case types.EventDataTx:
+		eventType = types.EventTx
+		if err = v.verifyTx(ctx, data.TxResult); err == nil {
+			data.Result = *abci.DeterministicExecTxResult(&data.Result)
+			event.Data = data
+		}
+	case types.EventDataNewEvidence:
+		eventType = types.EventNewEvidence
+		err = v.verifyEvidence(ctx, data)
+	case types.EventDataValidatorSetUpdates:
+		eventType = types.EventValidatorSetUpdates
+		err = v.verifyValidatorSetUpdates(ctx, data)
+	default:
+		err = errUnverifiableEvent
+	}
+	if err != nil {
+		return event, err
+	}
+
+	event.Events = map[string][]string{types.EventTypeKey: {eventType}}
+	if data, ok := event.Data.(types.EventDataTx); ok {
+		event.Events[types.TxHashKey] = []string{fmt.Sprintf("%X", types.Tx(data.Tx).Hash())}
+		event.Events[types.TxHeightKey] = []string{strconv.FormatInt(data.Height, 10)}
+	}
+	if matches, err := v.query.Matches(event.Events); err != nil || !matches {
+		return event, fmt.Errorf("event does not match the query %q on its verified keys", v.query)
+	}
+	return event, nil
+}
+
+// verifyNewBlock verifies the block against its trusted header, and its
+// results against the next trusted header. No header commits to the block
+// events, the non-deterministic fields of the tx results (including their
+// events) and the consensus param updates (the consensus hash only covers some
+// of the params), so they are dropped from the returned data.
+func (v *eventVerifier) verifyNewBlock(ctx context.Context, data types.EventDataNewBlock) (types.EventDataNewBlock, error) {
+	if data.Block == nil {
+		return data, errors.New("missing block")
+	}
+	if err := data.Block.ValidateBasic(); err != nil {
+		return data, err
+	}
+	if bmH, bH := data.BlockID.Hash, data.Block.Hash(); !bytes.Equal(bmH, bH) {
+		return data, fmt.Errorf("blockID %X does not match with block %X", bmH, bH)
+	}
+	if err := v.verifyHeader(ctx, data.Block.Header); err != nil {
+		return data, err
+	}
+
+	next, err := v.lightBlock(ctx, data.Block.Height+1)
+	if err != nil {
+		return data, err
+	}
+	results := data.ResultFinalizeBlock
+	if rH := state.TxResultsHash(results.TxResults); !bytes.Equal(rH, next.LastResultsHash) {
+		return data, fmt.Errorf("last results %X does not match with trusted last results %X",
+			rH, next.LastResultsHash)
+	}
+	if !bytes.Equal(results.AppHash, next.AppHash) {
+		return data, fmt.Errorf("app hash %X does not match with trusted app hash %X",
+			results.AppHash, next.AppHash)
+	}
+	if len(results.ValidatorUpdates) > 0 {
+		updates, err := types.PB2TM.ValidatorUpdates(results.ValidatorUpdates)
+		if err != nil {
+			return data, err
+		}
+		if err := v.verifyValidatorUpdates(ctx, next, data.Block.Height, updates); err != nil {
+			return data, err
+		}
+	}
+
+	data.ResultFinalizeBlock.Events = nil
+	data.ResultFinalizeBlock.ConsensusParamUpdates = nil
+	data.ResultFinalizeBlock.TxResults = make([]*abci.ExecTxResult, len(results.TxResults))
+	for i, txResult := range results.TxResults {
+		data.ResultFinalizeBlock.TxResults[i] = abci.DeterministicExecTxResult(txResult)
+	}
+	return data, nil
+}
+
+// verifyHeader verifies a header against the trusted one.
+func (v *eventVerifier) verifyHeader(ctx context.Context, header types.Header) error {
+	l, err := v.lightBlock(ctx, header.Height)
+	if err != nil {
+		return err
+	}
+	if hH, tH := header.Hash(), l.Hash(); !bytes.Equal(hH, tH) {
+		return fmt.Errorf("header %X does not match with trusted header %X", hH, tH)
+	}
+	return nil
+}
+
+// verifyTx verifies that the tx is included in its trusted block, and its
+// result against the trusted results of the block.
+func (v *eventVerifier) verifyTx(ctx context.Context, txResult abci.TxResult) error {
+	block, results, err := v.blockWithResults(ctx, txResult.Height)
+	if err != nil {
+		return err
+	}
+	txs := block.Block.Txs
+	if int(txResult.Index) >= len(txs) || int(txResult.Index) >= len(results.TxsResults) {
+		return fmt.Errorf("tx index %d is out of range of block %d", txResult.Index, txResult.Height)
+	}
+	if !bytes.Equal(txs[txResult.Index], txResult.Tx) {
+		return fmt.Errorf("tx %X is not included in block %d", types.Tx(txResult.Tx).Hash(), txResult.Height)
+	}
+	if !proto.Equal(abci.DeterministicExecTxResult(&txResult.Result),
+		abci.DeterministicExecTxResult(results.TxsResults[txResult.Index])) {
+		return fmt.Errorf("result of tx %X does not match with trusted results", types.Tx(txResult.Tx).Hash())
+	}
+	return nil
+}
+
+// verifyEvidence verifies that the evidence is included in its trusted block.
+func (v *eventVerifier) verifyEvidence(ctx context.Context, data types.EventDataNewEvidence) error {
+	if data.Evidence == nil {
+		return errors.New("missing evidence")
+	}
+	if _, err := v.lightBlock(ctx, data.Height); err != nil {
+		return err
+	}
+	block, err := v.c.Block(ctx, &data.Height)
+	if err != nil {
+		return err
+	}
+	if !block.Block.Evidence.Evidence.Has(data.Evidence) {
+		return fmt.Errorf("evidence %X is not included in block %d", data.Evidence.Hash(), data.Height)
+	}
+	return nil
+}
+
+// verifyValidatorSetUpdates verifies the updates against the next validators
+// of the next trusted header, which include the updates of the block.
+func (v *eventVerifier) verifyValidatorSetUpdates(
+	ctx context.Context,
+	data types.EventDataValidatorSetUpdates,
+) error {
+	if data.Height <= 0 {
+		// Sent by a full node not reporting the height of the updates.
+		return errUnverifiableEvent
+	}
+	next, err := v.lightBlock(ctx, data.Height+1)
+	if err != nil {
+		return err
+	}
+	return v.verifyValidatorUpdates(ctx, next, data.Height, data.ValidatorUpdates)
+}
+
+// verifyValidatorUpdates verifies the validator updates of the block at the
+// given height against the validator set they result in, which is committed
+// by the next validators of the next trusted header.
+func (v *eventVerifier) verifyValidatorUpdates(
+	ctx context.Context,
+	next *types.LightBlock,
+	height int64,
+	updates []*types.Validator,
+) error {
+	valsHeight := height + 2
+	vals := &types.ValidatorSet{}
+	for page := 1; ; page++ {
+		perPage := maxPerPage
+		res, err := v.c.next.Validators(ctx, &valsHeight, &page, &perPage)
+		if err != nil {
+			return err
+		}
+		vals.Validators = append(vals.Validators, res.Validators...)
+		if len(res.Validators) == 0 || len(vals.Validators) >= res.Total {
+			break
+		}
+	}
+	if vH, tH := vals.Hash(), next.NextValidatorsHash; !bytes.Equal(vH, tH) {
+		return fmt.Errorf("validators %X do not match with trusted next validators %X", vH, tH)
+	}
+
+	for _, update := range updates {
+		_, val := vals.GetByAddress(update.Address)
+		switch {
+		case update.VotingPower == 0 && val != nil:
+			return fmt.Errorf("removed validator %v is still in the validator set", update.Address)
+		case update.VotingPower == 0:
+		case val == nil || !val.PubKey.Equals(update.PubKey) || val.VotingPower != update.VotingPower:
+			return fmt.Errorf("validator update %v does not match with the validator set", update.Address)
+		}
+	}
+	return nil
+}
+
+// blockWithResults returns the trusted block and block results at the given
+// height.
+func (v *eventVerifier) blockWithResults(
+	ctx context.Context,
+	height int64,
+) (*ctypes.ResultBlock, *ctypes.ResultBlockResults, error) {
+	// The results are verified against the next header. It is waited for
+	// before locking, so that the events waiting for it don't wait in turn.
+	if _, err := v.lightBlock(ctx, height+1); err != nil {
+		return nil, nil, err
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if v.block != nil && v.block.Block.Height == height {
+		return v.block, v.results, nil
+	}
+	block, err := v.c.Block(ctx, &height)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := v.c.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, nil, err
+	}
+	v.block, v.results = block, results
+	return block, results, nil
+}
+
+// lightBlock returns the trusted light block at the given height, waiting for
+// it if the full node has just committed the previous one.
+func (v *eventVerifier) lightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	return v.c.waitForLightBlock(ctx, height, eventVerificationTimeout)
+}
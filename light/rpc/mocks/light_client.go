@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	light "github.com/cometbft/cometbft/light"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -109,6 +111,20 @@ func (_m *LightClient) VerifyLightBlockAtHeight(ctx context.Context, height int6
 	return r0, r1
 }
 
+// WitnessPoolStatus provides a mock function with given fields:
+func (_m *LightClient) WitnessPoolStatus() light.WitnessPoolStatus {
+	ret := _m.Called()
+
+	var r0 light.WitnessPoolStatus
+	if rf, ok := ret.Get(0).(func() light.WitnessPoolStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(light.WitnessPoolStatus)
+	}
+
+	return r0
+}
+
 // NewLightClient creates a new instance of LightClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLightClient(t interface {
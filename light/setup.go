@@ -13,6 +13,8 @@ import (
 // for both the primary provider and witnesses of the light client. A trusted
 // header and hash must be passed to initialize the client.
 //
+// The providers of the witness pool are also created from HTTP addresses.
+//
 // See all Option(s) for the additional configuration.
 // See NewClient.
 func NewHTTPClient(
@@ -36,7 +38,7 @@ func NewHTTPClient(
 		providers[len(providers)-1],
 		providers[:len(providers)-1],
 		trustedStore,
-		options...)
+		append([]Option{NewProviderFn(http.New)}, options...)...)
 }
 
 // NewHTTPClientFromTrustedStore initiates an instance of a light client using
@@ -64,7 +66,7 @@ func NewHTTPClientFromTrustedStore(
 		providers[len(providers)-1],
 		providers[:len(providers)-1],
 		trustedStore,
-		options...)
+		append([]Option{NewProviderFn(http.New)}, options...)...)
 }
 
 func providersFromAddresses(addrs []string, chainID string) ([]provider.Provider, error) {
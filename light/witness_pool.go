@@ -0,0 +1,271 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/light/provider"
+)
+
+const (
+	// Weight of the latest request in the moving averages of the latency and
+	// error rate of a provider.
+	providerStatsWeight = 0.2
+	// Number of requests after which the score of a provider is considered.
+	minProviderRequests = 10
+	// The primary is replaced by a witness whose score is this many times
+	// better.
+	primaryRotationFactor = 2
+
+	// Timeout of the requests to discover new witnesses.
+	witnessDiscoveryTimeout = 5 * time.Second
+)
+
+// NewProviderFunc creates a provider of the given chain, connected to the
+// given address.
+type NewProviderFunc func(chainID, address string) (provider.Provider, error)
+
+// peerLister is implemented by the providers which can list the RPC addresses
+// of the peers of their node (e.g. from its net_info).
+type peerLister interface {
+	PeerRPCAddresses(ctx context.Context) ([]string, error)
+}
+
+// ProviderStatus describes a provider of the light client and its score.
+type ProviderStatus struct {
+	Provider  string        `json:"provider"`
+	Requests  uint64        `json:"requests"`
+	Errors    uint64        `json:"errors"`
+	Latency   time.Duration `json:"latency"`
+	ErrorRate float64       `json:"error_rate"`
+	Score     float64       `json:"score"`
+}
+
+// WitnessPoolStatus describes the providers of the light client and the
+// addresses of the providers which can replace the witnesses removed.
+type WitnessPoolStatus struct {
+	Primary    ProviderStatus   `json:"primary"`
+	Witnesses  []ProviderStatus `json:"witnesses"`
+	Candidates []string         `json:"candidates"`
+}
+
+// providerStats holds the moving averages of the latency and error rate of
+// the requests to a provider.
+type providerStats struct {
+	requests  uint64
+	errors    uint64
+	latency   time.Duration
+	errorRate float64
+}
+
+func (s *providerStats) observe(latency time.Duration, failed bool) {
+	s.requests++
+	failure := 0.0
+	if failed {
+		s.errors++
+		failure = 1
+	}
+	if s.requests == 1 {
+		s.latency, s.errorRate = latency, failure
+		return
+	}
+	s.latency += time.Duration(providerStatsWeight * float64(latency-s.latency))
+	s.errorRate += providerStatsWeight * (failure - s.errorRate)
+}
+
+// score of the provider between 0 and 1, the higher the better. It decreases
+// with the error rate and the latency in seconds.
+func (s *providerStats) score() float64 {
+	return (1 - s.errorRate) / (1 + s.latency.Seconds())
+}
+
+// witnessPool scores the providers of the light client, and provides new
+// witnesses to replace the ones removed, from a list of candidate addresses
+// or from the peers of the primary.
+type witnessPool struct {
+	mtx cmtsync.Mutex
+
+	chainID     string
+	newProvider NewProviderFunc
+	candidates  []string
+	discover    bool
+	discovering bool
+
+	stats map[provider.Provider]*providerStats
+	// Providers removed for misbehaving, which are never used again.
+	removed map[string]struct{}
+}
+
+func newWitnessPool(chainID string) *witnessPool {
+	return &witnessPool{
+		chainID: chainID,
+		stats:   make(map[provider.Provider]*providerStats),
+		removed: make(map[string]struct{}),
+	}
+}
+
+func (p *witnessPool) validate() error {
+	if p.newProvider == nil && (len(p.candidates) > 0 || p.discover) {
+		return errors.New("witness candidates or discovery require a function to create providers (see NewProviderFn)")
+	}
+	return nil
+}
+
+// observe records the outcome of a request to a provider.
+func (p *witnessPool) observe(pr provider.Provider, latency time.Duration, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	switch err {
+	case context.Canceled:
+		// The request was canceled by the client.
+		return
+	case nil, provider.ErrLightBlockNotFound, provider.ErrHeightTooHigh:
+		// The provider responded, even if it does not have the light block.
+		err = nil
+	}
+	s, ok := p.stats[pr]
+	if !ok {
+		s = &providerStats{}
+		p.stats[pr] = s
+	}
+	s.observe(latency, err != nil)
+}
+
+// remove marks a provider as misbehaving so that it is not used again.
+func (p *witnessPool) remove(pr provider.Provider) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	delete(p.stats, pr)
+	p.removed[fmt.Sprint(pr)] = struct{}{}
+}
+
+// candidateAddresses returns the addresses of the providers which can
+// replace the witnesses removed.
+func (p *witnessPool) candidateAddresses() []string {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return append([]string{}, p.candidates...)
+}
+
+// status returns the status of the given provider.
+func (p *witnessPool) status(pr provider.Provider) ProviderStatus {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	status := ProviderStatus{Provider: fmt.Sprint(pr), Score: 1}
+	if s, ok := p.stats[pr]; ok {
+		status.Requests = s.requests
+		status.Errors = s.errors
+		status.Latency = s.latency
+		status.ErrorRate = s.errorRate
+		status.Score = s.score()
+	}
+	return status
+}
+
+// bestWitness returns the index of the witness to promote when the primary
+// has degraded, or -1 if the primary should be kept.
+func (p *witnessPool) bestWitness(primary provider.Provider, witnesses []provider.Provider) int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	ps, ok := p.stats[primary]
+	if !ok || ps.requests < minProviderRequests {
+		return -1
+	}
+	best, bestScore := -1, ps.score()*primaryRotationFactor
+	for i, w := range witnesses {
+		ws, ok := p.stats[w]
+		if !ok || ws.requests < minProviderRequests {
+			continue
+		}
+		if score := ws.score(); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// newWitnesses returns up to n new witnesses from the candidate addresses,
+// which are neither in use nor removed.
+func (p *witnessPool) newWitnesses(n int, primary provider.Provider, inUse []provider.Provider) []provider.Provider {
+	if n <= 0 || p.newProvider == nil {
+		return nil
+	}
+	used := make(map[string]struct{}, len(inUse)+1)
+	used[fmt.Sprint(primary)] = struct{}{}
+	for _, w := range inUse {
+		used[fmt.Sprint(w)] = struct{}{}
+	}
+	return p.fromCandidates(n, used)
+}
+
+// discoverCandidates adds the addresses of the peers of the primary to the
+// candidates. It returns false if discovery is disabled, unsupported by the
+// primary, already in progress or failed. It makes a network request, so the
+// caller must not hold the providerMutex lock of the client.
+func (p *witnessPool) discoverCandidates(primary provider.Provider) bool {
+	lister, ok := primary.(peerLister)
+	if !ok || !p.discover || p.newProvider == nil {
+		return false
+	}
+	p.mtx.Lock()
+	if p.discovering {
+		p.mtx.Unlock()
+		return false
+	}
+	p.discovering = true
+	p.mtx.Unlock()
+	defer func() {
+		p.mtx.Lock()
+		p.discovering = false
+		p.mtx.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), witnessDiscoveryTimeout)
+	defer cancel()
+	addrs, err := lister.PeerRPCAddresses(ctx)
+	if err != nil {
+		return false
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.candidates = append(p.candidates, addrs...)
+	return true
+}
+
+// fromCandidates creates up to n providers from the candidate addresses,
+// skipping the ones used or removed. The addresses of the providers created
+// are removed from the candidates.
+func (p *witnessPool) fromCandidates(n int, used map[string]struct{}) []provider.Provider {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	var witnesses []provider.Provider
+	for len(p.candidates) > 0 && len(witnesses) < n {
+		addr := p.candidates[0]
+		p.candidates = p.candidates[1:]
+
+		w, err := p.newProvider(p.chainID, addr)
+		if err != nil {
+			continue
+		}
+		key := fmt.Sprint(w)
+		if _, ok := used[key]; ok {
+			continue
+		}
+		if _, ok := p.removed[key]; ok {
+			continue
+		}
+		used[key] = struct{}{}
+		witnesses = append(witnesses, w)
+	}
+	return witnesses
+}
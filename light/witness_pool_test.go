@@ -0,0 +1,63 @@
+package light
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/light/provider"
+	mockp "github.com/cometbft/cometbft/light/provider/mock"
+)
+
+func TestProviderStats(t *testing.T) {
+	s := &providerStats{}
+	s.observe(100*time.Millisecond, false)
+	assert.Equal(t, 100*time.Millisecond, s.latency)
+	assert.Zero(t, s.errorRate)
+
+	s.observe(600*time.Millisecond, true)
+	assert.EqualValues(t, 2, s.requests)
+	assert.EqualValues(t, 1, s.errors)
+	assert.Equal(t, 200*time.Millisecond, s.latency)
+	assert.InDelta(t, 0.2, s.errorRate, 1e-9)
+	assert.InDelta(t, 0.8/1.2, s.score(), 1e-9)
+}
+
+func TestWitnessPoolBestWitness(t *testing.T) {
+	var (
+		pool      = newWitnessPool("chain")
+		primary   = mockp.NewDeadMock("chain")
+		fast      = mockp.NewDeadMock("chain")
+		faster    = mockp.NewDeadMock("chain")
+		witnesses = []provider.Provider{fast, faster}
+	)
+
+	observe := func(p provider.Provider, n int, latency time.Duration, err error) {
+		for i := 0; i < n; i++ {
+			pool.observe(p, latency, err)
+		}
+	}
+
+	// Not enough requests to score the providers.
+	observe(primary, minProviderRequests-1, 3*time.Second, nil)
+	observe(fast, minProviderRequests, 10*time.Millisecond, nil)
+	assert.Equal(t, -1, pool.bestWitness(primary, witnesses))
+
+	observe(primary, 1, 3*time.Second, nil)
+	observe(faster, minProviderRequests, time.Millisecond, nil)
+	assert.Equal(t, 1, pool.bestWitness(primary, witnesses))
+
+	// The primary recovers, and the witnesses fail.
+	observe(primary, 20, 10*time.Millisecond, nil)
+	observe(fast, 20, 10*time.Millisecond, provider.ErrNoResponse)
+	observe(faster, 20, time.Millisecond, context.DeadlineExceeded)
+	assert.Equal(t, -1, pool.bestWitness(primary, witnesses))
+
+	// Canceled requests and missing light blocks are not errors.
+	observe(fast, 20, time.Millisecond, context.Canceled)
+	observe(fast, 20, time.Millisecond, provider.ErrLightBlockNotFound)
+	assert.EqualValues(t, 50, pool.status(fast).Requests)
+	assert.EqualValues(t, 20, pool.status(fast).Errors)
+}
@@ -62,6 +62,9 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Write-ahead log of the txs added and removed, if enabled.
+	wal *WAL
+
 	logger  log.Logger
 	metrics *Metrics
 }
@@ -145,6 +148,12 @@ func (mem *CListMempool) removeAllTxs() {
 		mem.invokeRemoveTxOnReactor(key.(types.TxKey))
 		return true
 	})
+
+	// A transaction may still be added by a response to an earlier CheckTx,
+	// which is not serialized with Flush, so keep whatever is in the list.
+	if err := mem.wal.compact(mem.pendingTxs); err != nil {
+		mem.logger.Error("failed to truncate mempool WAL", "err", err)
+	}
 }
 
 // NOTE: not thread safe - should only be called once, on startup
@@ -188,6 +197,12 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithWAL sets the write-ahead log where the mempool records the txs added to
+// and removed from it. See ReplayWAL to restore them on startup.
+func WithWAL(wal *WAL) CListMempoolOption {
+	return func(mem *CListMempool) { mem.wal = wal }
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -215,8 +230,8 @@ func (mem *CListMempool) FlushAppConn() error {
 
 // XXX: Unsafe! Calling Flush may leave mempool in inconsistent state.
 func (mem *CListMempool) Flush() {
-	mem.updateMtx.RLock()
-	defer mem.updateMtx.RUnlock()
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
 
 	_ = atomic.SwapInt64(&mem.txsBytes, 0)
 	mem.cache.Reset()
@@ -327,6 +342,9 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	mem.txsMap.Store(memTx.tx.Key(), e)
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	if err := mem.wal.AddTx(memTx.tx); err != nil {
+		mem.logger.Error("failed to write tx to mempool WAL", "tx", memTx.tx.Hash(), "err", err)
+	}
 }
 
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
@@ -343,6 +361,9 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 		mem.txsMap.Delete(txKey)
 		tx := elem.Value.(*mempoolTx).tx
 		atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
+		if err := mem.wal.RemoveTx(txKey); err != nil {
+			mem.logger.Error("failed to write tx removal to mempool WAL", "key", txKey, "err", err)
+		}
 		return nil
 	}
 	return errors.New("transaction not found in mempool")
@@ -623,6 +644,10 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	if mem.wal.needsCompaction(mem.SizeBytes()) {
+		mem.compactWAL()
+	}
+
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
 	if mem.Size() > 0 {
@@ -643,6 +668,23 @@ func (mem *CListMempool) Update(
 	return nil
 }
 
+// compactWAL rewrites the WAL with only the txs currently in the mempool.
+// Lock() must be help by the caller during execution.
+func (mem *CListMempool) compactWAL() {
+	if err := mem.wal.compact(mem.pendingTxs); err != nil {
+		mem.logger.Error("failed to compact mempool WAL", "err", err)
+	}
+}
+
+// pendingTxs returns the transactions in the mempool, in order of arrival.
+func (mem *CListMempool) pendingTxs() types.Txs {
+	txs := make(types.Txs, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).tx)
+	}
+	return txs
+}
+
 func (mem *CListMempool) recheckTxs() {
 	if mem.Size() == 0 {
 		panic("recheckTxs is called, but the mempool is empty")
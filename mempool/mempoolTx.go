@@ -11,6 +11,15 @@ type mempoolTx struct {
 	height    int64    // height that this tx had been validated in
 	gasWanted int64    // amount of gas this tx states it will require
 	tx        types.Tx // validated by the application
+
+	// The following fields are only used by PriorityMempool.
+	priority int64  // priority set by the application, updated on recheck
+	sender   string // sender set by the application, if any
+	seq      int64  // order in which the tx was added to the mempool
+
+	// index in the eviction heap of the PriorityMempool, or -1 if the tx is
+	// not in it
+	evictionIndex int
 }
 
 // Height returns the height for this transaction
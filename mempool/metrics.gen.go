@@ -40,6 +40,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "rejected_txs",
 			Help:      "Number of rejected transactions.",
 		}, labels).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of evicted transactions.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -61,6 +67,7 @@ func NopMetrics() *Metrics {
 		TxSizeBytes:        discard.NewHistogram(),
 		FailedTxs:          discard.NewCounter(),
 		RejectedTxs:        discard.NewCounter(),
+		EvictedTxs:         discard.NewCounter(),
 		RecheckTimes:       discard.NewCounter(),
 		AlreadyReceivedTxs: discard.NewCounter(),
 	}
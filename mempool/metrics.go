@@ -31,6 +31,12 @@ type Metrics struct {
 	//metrics:Number of rejected transactions.
 	RejectedTxs metrics.Counter
 
+	// EvictedTxs defines the number of evicted transactions. These are valid
+	// transactions that were removed from the mempool to make room for
+	// transactions with a higher priority (only used by the priority mempool).
+	//metrics:Number of evicted transactions.
+	EvictedTxs metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
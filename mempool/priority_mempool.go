@@ -0,0 +1,980 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync/atomic"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/clist"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// PriorityMempool is an in-memory pool for transactions that orders them by
+// the priority set by the application in ResponseCheckTx.
+//
+// Transactions are reaped in decreasing order of priority. Transactions with
+// the same priority are reaped in the order in which they were added to the
+// mempool. Transactions from the same sender (as set by the application in
+// ResponseCheckTx) are never reordered among themselves: a transaction is only
+// reaped after all the transactions from the same sender that were added to
+// the mempool before it.
+//
+// When the mempool is full, transactions with a lower priority than a new
+// transaction are evicted to make room for it, instead of rejecting the new
+// transaction.
+//
+// Transactions are also kept in a concurrent list, in the order in which they
+// were added, so that the reactor can gossip them exactly as it does for the
+// CListMempool.
+type PriorityMempool struct {
+	// Atomic integers
+	height          int64 // the last block Update()'d to
+	txsBytes        int64 // total size of mempool, in bytes
+	pendingRechecks int64 // number of recheck responses not yet received
+
+	// notify listeners (ie. consensus) when txs are available
+	notifiedTxsAvailable bool
+	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
+
+	// Function set by the reactor to be called when a transaction is removed
+	// from the mempool.
+	removeTxOnReactorCb func(txKey types.TxKey)
+
+	config *config.MempoolConfig
+
+	// Exclusive mutex for Update method to prevent concurrent execution of
+	// CheckTx or ReapMaxBytesMaxGas(ReapMaxTxs) methods.
+	updateMtx cmtsync.RWMutex
+	preCheck  PreCheckFunc
+	postCheck PostCheckFunc
+
+	proxyAppConn proxy.AppConnMempool
+
+	// Concurrent linked-list of valid txs, in the order in which they were
+	// added to the mempool, and an index from txKey to list element.
+	// `txs`, `txsMap` and `seq` must be modified while holding `mtx`.
+	mtx    cmtsync.RWMutex
+	txs    *clist.CList
+	txsMap map[types.TxKey]*clist.CElement
+	seq    int64 // sequence number of the last added tx
+
+	// The txs of each sender, in the order in which they were added, and the
+	// heap of the txs which can be evicted when the mempool is full.
+	// `senders` and `evictable` must be modified while holding `mtx`.
+	senders   map[string][]*mempoolTx
+	evictable evictionHeap
+
+	// Keep a cache of already-seen txs.
+	// This reduces the pressure on the proxyApp.
+	cache TxCache
+
+	// Write-ahead log of the txs added and removed, if enabled.
+	wal *WAL
+
+	logger  log.Logger
+	metrics *Metrics
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// PriorityMempoolOption sets an optional parameter on the PriorityMempool.
+type PriorityMempoolOption func(*PriorityMempool)
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	cfg *config.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...PriorityMempoolOption,
+) *PriorityMempool {
+	mp := &PriorityMempool{
+		config:       cfg,
+		proxyAppConn: proxyAppConn,
+		txs:          clist.New(),
+		txsMap:       make(map[types.TxKey]*clist.CElement),
+		senders:      make(map[string][]*mempoolTx),
+		height:       height,
+		logger:       log.NewNopLogger(),
+		metrics:      NopMetrics(),
+	}
+
+	if cfg.CacheSize > 0 {
+		mp.cache = NewLRUTxCache(cfg.CacheSize)
+	} else {
+		mp.cache = NopTxCache{}
+	}
+
+	proxyAppConn.SetResponseCallback(mp.globalCb)
+
+	for _, option := range options {
+		option(mp)
+	}
+
+	return mp
+}
+
+// WithPriorityPreCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns an error. This is ran before CheckTx. Only applies to the first
+// created block. After that, Update overwrites the existing value.
+func WithPriorityPreCheck(f PreCheckFunc) PriorityMempoolOption {
+	return func(mp *PriorityMempool) { mp.preCheck = f }
+}
+
+// WithPriorityPostCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns an error. This is ran after CheckTx. Only applies to the first
+// created block. After that, Update overwrites the existing value.
+func WithPriorityPostCheck(f PostCheckFunc) PriorityMempoolOption {
+	return func(mp *PriorityMempool) { mp.postCheck = f }
+}
+
+// WithPriorityMetrics sets the metrics.
+func WithPriorityMetrics(metrics *Metrics) PriorityMempoolOption {
+	return func(mp *PriorityMempool) { mp.metrics = metrics }
+}
+
+// WithPriorityWAL sets the write-ahead log where the mempool records the txs
+// added to and removed from it. See ReplayWAL to restore them on startup.
+func WithPriorityWAL(wal *WAL) PriorityMempoolOption {
+	return func(mp *PriorityMempool) { mp.wal = wal }
+}
+
+// SetLogger sets the Logger.
+func (mp *PriorityMempool) SetLogger(l log.Logger) {
+	mp.logger = l
+}
+
+// NOTE: not thread safe - should only be called once, on startup
+func (mp *PriorityMempool) EnableTxsAvailable() {
+	mp.txsAvailable = make(chan struct{}, 1)
+}
+
+func (mp *PriorityMempool) SetTxRemovedCallback(cb func(txKey types.TxKey)) {
+	mp.removeTxOnReactorCb = cb
+}
+
+func (mp *PriorityMempool) invokeRemoveTxOnReactor(txKey types.TxKey) {
+	if mp.removeTxOnReactorCb != nil {
+		mp.removeTxOnReactorCb(txKey)
+	}
+}
+
+// InMempool returns true if the transaction with the given key is in the
+// mempool.
+func (mp *PriorityMempool) InMempool(txKey types.TxKey) bool {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	_, ok := mp.txsMap[txKey]
+	return ok
+}
+
+func (mp *PriorityMempool) addToCache(tx types.Tx) bool {
+	return mp.cache.Push(tx)
+}
+
+func (mp *PriorityMempool) forceRemoveFromCache(tx types.Tx) {
+	mp.cache.Remove(tx)
+}
+
+// tryRemoveFromCache removes a transaction from the cache in case it can be
+// added to the mempool at a later stage (probably when the transaction becomes
+// valid).
+func (mp *PriorityMempool) tryRemoveFromCache(tx types.Tx) {
+	if !mp.config.KeepInvalidTxsInCache {
+		mp.forceRemoveFromCache(tx)
+	}
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) Lock() {
+	mp.updateMtx.Lock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) Unlock() {
+	mp.updateMtx.Unlock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) Size() int {
+	return mp.txs.Len()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) SizeBytes() int64 {
+	return atomic.LoadInt64(&mp.txsBytes)
+}
+
+// Lock() must be help by the caller during execution.
+func (mp *PriorityMempool) FlushAppConn() error {
+	return mp.proxyAppConn.Flush(context.TODO())
+}
+
+// XXX: Unsafe! Calling Flush may leave mempool in inconsistent state.
+func (mp *PriorityMempool) Flush() {
+	mp.updateMtx.Lock()
+	defer mp.updateMtx.Unlock()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	// The WAL is truncated at once below, rather than recording the removal
+	// of every tx.
+	for txKey, e := range mp.txsMap {
+		mp.detachTx(txKey, e)
+		mp.invokeRemoveTxOnReactor(txKey)
+	}
+	_ = atomic.SwapInt64(&mp.txsBytes, 0)
+	mp.cache.Reset()
+
+	if err := mp.wal.compact(mp.pendingTxs); err != nil {
+		mp.logger.Error("failed to truncate mempool WAL", "err", err)
+	}
+}
+
+// TxsFront returns the first transaction, in order of arrival, for peer
+// goroutines to call .NextWait() on.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) TxsFront() *clist.CElement {
+	return mp.txs.Front()
+}
+
+// TxsWaitChan returns a channel to wait on transactions. It will be closed
+// once the mempool is not empty.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) TxsWaitChan() <-chan struct{} {
+	return mp.txs.WaitChan()
+}
+
+// CheckTx sends the transaction to the application for validation. Unlike
+// CListMempool, it does not reject the transaction when the mempool is full,
+// because the priority of the transaction is only known once the application
+// has checked it.
+//
+// It blocks if we're waiting on Update() or Reap().
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) CheckTx(tx types.Tx) (*abcicli.ReqRes, error) {
+	mp.updateMtx.RLock()
+	// use defer to unlock mutex because application (*local client*) might panic
+	defer mp.updateMtx.RUnlock()
+
+	txSize := len(tx)
+
+	if txSize > mp.config.MaxTxBytes {
+		return nil, ErrTxTooLarge{
+			Max:    mp.config.MaxTxBytes,
+			Actual: txSize,
+		}
+	}
+
+	// No matter how many transactions we evict, this one would not fit.
+	if int64(txSize) > mp.config.MaxTxsBytes || mp.config.Size == 0 {
+		return nil, ErrMempoolIsFull{
+			NumTxs:      mp.Size(),
+			MaxTxs:      mp.config.Size,
+			TxsBytes:    mp.SizeBytes(),
+			MaxTxsBytes: mp.config.MaxTxsBytes,
+		}
+	}
+
+	if mp.preCheck != nil {
+		if err := mp.preCheck(tx); err != nil {
+			return nil, ErrPreCheck{
+				Reason: err,
+			}
+		}
+	}
+
+	// NOTE: proxyAppConn may error if tx buffer is full
+	if err := mp.proxyAppConn.Error(); err != nil {
+		return nil, err
+	}
+
+	if added := mp.addToCache(tx); !added {
+		mp.metrics.AlreadyReceivedTxs.Add(1)
+		return nil, ErrTxInCache
+	}
+
+	reqRes, err := mp.proxyAppConn.CheckTxAsync(context.TODO(), &abci.RequestCheckTx{Tx: tx})
+	if err != nil {
+		mp.logger.Error("RequestCheckTx", "err", err)
+		return nil, err
+	}
+
+	return reqRes, nil
+}
+
+// Global callback that will be called after every ABCI response.
+func (mp *PriorityMempool) globalCb(req *abci.Request, res *abci.Response) {
+	r, ok := res.Value.(*abci.Response_CheckTx)
+	if !ok {
+		// ignore other messages
+		return
+	}
+
+	switch req.GetCheckTx().GetType() {
+	case abci.CheckTxType_New:
+		mp.resCbFirstTime(req.GetCheckTx().Tx, r.CheckTx)
+
+	case abci.CheckTxType_Recheck:
+		mp.metrics.RecheckTimes.Add(1)
+		mp.resCbRecheck(req.GetCheckTx().Tx, r.CheckTx)
+	}
+
+	// update metrics
+	mp.metrics.Size.Set(float64(mp.Size()))
+}
+
+// callback, which is called after the app checked the tx for the first time.
+func (mp *PriorityMempool) resCbFirstTime(tx types.Tx, res *abci.ResponseCheckTx) {
+	var postCheckErr error
+	if mp.postCheck != nil {
+		postCheckErr = mp.postCheck(tx, res)
+	}
+
+	if !res.IsOK() || postCheckErr != nil {
+		mp.tryRemoveFromCache(tx)
+		mp.logger.Debug(
+			"rejected invalid transaction",
+			"tx", tx.Hash(),
+			"res", res,
+			"err", postCheckErr,
+		)
+		mp.metrics.FailedTxs.Add(1)
+		return
+	}
+
+	memTx := &mempoolTx{
+		height:    mp.height,
+		gasWanted: res.GasWanted,
+		tx:        tx,
+		priority:  res.Priority,
+		sender:    res.Sender,
+	}
+	added, err := mp.addTx(memTx)
+	if err != nil {
+		mp.forceRemoveFromCache(tx) // mempool might have space later
+		mp.logger.Debug(
+			"rejected transaction",
+			"tx", tx.Hash(),
+			"priority", res.Priority,
+			"err", err,
+		)
+		mp.metrics.RejectedTxs.Add(1)
+		return
+	}
+	if !added {
+		mp.logger.Debug(
+			"transaction already there, not adding it again",
+			"tx", tx.Hash(),
+			"height", mp.height,
+			"total", mp.Size(),
+		)
+		return
+	}
+
+	mp.logger.Debug(
+		"added valid transaction",
+		"tx", tx.Hash(),
+		"priority", res.Priority,
+		"sender", res.Sender,
+		"height", mp.height,
+		"total", mp.Size(),
+	)
+	mp.notifyTxsAvailable()
+}
+
+// addTx adds memTx to the mempool, evicting transactions with a lower priority
+// if the mempool is full. It returns false if the transaction is already in
+// the mempool, and an error if there is not enough room for it.
+func (mp *PriorityMempool) addTx(memTx *mempoolTx) (bool, error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txKey := memTx.tx.Key()
+	if _, ok := mp.txsMap[txKey]; ok {
+		return false, nil
+	}
+
+	if err := mp.makeRoomFor(memTx); err != nil {
+		return false, err
+	}
+
+	mp.seq++
+	memTx.seq = mp.seq
+	e := mp.txs.PushBack(memTx)
+	mp.txsMap[txKey] = e
+	mp.trackEviction(memTx)
+	atomic.AddInt64(&mp.txsBytes, int64(len(memTx.tx)))
+	mp.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	if err := mp.wal.AddTx(memTx.tx); err != nil {
+		mp.logger.Error("failed to write tx to mempool WAL", "tx", memTx.tx.Hash(), "err", err)
+	}
+
+	return true, nil
+}
+
+// makeRoomFor evicts transactions with a priority strictly lower than the
+// priority of memTx until memTx fits in the mempool. Only the last transaction
+// of a sender can be evicted, so that the remaining transactions of that
+// sender are still valid in sequence, and never one from the sender of memTx,
+// which is added after them. Either enough transactions are evicted, or none
+// is and an ErrMempoolIsFull is returned.
+//
+// Must be called with mtx held.
+func (mp *PriorityMempool) makeRoomFor(memTx *mempoolTx) error {
+	var (
+		numTxs   = mp.txs.Len()
+		txsBytes = atomic.LoadInt64(&mp.txsBytes)
+		txSize   = int64(len(memTx.tx))
+	)
+	fits := func() bool {
+		return numTxs < mp.config.Size && txsBytes+txSize <= mp.config.MaxTxsBytes
+	}
+	if fits() {
+		return nil
+	}
+
+	// Pick the victims by increasing priority and, among transactions with the
+	// same priority, newest first. The eviction heap is left untouched until
+	// enough victims are found: the candidates are its entries, visited in
+	// order, and the transactions preceding a victim from the same sender.
+	candidates := &evictionCandidates{}
+	if len(mp.evictable) > 0 {
+		heap.Push(candidates, mp.evictionCandidate(0))
+	}
+	var victims []*mempoolTx
+	for !fits() && candidates.Len() > 0 {
+		c := heap.Pop(candidates).(evictionCandidate)
+		if c.memTx.priority >= memTx.priority {
+			break
+		}
+		if c.index >= 0 {
+			for _, child := range []int{2*c.index + 1, 2*c.index + 2} {
+				if child < len(mp.evictable) {
+					heap.Push(candidates, mp.evictionCandidate(child))
+				}
+			}
+		}
+		// Evicting the last transaction of the sender of memTx would leave a
+		// gap in its sequence, as memTx is appended after it.
+		if memTx.sender != "" && c.memTx.sender == memTx.sender {
+			continue
+		}
+		victims = append(victims, c.memTx)
+		numTxs--
+		txsBytes -= int64(len(c.memTx.tx))
+		if c.pos > 0 {
+			heap.Push(candidates, evictionCandidate{
+				memTx: mp.senders[c.memTx.sender][c.pos-1],
+				index: -1,
+				pos:   c.pos - 1,
+			})
+		}
+	}
+
+	if !fits() {
+		return ErrMempoolIsFull{
+			NumTxs:      mp.txs.Len(),
+			MaxTxs:      mp.config.Size,
+			TxsBytes:    atomic.LoadInt64(&mp.txsBytes),
+			MaxTxsBytes: mp.config.MaxTxsBytes,
+		}
+	}
+
+	for _, victim := range victims {
+		txKey := victim.tx.Key()
+		mp.removeTx(txKey, mp.txsMap[txKey])
+		mp.invokeRemoveTxOnReactor(txKey)
+		// The evicted transaction may be valid and be sent again later.
+		mp.forceRemoveFromCache(victim.tx)
+		mp.logger.Debug(
+			"evicted transaction",
+			"tx", victim.tx.Hash(),
+			"priority", victim.priority,
+			"new_tx_priority", memTx.priority,
+		)
+	}
+	mp.metrics.EvictedTxs.Add(float64(len(victims)))
+
+	return nil
+}
+
+// removeTx removes the given element from the mempool and records the
+// removal in the WAL.
+// Must be called with mtx held.
+func (mp *PriorityMempool) removeTx(txKey types.TxKey, e *clist.CElement) {
+	mp.detachTx(txKey, e)
+	atomic.AddInt64(&mp.txsBytes, int64(-len(e.Value.(*mempoolTx).tx)))
+	if err := mp.wal.RemoveTx(txKey); err != nil {
+		mp.logger.Error("failed to write tx removal to mempool WAL", "key", txKey, "err", err)
+	}
+}
+
+// detachTx removes the given element from the list, the map and the eviction
+// index of the mempool.
+// Must be called with mtx held.
+func (mp *PriorityMempool) detachTx(txKey types.TxKey, e *clist.CElement) {
+	mp.txs.Remove(e)
+	e.DetachPrev()
+	delete(mp.txsMap, txKey)
+	mp.untrackEviction(e.Value.(*mempoolTx))
+}
+
+// RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+// Called from:
+//   - Update (lock held) if tx was committed
+//   - resCbRecheck (lock not held) if tx was invalidated
+func (mp *PriorityMempool) RemoveTxByKey(txKey types.TxKey) error {
+	// The transaction should be removed from the reactor, even if it cannot be
+	// found in the mempool.
+	mp.invokeRemoveTxOnReactor(txKey)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if e, ok := mp.txsMap[txKey]; ok {
+		mp.removeTx(txKey, e)
+		return nil
+	}
+	return errors.New("transaction not found in mempool")
+}
+
+// callback, which is called after the app rechecked the tx.
+func (mp *PriorityMempool) resCbRecheck(tx types.Tx, res *abci.ResponseCheckTx) {
+	var postCheckErr error
+	if mp.postCheck != nil {
+		postCheckErr = mp.postCheck(tx, res)
+	}
+
+	if !res.IsOK() || postCheckErr != nil {
+		// Tx became invalidated due to newly committed block.
+		mp.logger.Debug("tx is no longer valid", "tx", tx.Hash(), "res", res, "err", postCheckErr)
+		if err := mp.RemoveTxByKey(tx.Key()); err != nil {
+			mp.logger.Debug("Transaction could not be removed from mempool", "err", err)
+		}
+		mp.tryRemoveFromCache(tx)
+	} else {
+		// The priority of a transaction may change with the application state.
+		mp.mtx.Lock()
+		if e, ok := mp.txsMap[tx.Key()]; ok {
+			memTx := e.Value.(*mempoolTx)
+			memTx.priority = res.Priority
+			if memTx.evictionIndex >= 0 {
+				heap.Fix(&mp.evictable, memTx.evictionIndex)
+			}
+		}
+		mp.mtx.Unlock()
+	}
+
+	if atomic.AddInt64(&mp.pendingRechecks, -1) == 0 {
+		mp.logger.Debug("done rechecking txs")
+
+		// incase the recheck removed all txs
+		if mp.Size() > 0 {
+			mp.notifyTxsAvailable()
+		}
+	}
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) TxsAvailable() <-chan struct{} {
+	return mp.txsAvailable
+}
+
+func (mp *PriorityMempool) notifyTxsAvailable() {
+	if mp.Size() == 0 {
+		panic("notified txs available but mempool is empty!")
+	}
+	if mp.txsAvailable != nil && !mp.notifiedTxsAvailable {
+		// channel cap is 1, so this will send once
+		mp.notifiedTxsAvailable = true
+		select {
+		case mp.txsAvailable <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ReapMaxBytesMaxGas reaps transactions in decreasing order of priority,
+// keeping the order of transactions from the same sender, until the next
+// transaction would exceed maxBytes or maxGas.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mp.updateMtx.RLock()
+	defer mp.updateMtx.RUnlock()
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	var (
+		totalGas    int64
+		runningSize int64
+	)
+
+	txs := make([]types.Tx, 0, mp.txs.Len())
+	mp.forEachByPriority(func(memTx *mempoolTx) bool {
+		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && runningSize+dataSize > maxBytes {
+			return false
+		}
+
+		// Check total gas requirement.
+		// If maxGas is negative, skip this check.
+		// Since newTotalGas < masGas, which
+		// must be non-negative, it follows that this won't overflow.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			return false
+		}
+
+		runningSize += dataSize
+		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+// ReapMaxTxs reaps up to max transactions, in the same order as
+// ReapMaxBytesMaxGas.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mp *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mp.updateMtx.RLock()
+	defer mp.updateMtx.RUnlock()
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	if max < 0 {
+		max = mp.txs.Len()
+	}
+
+	txs := make([]types.Tx, 0, max)
+	mp.forEachByPriority(func(memTx *mempoolTx) bool {
+		if len(txs) >= max {
+			return false
+		}
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+// forEachByPriority calls fn on every transaction in the mempool in reaping
+// order, until fn returns false.
+//
+// Must be called with mtx held.
+func (mp *PriorityMempool) forEachByPriority(fn func(*mempoolTx) bool) {
+	queues := &txQueueHeap{
+		queues: mp.senderQueues(),
+		less: func(a, b []*mempoolTx) bool {
+			if a[0].priority != b[0].priority {
+				return a[0].priority > b[0].priority
+			}
+			return a[0].seq < b[0].seq
+		},
+	}
+	heap.Init(queues)
+
+	for queues.Len() > 0 {
+		queue := heap.Pop(queues).([]*mempoolTx)
+		if !fn(queue[0]) {
+			return
+		}
+		if len(queue) > 1 {
+			heap.Push(queues, queue[1:])
+		}
+	}
+}
+
+// evictionCandidate returns the candidate for eviction of the transaction at
+// the given index of the eviction heap.
+//
+// Must be called with mtx held.
+func (mp *PriorityMempool) evictionCandidate(index int) evictionCandidate {
+	memTx := mp.evictable[index]
+	return evictionCandidate{memTx: memTx, index: index, pos: len(mp.senders[memTx.sender]) - 1}
+}
+
+// trackEviction adds memTx, just added to the mempool, to the eviction heap,
+// in place of the previous transaction from the same sender.
+//
+// Must be called with mtx held.
+func (mp *PriorityMempool) trackEviction(memTx *mempoolTx) {
+	memTx.evictionIndex = -1
+	if memTx.sender != "" {
+		queue := mp.senders[memTx.sender]
+		if len(queue) > 0 {
+			heap.Remove(&mp.evictable, queue[len(queue)-1].evictionIndex)
+		}
+		mp.senders[memTx.sender] = append(queue, memTx)
+	}
+	heap.Push(&mp.evictable, memTx)
+}
+
+// untrackEviction removes memTx, just removed from the mempool, from the
+// eviction heap, replacing it with the previous transaction from the same
+// sender if it was the last one.
+//
+// Must be called with mtx held.
+func (mp *PriorityMempool) untrackEviction(memTx *mempoolTx) {
+	if memTx.evictionIndex >= 0 {
+		heap.Remove(&mp.evictable, memTx.evictionIndex)
+	}
+	if memTx.sender == "" {
+		return
+	}
+	queue := mp.senders[memTx.sender]
+	for i, queued := range queue {
+		if queued == memTx {
+			queue = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+	if len(queue) == 0 {
+		delete(mp.senders, memTx.sender)
+		return
+	}
+	mp.senders[memTx.sender] = queue
+	if last := queue[len(queue)-1]; last.evictionIndex < 0 {
+		heap.Push(&mp.evictable, last)
+	}
+}
+
+// senderQueues groups the transactions in the mempool in queues, in the order
+// in which they were added. All transactions of a sender are in the same
+// queue, while each transaction without a sender is in a queue of its own.
+//
+// Must be called with mtx held.
+func (mp *PriorityMempool) senderQueues() [][]*mempoolTx {
+	var (
+		queues   = make([][]*mempoolTx, 0, mp.txs.Len())
+		bySender = make(map[string]int)
+	)
+	for e := mp.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if memTx.sender == "" {
+			queues = append(queues, []*mempoolTx{memTx})
+			continue
+		}
+		if i, ok := bySender[memTx.sender]; ok {
+			queues[i] = append(queues[i], memTx)
+			continue
+		}
+		bySender[memTx.sender] = len(queues)
+		queues = append(queues, []*mempoolTx{memTx})
+	}
+	return queues
+}
+
+// Lock() must be help by the caller during execution.
+// TODO: this function always returns nil; remove the return value
+func (mp *PriorityMempool) Update(
+	height int64,
+	txs types.Txs,
+	txResults []*abci.ExecTxResult,
+	preCheck PreCheckFunc,
+	postCheck PostCheckFunc,
+) error {
+	// Set height
+	mp.height = height
+	mp.notifiedTxsAvailable = false
+
+	if preCheck != nil {
+		mp.preCheck = preCheck
+	}
+	if postCheck != nil {
+		mp.postCheck = postCheck
+	}
+
+	for i, tx := range txs {
+		if txResults[i].Code == abci.CodeTypeOK {
+			// Add valid committed tx to the cache (if missing).
+			_ = mp.addToCache(tx)
+		} else {
+			mp.tryRemoveFromCache(tx)
+		}
+
+		// Remove committed tx from the mempool.
+		if err := mp.RemoveTxByKey(tx.Key()); err != nil {
+			mp.logger.Debug("Committed transaction not in local mempool (not an error)",
+				"key", tx.Key(),
+				"error", err.Error())
+		}
+	}
+
+	if mp.wal.needsCompaction(mp.SizeBytes()) {
+		mp.compactWAL()
+	}
+
+	// Either recheck non-committed txs to see if they became invalid
+	// or just notify there're some txs left.
+	if mp.Size() > 0 {
+		if mp.config.Recheck {
+			mp.logger.Debug("recheck txs", "numtxs", mp.Size(), "height", height)
+			mp.recheckTxs()
+		} else {
+			mp.notifyTxsAvailable()
+		}
+	}
+
+	// Update metrics
+	mp.metrics.Size.Set(float64(mp.Size()))
+
+	return nil
+}
+
+// compactWAL rewrites the WAL with only the txs currently in the mempool.
+// Lock() must be help by the caller during execution.
+func (mp *PriorityMempool) compactWAL() {
+	// Transactions are written to the WAL while holding mtx, so none can be
+	// added between the snapshot and the rotation of the WAL.
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	if err := mp.wal.compact(mp.pendingTxs); err != nil {
+		mp.logger.Error("failed to compact mempool WAL", "err", err)
+	}
+}
+
+// pendingTxs returns the transactions in the mempool, in order of arrival.
+// Must be called with mtx held.
+func (mp *PriorityMempool) pendingTxs() types.Txs {
+	txs := make(types.Txs, 0, mp.txs.Len())
+	for e := mp.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).tx)
+	}
+	return txs
+}
+
+func (mp *PriorityMempool) recheckTxs() {
+	// Copy the transactions first, as responses from a local client are
+	// delivered synchronously and modify the mempool.
+	mp.mtx.RLock()
+	txs := make([]types.Tx, 0, mp.txs.Len())
+	for e := mp.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).tx)
+	}
+	mp.mtx.RUnlock()
+
+	if len(txs) == 0 {
+		panic("recheckTxs is called, but the mempool is empty")
+	}
+	atomic.StoreInt64(&mp.pendingRechecks, int64(len(txs)))
+
+	// Push txs to proxyAppConn
+	// NOTE: globalCb may be called concurrently.
+	for _, tx := range txs {
+		_, err := mp.proxyAppConn.CheckTxAsync(context.TODO(), &abci.RequestCheckTx{
+			Tx:   tx,
+			Type: abci.CheckTxType_Recheck,
+		})
+		if err != nil {
+			mp.logger.Error("recheckTx", "err", err)
+			return
+		}
+	}
+}
+
+// txQueueHeap is a heap of transaction queues, ordered with the given less
+// function. It implements heap.Interface.
+type txQueueHeap struct {
+	queues [][]*mempoolTx
+	less   func(a, b []*mempoolTx) bool
+}
+
+func (h *txQueueHeap) Len() int           { return len(h.queues) }
+func (h *txQueueHeap) Less(i, j int) bool { return h.less(h.queues[i], h.queues[j]) }
+func (h *txQueueHeap) Swap(i, j int)      { h.queues[i], h.queues[j] = h.queues[j], h.queues[i] }
+
+func (h *txQueueHeap) Push(x interface{}) {
+	h.queues = append(h.queues, x.([]*mempoolTx))
+}
+
+func (h *txQueueHeap) Pop() interface{} {
+	n := len(h.queues)
+	queue := h.queues[n-1]
+	h.queues = h.queues[:n-1]
+	return queue
+}
+
+// evictionHeap is a heap of the transactions which can be evicted without
+// breaking the sequence of the transactions from their sender: the last
+// transaction of each sender, and the transactions without a sender. They are
+// ordered by increasing priority and, among transactions with the same
+// priority, newest first.
+type evictionHeap []*mempoolTx
+
+// evictsBefore returns true if a is evicted before b.
+func evictsBefore(a, b *mempoolTx) bool {
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	return a.seq > b.seq
+}
+
+func (h evictionHeap) Len() int           { return len(h) }
+func (h evictionHeap) Less(i, j int) bool { return evictsBefore(h[i], h[j]) }
+
+func (h evictionHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].evictionIndex = i
+	h[j].evictionIndex = j
+}
+
+func (h *evictionHeap) Push(x interface{}) {
+	memTx := x.(*mempoolTx)
+	memTx.evictionIndex = len(*h)
+	*h = append(*h, memTx)
+}
+
+func (h *evictionHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	memTx := old[n-1]
+	old[n-1] = nil
+	memTx.evictionIndex = -1
+	*h = old[:n-1]
+	return memTx
+}
+
+// evictionCandidate is a transaction considered for eviction by makeRoomFor.
+type evictionCandidate struct {
+	memTx *mempoolTx
+	index int // index in the eviction heap, or -1 if not in it
+	pos   int // position among the transactions from the same sender
+}
+
+// evictionCandidates is a heap of candidates, ordered like the eviction heap.
+type evictionCandidates []evictionCandidate
+
+func (h evictionCandidates) Len() int           { return len(h) }
+func (h evictionCandidates) Less(i, j int) bool { return evictsBefore(h[i].memTx, h[j].memTx) }
+func (h evictionCandidates) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *evictionCandidates) Push(x interface{}) {
+	*h = append(*h, x.(evictionCandidate))
+}
+
+func (h *evictionCandidates) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	*h = old[:n-1]
+	return c
+}
@@ -0,0 +1,301 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// priorityApp is an application that reads the sender and the priority of a
+// transaction from its content, which has the form "sender:priority:payload".
+// Transactions listed in invalidTxs fail CheckTx.
+type priorityApp struct {
+	abci.BaseApplication
+	invalidTxs map[string]bool
+	priorities map[string]int64 // overrides the priority in the tx
+}
+
+func newPriorityApp() *priorityApp {
+	return &priorityApp{
+		invalidTxs: make(map[string]bool),
+		priorities: make(map[string]int64),
+	}
+}
+
+func (app *priorityApp) CheckTx(_ context.Context, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	parts := strings.SplitN(string(req.Tx), ":", 3)
+	if len(parts) != 3 || app.invalidTxs[string(req.Tx)] {
+		return &abci.ResponseCheckTx{Code: 1}, nil
+	}
+	priority, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return &abci.ResponseCheckTx{Code: 1}, nil
+	}
+	if p, ok := app.priorities[string(req.Tx)]; ok {
+		priority = p
+	}
+	return &abci.ResponseCheckTx{
+		Code:      abci.CodeTypeOK,
+		GasWanted: 1,
+		Sender:    parts[0],
+		Priority:  priority,
+	}, nil
+}
+
+func newPriorityTx(sender string, priority int64, payload string) types.Tx {
+	return types.Tx(fmt.Sprintf("%s:%d:%s", sender, priority, payload))
+}
+
+func newPriorityMempoolWithApp(t *testing.T, app abci.Application, cfg *config.Config) *PriorityMempool {
+	t.Helper()
+
+	appConnMem, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+		os.RemoveAll(cfg.RootDir)
+	})
+
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func TestPriorityMempoolReapOrder(t *testing.T) {
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), test.ResetTestRoot("mempool_test"))
+
+	txs := types.Txs{
+		newPriorityTx("", 1, "a"),
+		newPriorityTx("", 10, "b"),
+		newPriorityTx("", 5, "c"),
+		newPriorityTx("", 10, "d"),
+		newPriorityTx("", 7, "e"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// Transactions with the same priority are reaped in order of arrival.
+	expected := types.Txs{txs[1], txs[3], txs[4], txs[2], txs[0]}
+	assert.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	assert.Equal(t, expected[:3], mp.ReapMaxBytesMaxGas(-1, 3))
+	assert.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+	assert.Equal(t, expected, mp.ReapMaxTxs(-1))
+
+	// The first two txs are 5 bytes long, plus 2 bytes of proto encoding.
+	assert.Equal(t, expected[:2], mp.ReapMaxBytesMaxGas(19, -1))
+}
+
+func TestPriorityMempoolSenderOrder(t *testing.T) {
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), test.ResetTestRoot("mempool_test"))
+
+	txs := types.Txs{
+		newPriorityTx("alice", 1, "a"),
+		newPriorityTx("bob", 5, "b"),
+		newPriorityTx("alice", 10, "c"),
+		newPriorityTx("", 3, "d"),
+		newPriorityTx("bob", 20, "e"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// A tx is never reaped before an earlier tx from the same sender, no matter
+	// its priority.
+	expected := types.Txs{txs[1], txs[4], txs[3], txs[0], txs[2]}
+	assert.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 3
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), cfg)
+
+	var removed []types.TxKey
+	mp.SetTxRemovedCallback(func(txKey types.TxKey) { removed = append(removed, txKey) })
+
+	txs := types.Txs{
+		newPriorityTx("alice", 1, "a"),
+		newPriorityTx("alice", 9, "b"),
+		newPriorityTx("", 2, "c"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, 3, mp.Size())
+
+	// Only the last tx of alice can be evicted, so the tx without sender is
+	// evicted instead of alice's first tx.
+	tx := newPriorityTx("", 5, "d")
+	callCheckTx(t, mp, types.Txs{tx})
+	require.Equal(t, 3, mp.Size())
+	assert.True(t, mp.InMempool(tx.Key()))
+	assert.False(t, mp.InMempool(txs[2].Key()))
+	assert.Equal(t, []types.TxKey{txs[2].Key()}, removed)
+
+	// A tx with a priority not higher than any evictable tx is rejected.
+	rejected := newPriorityTx("", 5, "e")
+	callCheckTx(t, mp, types.Txs{rejected})
+	require.Equal(t, 3, mp.Size())
+	assert.False(t, mp.InMempool(rejected.Key()))
+
+	// Rejected and evicted txs are removed from the cache, so they can be
+	// submitted again.
+	_, err := mp.CheckTx(rejected)
+	require.NoError(t, err)
+	_, err = mp.CheckTx(txs[2])
+	require.NoError(t, err)
+
+	// Several txs may be evicted for a single large tx. Alice's first tx, which
+	// has the lowest priority, is kept because her second tx is still there
+	// when the mempool has enough room.
+	cfg.Mempool.Size = 10
+	cfg.Mempool.MaxTxsBytes = mp.SizeBytes()
+	big := newPriorityTx("", 100, strings.Repeat("x", 6))
+	callCheckTx(t, mp, types.Txs{big})
+	assert.True(t, mp.InMempool(big.Key()))
+	assert.True(t, mp.InMempool(txs[0].Key()))
+	assert.False(t, mp.InMempool(txs[1].Key()))
+	assert.False(t, mp.InMempool(tx.Key()))
+	assert.Equal(t, 2, mp.Size())
+}
+
+func TestPriorityMempoolEvictionSameSender(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 3
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), cfg)
+
+	txs := types.Txs{
+		newPriorityTx("alice", 5, "a"),
+		newPriorityTx("alice", 1, "b"),
+		newPriorityTx("bob", 3, "c"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, 3, mp.Size())
+
+	// Alice's last tx has the lowest priority, but evicting it would leave a
+	// gap before her new tx, so bob's tx is evicted instead.
+	tx := newPriorityTx("alice", 10, "d")
+	callCheckTx(t, mp, types.Txs{tx})
+	assert.True(t, mp.InMempool(tx.Key()))
+	assert.True(t, mp.InMempool(txs[1].Key()))
+	assert.False(t, mp.InMempool(txs[2].Key()))
+	assert.Equal(t, types.Txs{txs[0], txs[1], tx}, mp.ReapMaxTxs(-1))
+
+	// Without any other sender's tx to evict, the tx is rejected.
+	rejected := newPriorityTx("alice", 20, "e")
+	callCheckTx(t, mp, types.Txs{rejected})
+	assert.False(t, mp.InMempool(rejected.Key()))
+	assert.Equal(t, 3, mp.Size())
+}
+
+func TestPriorityMempoolEvictionHeap(t *testing.T) {
+	app := newPriorityApp()
+	cfg := test.ResetTestRoot("mempool_test")
+	mp := newPriorityMempoolWithApp(t, app, cfg)
+
+	txs := types.Txs{
+		newPriorityTx("alice", 1, "a"),
+		newPriorityTx("alice", 2, "b"),
+		newPriorityTx("alice", 3, "c"),
+		newPriorityTx("bob", 4, "d"),
+		newPriorityTx("", 5, "e"),
+		newPriorityTx("", 6, "f"),
+	}
+	callCheckTx(t, mp, txs)
+	requireEvictable(t, mp, txs[2], txs[3], txs[4], txs[5])
+
+	// Removing alice's last tx makes the previous one evictable, and a
+	// recheck updates the priorities in the heap.
+	app.invalidTxs[string(txs[2])] = true
+	app.priorities[string(txs[5])] = 1
+	mp.Lock()
+	err := mp.Update(1, txs[3:4], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	requireEvictable(t, mp, txs[1], txs[4], txs[5])
+
+	// The tx without sender, now with the lowest priority, is evicted first,
+	// then alice's txs from the last one.
+	cfg.Mempool.Size = mp.Size()
+	tx := newPriorityTx("", 10, "g")
+	callCheckTx(t, mp, types.Txs{tx})
+	assert.False(t, mp.InMempool(txs[5].Key()))
+	requireEvictable(t, mp, txs[1], txs[4], tx)
+
+	cfg.Mempool.MaxTxsBytes = mp.SizeBytes()
+	big := newPriorityTx("", 10, strings.Repeat("x", 8))
+	callCheckTx(t, mp, types.Txs{big})
+	assert.True(t, mp.InMempool(big.Key()))
+	assert.False(t, mp.InMempool(txs[1].Key()))
+	assert.False(t, mp.InMempool(txs[0].Key()))
+	requireEvictable(t, mp, txs[4], tx, big)
+}
+
+// requireEvictable checks that the eviction heap of mp holds exactly the
+// given txs and is well ordered.
+func requireEvictable(t *testing.T, mp *PriorityMempool, txs ...types.Tx) {
+	t.Helper()
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	evictable := make(types.Txs, 0, len(mp.evictable))
+	for i, memTx := range mp.evictable {
+		require.Equal(t, i, memTx.evictionIndex)
+		if i > 0 {
+			require.False(t, evictsBefore(memTx, mp.evictable[(i-1)/2]))
+		}
+		evictable = append(evictable, memTx.tx)
+	}
+	require.ElementsMatch(t, txs, evictable)
+}
+
+func TestPriorityMempoolUpdate(t *testing.T) {
+	app := newPriorityApp()
+	mp := newPriorityMempoolWithApp(t, app, test.ResetTestRoot("mempool_test"))
+	mp.EnableTxsAvailable()
+
+	txs := types.Txs{
+		newPriorityTx("", 1, "a"),
+		newPriorityTx("", 2, "b"),
+		newPriorityTx("", 3, "c"),
+		newPriorityTx("", 4, "d"),
+	}
+	callCheckTx(t, mp, txs)
+	ensureFire(t, mp.TxsAvailable(), 100)
+
+	// On recheck, the first tx becomes invalid and the second one has the
+	// highest priority.
+	app.invalidTxs[string(txs[0])] = true
+	app.priorities[string(txs[1])] = 100
+
+	mp.Lock()
+	err := mp.Update(1, txs[3:], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	ensureFire(t, mp.TxsAvailable(), 100)
+
+	assert.Equal(t, types.Txs{txs[1], txs[2]}, mp.ReapMaxTxs(-1))
+	assert.EqualValues(t, len(txs[1])+len(txs[2]), mp.SizeBytes())
+
+	// The committed tx is still in the cache.
+	_, err = mp.CheckTx(txs[3])
+	assert.Equal(t, ErrTxInCache, err)
+
+	mp.Flush()
+	assert.Zero(t, mp.Size())
+	assert.Zero(t, mp.SizeBytes())
+}
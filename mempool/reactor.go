@@ -16,13 +16,40 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
+// GossipMempool is a mempool whose transactions can be traversed by the
+// reactor, in the order in which they were added, to gossip them to peers.
+// The values of the list elements are of type *mempoolTx, so only mempools
+// defined in this package can implement it.
+type GossipMempool interface {
+	Mempool
+
+	// SetLogger sets the Logger.
+	SetLogger(l log.Logger)
+
+	// InMempool returns true if the transaction with the given key is in the
+	// mempool.
+	InMempool(txKey types.TxKey) bool
+
+	// TxsFront returns the first transaction in the list of transactions.
+	TxsFront() *clist.CElement
+
+	// TxsWaitChan returns a channel that is closed once the mempool is not
+	// empty.
+	TxsWaitChan() <-chan struct{}
+}
+
+var (
+	_ GossipMempool = (*CListMempool)(nil)
+	_ GossipMempool = (*PriorityMempool)(nil)
+)
+
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
 	config  *cfg.MempoolConfig
-	mempool *CListMempool
+	mempool GossipMempool
 
 	// `txSenders` maps every received transaction to the set of peer IDs that
 	// have sent the transaction to this node. Sender IDs are used during
@@ -33,7 +60,7 @@ type Reactor struct {
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, mempool GossipMempool) *Reactor {
 	memR := &Reactor{
 		config:    config,
 		mempool:   mempool,
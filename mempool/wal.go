@@ -0,0 +1,451 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	cmtmempool "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+const (
+	// how often the WAL should be sync'd during period sync'ing
+	walDefaultFlushInterval = 2 * time.Second
+
+	// upper bound of the bytes added to a transaction when encoded as a WAL
+	// message: time, protobuf tags and lengths.
+	walMsgOverheadBytes = 64
+
+	// maximum size of the messages read from the WAL. It doesn't depend on
+	// max_tx_bytes, which may have been lowered since the messages were
+	// written: the transactions that became too big are rejected by CheckTx
+	// when replayed.
+	walMaxMsgSizeBytes = types.MaxBlockSizeBytes + walMsgOverheadBytes
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// WAL is a write-ahead log of the transactions added to and removed from the
+// mempool, so that pending transactions survive a restart of the node.
+//
+// The WAL is a sequence of AddedTx and RemovedTx messages, stored in an
+// autofile group. The transactions still pending are those that were added
+// and not removed afterwards. The WAL grows as transactions come and go, so
+// the mempool periodically compacts it, rewriting only the pending
+// transactions.
+//
+// A nil *WAL is valid and discards everything written to it.
+type WAL struct {
+	service.BaseService
+
+	// Serializes writes and compactions.
+	mtx   cmtsync.Mutex
+	group *auto.Group
+	enc   *walEncoder
+
+	flushTicker   *time.Ticker
+	flushInterval time.Duration
+}
+
+// NewWAL returns a new mempool write-ahead log, stored in walDir, which
+// accepts transactions of up to maxTxBytes bytes. It's flushed and synced to
+// disk every 2s and once when stopped.
+func NewWAL(walDir string, maxTxBytes int, groupOptions ...func(*auto.Group)) (*WAL, error) {
+	if err := cmtos.EnsureDir(walDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to ensure WAL directory is in place: %w", err)
+	}
+
+	// Older files may contain pending transactions, so they must never be
+	// removed because of the size of the group. Compact takes care of that.
+	groupOptions = append([]func(*auto.Group){auto.GroupTotalSizeLimit(0)}, groupOptions...)
+	group, err := auto.OpenGroup(filepath.Join(walDir, "wal"), groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	wal := &WAL{
+		group:         group,
+		enc:           newWALEncoder(group, maxTxBytes+walMsgOverheadBytes),
+		flushInterval: walDefaultFlushInterval,
+	}
+	wal.BaseService = *service.NewBaseService(nil, "MempoolWAL", wal)
+	return wal, nil
+}
+
+// SetFlushInterval allows us to override the periodic flush interval for the WAL.
+func (wal *WAL) SetFlushInterval(i time.Duration) {
+	wal.flushInterval = i
+}
+
+// Group returns the underlying autofile group.
+func (wal *WAL) Group() *auto.Group {
+	return wal.group
+}
+
+// SetLogger sets the Logger.
+func (wal *WAL) SetLogger(l log.Logger) {
+	wal.BaseService.Logger = l
+	wal.group.SetLogger(l)
+}
+
+// OnStart implements service.Service.
+func (wal *WAL) OnStart() error {
+	if err := wal.group.Start(); err != nil {
+		return err
+	}
+	wal.flushTicker = time.NewTicker(wal.flushInterval)
+	go wal.processFlushTicks()
+	return nil
+}
+
+func (wal *WAL) processFlushTicks() {
+	for {
+		select {
+		case <-wal.flushTicker.C:
+			if err := wal.FlushAndSync(); err != nil {
+				wal.Logger.Error("Periodic mempool WAL flush failed", "err", err)
+			}
+		case <-wal.Quit():
+			return
+		}
+	}
+}
+
+// OnStop implements service.Service by flushing the WAL and stopping the
+// underlying autofile group.
+// Use Wait() to ensure it's finished shutting down before cleaning up files.
+func (wal *WAL) OnStop() {
+	wal.flushTicker.Stop()
+	if err := wal.FlushAndSync(); err != nil {
+		wal.Logger.Error("error on flush data to disk", "error", err)
+	}
+	if err := wal.group.Stop(); err != nil {
+		wal.Logger.Error("error trying to stop wal", "error", err)
+	}
+	wal.group.Close()
+}
+
+// Wait for the underlying autofile group to finish shutting down
+// so it's safe to cleanup files.
+func (wal *WAL) Wait() {
+	wal.group.Wait()
+}
+
+// FlushAndSync flushes and fsync's the underlying group's data to disk.
+// See auto#FlushAndSync
+func (wal *WAL) FlushAndSync() error {
+	if wal == nil {
+		return nil
+	}
+	return wal.group.FlushAndSync()
+}
+
+// Size returns the size in bytes of the WAL files on disk, which does not
+// include the data not flushed yet.
+func (wal *WAL) Size() int64 {
+	if wal == nil {
+		return 0
+	}
+	return wal.group.ReadGroupInfo().TotalSize
+}
+
+// AddTx records that tx was added to the mempool.
+// NOTE: does not call fsync()
+func (wal *WAL) AddTx(tx types.Tx) error {
+	if wal == nil {
+		return nil
+	}
+
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+	return wal.write(&cmtmempool.WALMessage{
+		Sum: &cmtmempool.WALMessage_AddedTx{AddedTx: &cmtmempool.AddedTx{Tx: tx}},
+	})
+}
+
+// RemoveTx records that the transaction with the given key was removed from
+// the mempool.
+// NOTE: does not call fsync()
+func (wal *WAL) RemoveTx(txKey types.TxKey) error {
+	if wal == nil {
+		return nil
+	}
+
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+	return wal.write(&cmtmempool.WALMessage{
+		Sum: &cmtmempool.WALMessage_RemovedTx{RemovedTx: &cmtmempool.RemovedTx{TxKey: txKey[:]}},
+	})
+}
+
+// CONTRACT: caller must hold mtx.
+func (wal *WAL) write(msg *cmtmempool.WALMessage) error {
+	return wal.enc.Encode(&cmtmempool.TimedWALMessage{Time: cmttime.Now(), Msg: msg})
+}
+
+// Compact replaces the content of the WAL with an AddedTx message for each of
+// the given transactions, which must be all the transactions in the mempool.
+//
+// The transactions are written to a new head, which is synced to disk before
+// the older files are removed, so that a crash in the middle of a compaction
+// does not lose any pending transaction.
+func (wal *WAL) Compact(txs types.Txs) error {
+	return wal.compact(func() types.Txs { return txs })
+}
+
+// compact is like Compact, but takes the transactions in the mempool from
+// pending, which is called while holding mtx. A transaction added to the
+// mempool while compacting is thus either returned by pending or written to
+// the new head, never to a file that is about to be removed.
+func (wal *WAL) compact(pending func() types.Txs) error {
+	if wal == nil {
+		return nil
+	}
+
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+
+	txs := pending()
+	wal.group.RotateFile()
+	for _, tx := range txs {
+		if err := wal.write(&cmtmempool.WALMessage{
+			Sum: &cmtmempool.WALMessage_AddedTx{AddedTx: &cmtmempool.AddedTx{Tx: tx}},
+		}); err != nil {
+			return err
+		}
+	}
+	if err := wal.group.FlushAndSync(); err != nil {
+		return err
+	}
+	return wal.group.RemoveFilesBefore(wal.group.MaxIndex())
+}
+
+// needsCompaction returns true if the WAL is much bigger than the
+// transactions in the mempool, txsBytes.
+func (wal *WAL) needsCompaction(txsBytes int64) bool {
+	if wal == nil {
+		return false
+	}
+	return wal.Size() > 2*txsBytes+wal.group.HeadSizeLimit()
+}
+
+// Iterate calls fn for each message in the WAL, from the oldest to the
+// newest, until fn returns an error. A WALCorruptionError is returned if
+// the WAL contains a corrupted message, which is expected for the last
+// message if the node crashed while writing it.
+func (wal *WAL) Iterate(fn func(*cmtmempool.TimedWALMessage) error) error {
+	gr, err := wal.group.NewReader(wal.group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	dec := newWALDecoder(gr, walMaxMsgSizeBytes)
+	for {
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// PendingTxs returns the transactions that were added to the mempool and not
+// removed afterwards, in the order in which they were added. Anything after a
+// corrupted message is ignored.
+func (wal *WAL) PendingTxs() (types.Txs, error) {
+	var (
+		txs   []types.Tx
+		index = make(map[types.TxKey]int)
+	)
+	err := wal.Iterate(func(msg *cmtmempool.TimedWALMessage) error {
+		switch m := msg.Msg.Sum.(type) {
+		case *cmtmempool.WALMessage_AddedTx:
+			tx := types.Tx(m.AddedTx.Tx)
+			if _, ok := index[tx.Key()]; !ok {
+				index[tx.Key()] = len(txs)
+				txs = append(txs, tx)
+			}
+		case *cmtmempool.WALMessage_RemovedTx:
+			if len(m.RemovedTx.TxKey) != types.TxKeySize {
+				return WALCorruptionError{fmt.Errorf("invalid tx key length: %d", len(m.RemovedTx.TxKey))}
+			}
+			var txKey types.TxKey
+			copy(txKey[:], m.RemovedTx.TxKey)
+			if i, ok := index[txKey]; ok {
+				txs[i] = nil
+				delete(index, txKey)
+			}
+		}
+		return nil
+	})
+	if IsWALCorruptionError(err) {
+		wal.Logger.Error("Corrupted entry in mempool WAL. Ignoring the rest of the WAL", "err", err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	pending := make(types.Txs, 0, len(index))
+	for _, tx := range txs {
+		if tx != nil {
+			pending = append(pending, tx)
+		}
+	}
+	return pending, nil
+}
+
+// ReplayWAL adds the transactions pending in wal to mp, by running CheckTx
+// on each of them, as the application state may have changed since they were
+// recorded. It must be called before the mempool is used, with wal attached
+// to mp.
+func ReplayWAL(wal *WAL, mp Mempool, logger log.Logger) error {
+	txs, err := wal.PendingTxs()
+	if err != nil {
+		return fmt.Errorf("failed to read the mempool WAL: %w", err)
+	}
+
+	// The mempool writes the transactions it accepts to a new head; the older
+	// files are kept until they are all in the new head.
+	wal.mtx.Lock()
+	wal.group.RotateFile()
+	wal.mtx.Unlock()
+
+	for _, tx := range txs {
+		if _, err := mp.CheckTx(tx); err != nil {
+			logger.Debug("Pending transaction rejected by the mempool", "tx", tx.Hash(), "err", err)
+		}
+	}
+	if err := mp.FlushAppConn(); err != nil {
+		return err
+	}
+	if err := wal.FlushAndSync(); err != nil {
+		return err
+	}
+	if err := wal.group.RemoveFilesBefore(wal.group.MaxIndex()); err != nil {
+		return err
+	}
+
+	logger.Info("Replayed mempool WAL", "pending", len(txs), "added", mp.Size())
+	return nil
+}
+
+// IsWALCorruptionError returns true if data has been corrupted inside the
+// mempool WAL.
+func IsWALCorruptionError(err error) bool {
+	return errors.As(err, &WALCorruptionError{})
+}
+
+// WALCorruptionError is an error that occurs if data on disk was corrupted.
+type WALCorruptionError struct {
+	Reason error
+}
+
+func (e WALCorruptionError) Error() string {
+	return fmt.Sprintf("mempool WAL corrupted: %v", e.Reason)
+}
+
+func (e WALCorruptionError) Unwrap() error {
+	return e.Reason
+}
+
+// A walEncoder writes custom-encoded WAL messages to an output stream.
+//
+// Format: 4 bytes CRC sum + 4 bytes length + arbitrary-length value
+type walEncoder struct {
+	wr         io.Writer
+	maxMsgSize int
+}
+
+func newWALEncoder(wr io.Writer, maxMsgSize int) *walEncoder {
+	return &walEncoder{wr, maxMsgSize}
+}
+
+// Encode writes the custom encoding of v to the stream. It returns an error if
+// the encoded size of v is greater than the maximum message size.
+func (enc *walEncoder) Encode(v *cmtmempool.TimedWALMessage) error {
+	data, err := proto.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode timed wal message failure: %w", err)
+	}
+
+	crc := crc32.Checksum(data, crc32c)
+	length := uint32(len(data))
+	if int(length) > enc.maxMsgSize {
+		return fmt.Errorf("msg is too big: %d bytes, max: %d bytes", length, enc.maxMsgSize)
+	}
+
+	msg := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint32(msg[0:4], crc)
+	binary.BigEndian.PutUint32(msg[4:8], length)
+	copy(msg[8:], data)
+
+	_, err = enc.wr.Write(msg)
+	return err
+}
+
+// A walDecoder reads and decodes custom-encoded WAL messages from an input
+// stream. See walEncoder for the format used.
+type walDecoder struct {
+	rd         io.Reader
+	maxMsgSize int
+}
+
+func newWALDecoder(rd io.Reader, maxMsgSize int) *walDecoder {
+	return &walDecoder{rd, maxMsgSize}
+}
+
+// Decode reads the next custom-encoded value from its reader and returns it.
+// It returns io.EOF at the end of the stream and a WALCorruptionError if the
+// data does not match its checksum or can not be decoded.
+func (dec *walDecoder) Decode() (*cmtmempool.TimedWALMessage, error) {
+	b := make([]byte, 8)
+	n, err := io.ReadFull(dec.rd, b)
+	if n == 0 && errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, WALCorruptionError{fmt.Errorf("failed to read header: %w", err)}
+	}
+	crc := binary.BigEndian.Uint32(b[0:4])
+	length := binary.BigEndian.Uint32(b[4:8])
+
+	if int(length) > dec.maxMsgSize {
+		return nil, WALCorruptionError{fmt.Errorf(
+			"length %d exceeded maximum possible value of %d bytes", length, dec.maxMsgSize)}
+	}
+
+	data := make([]byte, length)
+	n, err = io.ReadFull(dec.rd, data)
+	if err != nil {
+		return nil, WALCorruptionError{fmt.Errorf("failed to read data: %w (read: %d, wanted: %d)", err, n, length)}
+	}
+
+	if actualCRC := crc32.Checksum(data, crc32c); actualCRC != crc {
+		return nil, WALCorruptionError{fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actualCRC)}
+	}
+
+	res := new(cmtmempool.TimedWALMessage)
+	if err := proto.Unmarshal(data, res); err != nil {
+		return nil, WALCorruptionError{fmt.Errorf("failed to decode data: %w", err)}
+	}
+	if res.Msg == nil || res.Msg.Sum == nil {
+		return nil, WALCorruptionError{errors.New("empty message")}
+	}
+	return res, nil
+}
@@ -0,0 +1,314 @@
+package mempool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtmempool "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newTestWAL(t *testing.T, walDir string) *WAL {
+	t.Helper()
+
+	wal, err := NewWAL(walDir, 1024)
+	require.NoError(t, err)
+	wal.SetLogger(log.TestingLogger())
+	t.Cleanup(func() { wal.Group().Close() })
+	return wal
+}
+
+func TestWALPendingTxs(t *testing.T) {
+	wal := newTestWAL(t, t.TempDir())
+
+	txs := types.Txs{types.Tx("a"), types.Tx("b"), types.Tx("c"), types.Tx("d")}
+	for _, tx := range txs {
+		require.NoError(t, wal.AddTx(tx))
+	}
+	require.NoError(t, wal.RemoveTx(txs[1].Key()))
+	require.NoError(t, wal.RemoveTx(txs[3].Key()))
+	// A tx can be added again after being removed.
+	require.NoError(t, wal.AddTx(txs[1]))
+	require.NoError(t, wal.FlushAndSync())
+
+	pending, err := wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[0], txs[2], txs[1]}, pending)
+
+	var added, removed int
+	err = wal.Iterate(func(msg *cmtmempool.TimedWALMessage) error {
+		if msg.Msg.GetAddedTx() != nil {
+			added++
+		} else if msg.Msg.GetRemovedTx() != nil {
+			removed++
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5, added)
+	assert.Equal(t, 2, removed)
+}
+
+func TestWALCompact(t *testing.T) {
+	walDir := t.TempDir()
+	wal := newTestWAL(t, walDir)
+
+	for _, tx := range NewRandomTxs(10, 20) {
+		require.NoError(t, wal.AddTx(tx))
+	}
+	require.NoError(t, wal.FlushAndSync())
+	sizeBefore := wal.Size()
+
+	txs := types.Txs{types.Tx("a"), types.Tx("b")}
+	require.NoError(t, wal.Compact(txs))
+	assert.Less(t, wal.Size(), sizeBefore)
+
+	pending, err := wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, txs, pending)
+
+	// Only the head is left.
+	files, err := os.ReadDir(walDir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "wal", files[0].Name())
+
+	// The compacted WAL is read back after a restart.
+	wal.Group().Close()
+	pending, err = newTestWAL(t, walDir).PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, txs, pending)
+}
+
+func TestWALCorruptedTail(t *testing.T) {
+	walDir := t.TempDir()
+	wal := newTestWAL(t, walDir)
+
+	txs := types.Txs{types.Tx("a"), types.Tx("b")}
+	for _, tx := range txs {
+		require.NoError(t, wal.AddTx(tx))
+	}
+	require.NoError(t, wal.FlushAndSync())
+
+	// Simulate a crash in the middle of a write.
+	f, err := os.OpenFile(filepath.Join(walDir, "wal"), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x00, 0x10, 0xff})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	err = wal.Iterate(func(*cmtmempool.TimedWALMessage) error { return nil })
+	assert.True(t, IsWALCorruptionError(err), err)
+
+	pending, err := wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, txs, pending)
+}
+
+func TestReplayWAL(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	walDir := filepath.Join(cfg.RootDir, "data", "mempool.wal")
+
+	app := newPriorityApp()
+	cc := proxy.NewLocalClientCreator(app)
+	newMempool := func(wal *WAL) *CListMempool {
+		appConnMem, err := cc.NewABCIClient()
+		require.NoError(t, err)
+		require.NoError(t, appConnMem.Start())
+		t.Cleanup(func() {
+			if err := appConnMem.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		mp := NewCListMempool(cfg.Mempool, appConnMem, 0, WithWAL(wal))
+		mp.SetLogger(log.TestingLogger())
+		return mp
+	}
+
+	wal := newTestWAL(t, walDir)
+	mp := newMempool(wal)
+	txs := types.Txs{
+		newPriorityTx("", 1, "a"),
+		newPriorityTx("", 1, "b"),
+		newPriorityTx("", 1, "c"),
+		newPriorityTx("", 1, "d"),
+	}
+	callCheckTx(t, mp, txs)
+
+	mp.Lock()
+	err := mp.Update(1, txs[:1], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	require.NoError(t, mp.RemoveTxByKey(txs[2].Key()))
+	require.NoError(t, wal.FlushAndSync())
+	wal.Group().Close()
+
+	// After a restart, the pending txs are checked again, and those that are
+	// no longer valid are dropped.
+	app.invalidTxs[string(txs[3])] = true
+	wal = newTestWAL(t, walDir)
+	mp = newMempool(wal)
+	require.NoError(t, ReplayWAL(wal, mp, log.TestingLogger()))
+	assert.Equal(t, types.Txs{txs[1]}, mp.ReapMaxTxs(-1))
+
+	// The replayed txs are the only ones left in the WAL.
+	pending, err := wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[1]}, pending)
+	assert.Equal(t, wal.Group().MinIndex(), wal.Group().MaxIndex())
+}
+
+func TestReplayWALLowerMaxTxBytes(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	walDir := filepath.Join(cfg.RootDir, "data", "mempool.wal")
+
+	wal := newTestWAL(t, walDir)
+	txs := types.Txs{
+		newPriorityTx("", 1, strings.Repeat("a", 500)),
+		newPriorityTx("", 1, "b"),
+	}
+	for _, tx := range txs {
+		require.NoError(t, wal.AddTx(tx))
+	}
+	require.NoError(t, wal.FlushAndSync())
+	wal.Group().Close()
+
+	// max_tx_bytes is lowered before the restart: the tx that became too big
+	// is rejected by the mempool, not read as a corrupted message.
+	cfg.Mempool.MaxTxBytes = 100
+	wal, err := NewWAL(walDir, cfg.Mempool.MaxTxBytes)
+	require.NoError(t, err)
+	wal.SetLogger(log.TestingLogger())
+	t.Cleanup(func() { wal.Group().Close() })
+
+	pending, err := wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, txs, pending)
+
+	appConnMem, err := proxy.NewLocalClientCreator(newPriorityApp()).NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	mp := NewCListMempool(cfg.Mempool, appConnMem, 0, WithWAL(wal))
+	mp.SetLogger(log.TestingLogger())
+
+	require.NoError(t, ReplayWAL(wal, mp, log.TestingLogger()))
+	assert.Equal(t, txs[1:], mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolWAL(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 2
+	wal := newTestWAL(t, t.TempDir())
+
+	appConnMem, err := proxy.NewLocalClientCreator(newPriorityApp()).NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+		os.RemoveAll(cfg.RootDir)
+	})
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0, WithPriorityWAL(wal))
+	mp.SetLogger(log.TestingLogger())
+
+	txs := types.Txs{
+		newPriorityTx("", 1, "a"),
+		newPriorityTx("", 2, "b"),
+		newPriorityTx("", 3, "c"),
+	}
+	callCheckTx(t, mp, txs)
+
+	// The evicted tx is recorded as removed.
+	require.NoError(t, wal.FlushAndSync())
+	pending, err := wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Equal(t, txs[1:], pending)
+
+	mp.Flush()
+	require.NoError(t, wal.FlushAndSync())
+	pending, err = wal.PendingTxs()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// Flushing truncates the WAL without recording the removals.
+	err = wal.Iterate(func(msg *cmtmempool.TimedWALMessage) error {
+		return fmt.Errorf("unexpected message %v", msg.Msg)
+	})
+	require.NoError(t, err)
+}
+
+func TestWALConcurrentFlush(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+
+	newMempools := map[string]func(*WAL) Mempool{
+		"clist": func(wal *WAL) Mempool {
+			appConnMem, err := proxy.NewLocalClientCreator(newPriorityApp()).NewABCIClient()
+			require.NoError(t, err)
+			require.NoError(t, appConnMem.Start())
+			t.Cleanup(func() { _ = appConnMem.Stop() })
+			mp := NewCListMempool(cfg.Mempool, appConnMem, 0, WithWAL(wal))
+			mp.SetLogger(log.TestingLogger())
+			return mp
+		},
+		"priority": func(wal *WAL) Mempool {
+			appConnMem, err := proxy.NewLocalClientCreator(newPriorityApp()).NewABCIClient()
+			require.NoError(t, err)
+			require.NoError(t, appConnMem.Start())
+			t.Cleanup(func() { _ = appConnMem.Stop() })
+			mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0, WithPriorityWAL(wal))
+			mp.SetLogger(log.TestingLogger())
+			return mp
+		},
+	}
+	for name, newMempool := range newMempools {
+		newMempool := newMempool
+		t.Run(name, func(t *testing.T) {
+			wal := newTestWAL(t, t.TempDir())
+			mp := newMempool(wal)
+
+			var wg sync.WaitGroup
+			for g := 0; g < 4; g++ {
+				g := g
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < 500; i++ {
+						_, err := mp.CheckTx(newPriorityTx("", 1, fmt.Sprintf("%d-%d", g, i)))
+						assert.NoError(t, err)
+					}
+				}()
+			}
+			for i := 0; i < 500; i++ {
+				mp.Flush()
+			}
+			wg.Wait()
+			require.NoError(t, mp.FlushAppConn())
+
+			// Every tx left in the mempool must be pending in the WAL.
+			require.NoError(t, wal.FlushAndSync())
+			pending, err := wal.PendingTxs()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, mp.ReapMaxTxs(-1), pending)
+		})
+	}
+}
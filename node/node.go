@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	"google.golang.org/grpc"
 
 	bc "github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
@@ -24,13 +25,13 @@ import (
 	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
+	coregrpc "github.com/cometbft/cometbft/rpc/grpc"
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/statesync"
-	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/cometbft/cometbft/version"
@@ -49,7 +50,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -59,10 +60,12 @@ type Node struct {
 	// services
 	eventBus          *types.EventBus // pub/sub for services
 	stateStore        sm.Store
-	blockStore        *store.BlockStore // store the blockchain to disk
-	bcReactor         p2p.Reactor       // for block-syncing
-	mempoolReactor    p2p.Reactor       // for gossipping transactions
+	blockStore        sm.BlockStore // store the blockchain to disk
+	pruner            *sm.Pruner    // prunes blocks in the background
+	bcReactor         p2p.Reactor   // for block-syncing
+	mempoolReactor    p2p.Reactor   // for gossipping transactions
 	mempool           mempl.Mempool
+	mempoolWAL        *mempl.WAL              // nil if the mempool WAL is disabled
 	stateSync         bool                    // whether the node should state sync on startup
 	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
 	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
@@ -187,7 +190,12 @@ func NewNode(ctx context.Context,
 	// external signing process.
 	if config.PrivValidatorListenAddr != "" {
 		// FIXME: we should start services inside OnStart
-		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
+		privValidator, err = createAndStartPrivValidatorSocketClient(
+			splitAndTrimEmpty(config.PrivValidatorListenAddr, ",", " "),
+			config.PrivValidatorFailoverStateFile(),
+			genDoc.ChainID,
+			logger,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("error with private validator socket client: %w", err)
 		}
@@ -229,7 +237,11 @@ func NewNode(ctx context.Context,
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
 	// Make MempoolReactor
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolWAL, err := createMempoolWAL(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, mempoolWAL, memplMetrics, logger)
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
@@ -237,6 +249,11 @@ func NewNode(ctx context.Context,
 		return nil, err
 	}
 
+	pruner, err := createPruner(config, stateStore, blockStore, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
 		stateStore,
@@ -246,6 +263,7 @@ func NewNode(ctx context.Context,
 		evidencePool,
 		blockStore,
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithPruner(pruner),
 	)
 
 	// Make BlocksyncReactor. Don't start block sync if we're doing a state sync first.
@@ -269,6 +287,7 @@ func NewNode(ctx context.Context,
 		proxyApp.Snapshot(),
 		proxyApp.Query(),
 		ssMetrics,
+		statesync.WithStores(stateStore, blockStore),
 	)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
@@ -278,7 +297,10 @@ func NewNode(ctx context.Context,
 	}
 
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	transport, peerFilters, err := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	if err != nil {
+		return nil, err
+	}
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
@@ -335,9 +357,11 @@ func NewNode(ctx context.Context,
 
 		stateStore:       stateStore,
 		blockStore:       blockStore,
+		pruner:           pruner,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		mempoolWAL:       mempoolWAL,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
@@ -379,6 +403,17 @@ func (n *Node) OnStart() error {
 		n.prometheusSrv = n.startPrometheusServer()
 	}
 
+	// Restore the txs that were pending before the node stopped, before the
+	// mempool receives any new tx.
+	if n.mempoolWAL != nil {
+		if err := mempl.ReplayWAL(n.mempoolWAL, n.mempool, n.Logger.With("module", "mempool")); err != nil {
+			return err
+		}
+		if err := n.mempoolWAL.Start(); err != nil {
+			return err
+		}
+	}
+
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
 	if n.config.RPC.ListenAddress != "" {
@@ -389,6 +424,19 @@ func (n *Node) OnStart() error {
 		n.rpcListeners = listeners
 	}
 
+	// The privileged gRPC server is independent of the public RPC server.
+	if n.config.RPC.GRPCPrivilegedListenAddress != "" {
+		listener, err := n.startPrivilegedGRPC()
+		if err != nil {
+			return err
+		}
+		n.rpcListeners = append(n.rpcListeners, listener)
+	}
+
+	if err := n.pruner.Start(); err != nil {
+		return err
+	}
+
 	// Start the transport.
 	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.ListenAddress))
 	if err != nil {
@@ -441,6 +489,9 @@ func (n *Node) OnStop() {
 	if err := n.indexerService.Stop(); err != nil {
 		n.Logger.Error("Error closing indexerService", "err", err)
 	}
+	if err := n.pruner.Stop(); err != nil {
+		n.Logger.Error("Error stopping pruner", "err", err)
+	}
 
 	// now stop the reactors
 	if err := n.sw.Stop(); err != nil {
@@ -461,6 +512,12 @@ func (n *Node) OnStop() {
 		}
 	}
 
+	if n.mempoolWAL != nil {
+		if err := n.mempoolWAL.Stop(); err != nil {
+			n.Logger.Error("Error stopping mempool WAL", "err", err)
+		}
+	}
+
 	if pvsc, ok := n.privValidator.(service.Service); ok {
 		if err := pvsc.Stop(); err != nil {
 			n.Logger.Error("Error closing private validator", "err", err)
@@ -622,9 +679,39 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		listeners[i] = listener
 	}
 
+	// we expose a simplified api over grpc for convenience to app devs
+	grpcListenAddr := n.config.RPC.GRPCListenAddress
+	if grpcListenAddr != "" {
+		listener, err := rpcserver.Listen(grpcListenAddr, n.config.RPC.GRPCMaxOpenConnections)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			if err := coregrpc.StartGRPCServer(env, listener, grpc.MaxRecvMsgSize(int(config.MaxBodyBytes))); err != nil {
+				n.Logger.Error("Error starting gRPC server", "err", err)
+			}
+		}()
+		listeners = append(listeners, listener)
+	}
+
 	return listeners, nil
 }
 
+// startPrivilegedGRPC starts the privileged gRPC server, which serves the
+// pruning service to a data companion.
+func (n *Node) startPrivilegedGRPC() (net.Listener, error) {
+	listener, err := rpcserver.Listen(n.config.RPC.GRPCPrivilegedListenAddress, n.config.RPC.GRPCMaxOpenConnections)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := coregrpc.StartPrivilegedGRPCServer(n.pruner, listener); err != nil {
+			n.Logger.Error("Error starting privileged gRPC server", "err", err)
+		}
+	}()
+	return listener, nil
+}
+
 // startPrometheusServer starts a Prometheus HTTP server, listening for metrics
 // collectors on addr.
 func (n *Node) startPrometheusServer() *http.Server {
@@ -669,7 +756,7 @@ func (n *Node) Switch() *p2p.Switch {
 }
 
 // BlockStore returns the Node's BlockStore.
-func (n *Node) BlockStore() *store.BlockStore {
+func (n *Node) BlockStore() sm.BlockStore {
 	return n.blockStore
 }
 
@@ -768,6 +855,7 @@ func makeNodeInfo(
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
+			statesync.LightBlockChannel, statesync.ParamsChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/statesync"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
@@ -77,6 +79,59 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeFlatFileBlockStore(t *testing.T) {
+	config := test.ResetTestRoot("node_flatfile_block_store_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Storage.BlockStoreBackend = cfg.BlockStoreBackendFlatFile
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.IsType(t, &store.FlatFileBlockStore{}, n.BlockStore())
+	require.NoError(t, n.Start())
+	defer n.Stop() //nolint:errcheck // ignore for tests
+
+	blocksSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-blocksSub.Out():
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the node to produce a block")
+		}
+	}
+	assert.NotNil(t, n.BlockStore().LoadBlock(1))
+}
+
+func TestSnapshotServerStartStop(t *testing.T) {
+	config := test.ResetTestRoot("snapshot_server_test")
+	defer os.RemoveAll(config.RootDir)
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	require.NoError(t, err)
+	dir, err := statesync.NewSnapshotDir(t.TempDir())
+	require.NoError(t, err)
+
+	s, err := NewSnapshotServer(config, nodeKey, genDoc, dir, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+
+	channels := s.NodeInfo().(p2p.DefaultNodeInfo).Channels
+	assert.Contains(t, channels, byte(statesync.SnapshotChannel))
+	assert.Contains(t, channels, byte(statesync.ChunkChannel))
+	assert.NotContains(t, channels, byte(mempl.MempoolChannel))
+	assert.True(t, s.Switch().Reactor("STATESYNC").IsRunning())
+
+	require.NoError(t, s.Stop())
+	assert.False(t, s.Switch().IsRunning())
+
+	// peers can't be filtered without an application
+	config.FilterPeers = true
+	_, err = NewSnapshotServer(config, nodeKey, genDoc, dir, log.TestingLogger())
+	require.Error(t, err)
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -187,7 +242,47 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
-	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
+	assert.IsType(t, &privval.FailoverSignerClient{}, n.PrivValidator())
+}
+
+func TestNodeSetPrivValFailover(t *testing.T) {
+	config := test.ResetTestRoot("node_priv_val_failover_test")
+	defer os.RemoveAll(config.RootDir)
+
+	privKey := ed25519.GenPrivKey()
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = "tcp://" + testFreeAddr(t)
+
+		dialer := privval.DialTCPFn(addrs[i], 100*time.Millisecond, ed25519.GenPrivKey())
+		dialerEndpoint := privval.NewSignerDialerEndpoint(
+			log.TestingLogger(),
+			dialer,
+		)
+		privval.SignerDialerEndpointTimeoutReadWrite(100 * time.Millisecond)(dialerEndpoint)
+
+		signerServer := privval.NewSignerServer(
+			dialerEndpoint,
+			test.DefaultTestChainID,
+			types.NewMockPVWithParams(privKey, false, false),
+		)
+
+		go func() {
+			err := signerServer.Start()
+			if err != nil {
+				panic(err)
+			}
+		}()
+		defer signerServer.Stop() //nolint:errcheck // ignore for tests
+	}
+	config.BaseConfig.PrivValidatorListenAddr = strings.Join(addrs, ",")
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &privval.FailoverSignerClient{}, n.PrivValidator())
+	pubKey, err := n.PrivValidator().GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
 }
 
 // address without a protocol must result in error
@@ -231,7 +326,7 @@ func TestNodeSetPrivValIPC(t *testing.T) {
 
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
-	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
+	assert.IsType(t, &privval.FailoverSignerClient{}, n.PrivValidator())
 }
 
 // testFreeAddr claims a free port so we don't block on listener being ready.
@@ -104,13 +104,11 @@ type blockSyncReactor interface {
 
 //------------------------------------------------------------------------------
 
-func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
-	var blockStoreDB dbm.DB
-	blockStoreDB, err = dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (blockStore sm.BlockStore, stateDB dbm.DB, err error) {
+	blockStore, err = store.NewBlockStoreFromConfig(config, dbProvider)
 	if err != nil {
 		return
 	}
-	blockStore = store.NewBlockStore(blockStoreDB)
 
 	stateDB, err = dbProvider(&cfg.DBContext{ID: "state", Config: config})
 	if err != nil {
@@ -224,18 +222,34 @@ func createMempoolAndMempoolReactor(
 	config *cfg.Config,
 	proxyApp proxy.AppConns,
 	state sm.State,
+	memplWAL *mempl.WAL,
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
 ) (mempl.Mempool, p2p.Reactor) {
 	logger = logger.With("module", "mempool")
-	mp := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
-		mempl.WithMetrics(memplMetrics),
-		mempl.WithPreCheck(sm.TxPreCheck(state)),
-		mempl.WithPostCheck(sm.TxPostCheck(state)),
-	)
+	var mp mempl.GossipMempool
+	switch config.Mempool.Type {
+	case cfg.MempoolTypePriority:
+		mp = mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithPriorityMetrics(memplMetrics),
+			mempl.WithPriorityPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPriorityPostCheck(sm.TxPostCheck(state)),
+			mempl.WithPriorityWAL(memplWAL),
+		)
+	default:
+		mp = mempl.NewCListMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+			mempl.WithWAL(memplWAL),
+		)
+	}
 
 	mp.SetLogger(logger)
 
@@ -251,8 +265,53 @@ func createMempoolAndMempoolReactor(
 	return mp, reactor
 }
 
+// createMempoolWAL opens the mempool write-ahead log, or returns nil if it is
+// disabled.
+func createMempoolWAL(config *cfg.Config, logger log.Logger) (*mempl.WAL, error) {
+	if !config.Mempool.WalEnabled() {
+		return nil, nil
+	}
+	wal, err := mempl.NewWAL(config.Mempool.WalDir(), config.Mempool.MaxTxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mempool WAL: %w", err)
+	}
+	wal.SetLogger(logger.With("module", "mempool", "wal", config.Mempool.WalDir()))
+	return wal, nil
+}
+
+func createPruner(config *cfg.Config, stateStore sm.Store, blockStore sm.BlockStore, logger log.Logger) (*sm.Pruner, error) {
+	dcConfig := config.Storage.Pruning.DataCompanion
+	options := []sm.PrunerOption{sm.WithPrunerInterval(config.Storage.Pruning.Interval)}
+	if dcConfig.Enabled {
+		options = append(options, sm.WithPrunerCompanionEnabled())
+
+		// The initial retain heights only apply until the data companion sets
+		// its own.
+		if err := initRetainHeight(stateStore.GetCompanionBlockRetainHeight,
+			stateStore.SaveCompanionBlockRetainHeight, dcConfig.InitialBlockRetainHeight); err != nil {
+			return nil, fmt.Errorf("failed to set initial block retain height: %w", err)
+		}
+		if err := initRetainHeight(stateStore.GetABCIResRetainHeight,
+			stateStore.SaveABCIResRetainHeight, dcConfig.InitialBlockResultsRetainHeight); err != nil {
+			return nil, fmt.Errorf("failed to set initial block results retain height: %w", err)
+		}
+	}
+	return sm.NewPruner(stateStore, blockStore, logger.With("module", "pruner"), options...), nil
+}
+
+func initRetainHeight(get func() (int64, error), save func(int64) error, height int64) error {
+	if height == 0 {
+		return nil
+	}
+	current, err := get()
+	if err != nil || current != 0 {
+		return err
+	}
+	return save(height)
+}
+
 func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
-	stateStore sm.Store, blockStore *store.BlockStore, logger log.Logger,
+	stateStore sm.Store, blockStore sm.BlockStore, logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
 	evidenceDB, err := dbProvider(&cfg.DBContext{ID: "evidence", Config: config})
 	if err != nil {
@@ -271,14 +330,16 @@ func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
 func createBlocksyncReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
-	blockStore *store.BlockStore,
+	blockStore sm.BlockStore,
 	blockSync bool,
 	logger log.Logger,
 	metrics *blocksync.Metrics,
 ) (bcReactor p2p.Reactor, err error) {
 	switch config.BlockSync.Version {
 	case "v0":
-		bcReactor = blocksync.NewReactor(state.Copy(), blockExec, blockStore, blockSync, metrics)
+		bcReactor = blocksync.NewReactor(state.Copy(), blockExec, blockStore, blockSync, metrics,
+			blocksync.VerificationWorkers(config.BlockSync.VerificationWorkers),
+			blocksync.VerificationWindow(config.BlockSync.VerificationWindow))
 	case "v1", "v2":
 		return nil, fmt.Errorf("block sync version %s has been deprecated. Please use v0", config.BlockSync.Version)
 	default:
@@ -314,7 +375,8 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics),
+		cs.ReactorVoteBatchVerification(config.Consensus.VoteBatchWindow, config.Consensus.VoteBatchMaxSize))
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -322,18 +384,27 @@ func createConsensusReactor(config *cfg.Config,
 	return consensusReactor, consensusState
 }
 
+// p2pTransport is a p2p.Transport whose lifecycle and channels are controlled
+// by the node.
+type p2pTransport interface {
+	p2p.Transport
+	Listen(p2p.NetAddress) error
+	Close() error
+	AddChannel(chID byte)
+}
+
 func createTransport(
 	config *cfg.Config,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
 ) (
-	*p2p.MultiplexTransport,
+	p2pTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
@@ -382,13 +453,30 @@ func createTransport(
 		)
 	}
 
-	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
-
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
-	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
-	return transport, peerFilters
+	var tcpTransport *p2p.MultiplexTransport
+	if config.P2P.Transport != cfg.P2PTransportQUIC {
+		tcpTransport = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		p2p.MultiplexTransportConnFilters(connFilters...)(tcpTransport)
+		p2p.MultiplexTransportMaxIncomingConnections(max)(tcpTransport)
+		if config.P2P.Transport == cfg.P2PTransportTCP {
+			return tcpTransport, peerFilters, nil
+		}
+	}
+
+	quicTransport, err := p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig,
+		p2p.QUICTransportConnFilters(connFilters...),
+		p2p.QUICTransportMaxIncomingConnections(max),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create QUIC transport: %w", err)
+	}
+	if tcpTransport == nil {
+		return quicTransport, peerFilters, nil
+	}
+	return p2p.NewHybridTransport(tcpTransport, quicTransport), peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
@@ -479,29 +567,41 @@ func startStateSync(
 	stateProvider statesync.StateProvider,
 	config *cfg.StateSyncConfig,
 	stateStore sm.Store,
-	blockStore *store.BlockStore,
+	blockStore sm.BlockStore,
 	state sm.State,
 ) error {
 	ssR.Logger.Info("Starting state sync")
 
-	if stateProvider == nil {
+	trustOptions := light.TrustOptions{
+		Period: config.TrustPeriod,
+		Height: config.TrustHeight,
+		Hash:   config.TrustHashBytes(),
+	}
+	if stateProvider == nil && !config.UseP2P {
 		var err error
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 		stateProvider, err = statesync.NewLightClientStateProvider(
 			ctx,
 			state.ChainID, state.Version, state.InitialHeight,
-			config.RPCServers, light.TrustOptions{
-				Period: config.TrustPeriod,
-				Height: config.TrustHeight,
-				Hash:   config.TrustHashBytes(),
-			}, ssR.Logger.With("module", "light"))
+			config.RPCServers, trustOptions, ssR.Logger.With("module", "light"))
 		if err != nil {
 			return fmt.Errorf("failed to set up light client state provider: %w", err)
 		}
 	}
 
 	go func() {
+		// The peers serving the light blocks are only known once the switch
+		// has connected to them.
+		if stateProvider == nil {
+			var err error
+			stateProvider, err = ssR.NewP2PStateProvider(context.Background(),
+				state.ChainID, state.Version, state.InitialHeight, trustOptions)
+			if err != nil {
+				ssR.Logger.Error("Failed to set up P2P state provider", "err", err)
+				return
+			}
+		}
 		state, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
 		if err != nil {
 			ssR.Logger.Error("State sync failed", "err", err)
@@ -593,33 +693,53 @@ func saveGenesisDoc(db dbm.DB, genDoc *types.GenesisDoc) error {
 }
 
 func createAndStartPrivValidatorSocketClient(
-	listenAddr,
+	listenAddrs []string,
+	failoverStateFile string,
 	chainID string,
 	logger log.Logger,
 ) (types.PrivValidator, error) {
-	pve, err := privval.NewSignerListener(listenAddr, logger)
-	if err != nil {
-		return nil, fmt.Errorf("failed to start private validator: %w", err)
-	}
-
-	pvsc, err := privval.NewSignerClient(pve, chainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to start private validator: %w", err)
-	}
+	signers := make([]*privval.SignerClient, 0, len(listenAddrs))
+	for _, listenAddr := range listenAddrs {
+		pve, err := privval.NewSignerListener(listenAddr, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start private validator: %w", err)
+		}
 
-	// try to get a pubkey from private validate first time
-	_, err = pvsc.GetPubKey()
-	if err != nil {
-		return nil, fmt.Errorf("can't get pubkey: %w", err)
+		pvsc, err := privval.NewSignerClient(pve, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start private validator: %w", err)
+		}
+		signers = append(signers, pvsc)
 	}
 
 	const (
 		retries = 50 // 50 * 100ms = 5s total
 		timeout = 100 * time.Millisecond
 	)
-	pvscWithRetries := privval.NewRetrySignerClient(pvsc, retries, timeout)
 
-	return pvscWithRetries, nil
+	// try to get a pubkey from private validate first time, without retrying
+	var err error
+	for _, signer := range signers {
+		if _, err = signer.GetPubKey(); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	// A single signer is used through the failover client as well, so that its
+	// last sign state is kept in the same file as with several signers, and the
+	// double signing protection carries over when signers are added or removed.
+	pvsc, err := privval.NewFailoverSignerClient(signers, failoverStateFile, logger.With("module", "privval"),
+		privval.FailoverSignerClientRetries(retries, timeout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+	if err := pvsc.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+	return pvsc, nil
 }
 
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
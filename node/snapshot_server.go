@@ -0,0 +1,167 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
+	"github.com/cometbft/cometbft/statesync"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+// SnapshotServer is a node which only serves the state sync snapshots of a
+// snapshot directory to its peers, without running an application nor
+// consensus, so that snapshots can be mirrored cheaply.
+type SnapshotServer struct {
+	service.BaseService
+
+	config    *cfg.Config
+	transport p2pTransport
+	sw        *p2p.Switch
+	addrBook  pex.AddrBook
+	nodeInfo  p2p.NodeInfo
+	nodeKey   *p2p.NodeKey
+
+	stateSyncReactor *statesync.Reactor
+}
+
+// NewSnapshotServer returns a new SnapshotServer serving the snapshots of the
+// given directory to the peers of the chain of the genesis doc.
+func NewSnapshotServer(
+	config *cfg.Config,
+	nodeKey *p2p.NodeKey,
+	genDoc *types.GenesisDoc,
+	snapshots *statesync.SnapshotDir,
+	logger log.Logger,
+) (*SnapshotServer, error) {
+	if config.FilterPeers {
+		return nil, errors.New("a snapshot server can't filter peers, it has no application")
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("invalid genesis doc: %w", err)
+	}
+
+	stateSyncReactor := statesync.NewReactor(*config.StateSync, nil, nil, statesync.NopMetrics(),
+		statesync.WithSnapshotDir(snapshots))
+	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
+
+	nodeInfo := p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(
+			version.P2PProtocol,
+			version.BlockProtocol,
+			genDoc.ConsensusParams.Version.App,
+		),
+		DefaultNodeID: nodeKey.ID(),
+		Network:       genDoc.ChainID,
+		Version:       version.TMCoreSemVer,
+		Channels: []byte{
+			statesync.SnapshotChannel, statesync.ChunkChannel,
+			statesync.LightBlockChannel, statesync.ParamsChannel,
+		},
+		Moniker: config.Moniker,
+		Other: p2p.DefaultNodeInfoOther{
+			TxIndex: "off",
+		},
+		ListenAddr: config.P2P.ListenAddress,
+	}
+	if config.P2P.ExternalAddress != "" {
+		nodeInfo.ListenAddr = config.P2P.ExternalAddress
+	}
+	if config.P2P.PexReactor {
+		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+	}
+	if err := nodeInfo.Validate(); err != nil {
+		return nil, err
+	}
+
+	transport, peerFilters, err := createTransport(config, nodeInfo, nodeKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p2pLogger := logger.With("module", "p2p")
+	sw := p2p.NewSwitch(config.P2P, transport, p2p.SwitchPeerFilters(peerFilters...))
+	sw.SetLogger(p2pLogger)
+	sw.AddReactor("STATESYNC", stateSyncReactor)
+	sw.SetNodeInfo(nodeInfo)
+	sw.SetNodeKey(nodeKey)
+	p2pLogger.Info("P2P Node ID", "ID", nodeKey.ID(), "file", config.NodeKeyFile())
+
+	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
+	}
+	err = sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
+	}
+
+	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not create addrbook: %w", err)
+	}
+	if config.P2P.PexReactor {
+		createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+	}
+	addrBook.AddPrivateIDs(splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "))
+
+	s := &SnapshotServer{
+		config:           config,
+		transport:        transport,
+		sw:               sw,
+		addrBook:         addrBook,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		stateSyncReactor: stateSyncReactor,
+	}
+	s.BaseService = *service.NewBaseService(logger, "SnapshotServer", s)
+	return s, nil
+}
+
+// OnStart starts the SnapshotServer. It implements service.Service.
+func (s *SnapshotServer) OnStart() error {
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(s.nodeKey.ID(), s.config.P2P.ListenAddress))
+	if err != nil {
+		return err
+	}
+	if err := s.transport.Listen(*addr); err != nil {
+		return err
+	}
+
+	if err := s.sw.Start(); err != nil {
+		return err
+	}
+
+	err = s.sw.DialPeersAsync(splitAndTrimEmpty(s.config.P2P.PersistentPeers, ",", " "))
+	if err != nil {
+		return fmt.Errorf("could not dial peers from persistent_peers field: %w", err)
+	}
+	return nil
+}
+
+// OnStop stops the SnapshotServer. It implements service.Service.
+func (s *SnapshotServer) OnStop() {
+	s.BaseService.OnStop()
+
+	if err := s.sw.Stop(); err != nil {
+		s.Logger.Error("Error closing switch", "err", err)
+	}
+	if err := s.transport.Close(); err != nil {
+		s.Logger.Error("Error closing transport", "err", err)
+	}
+}
+
+// Switch returns the SnapshotServer's Switch.
+func (s *SnapshotServer) Switch() *p2p.Switch {
+	return s.sw
+}
+
+// NodeInfo returns the SnapshotServer's Info from the Switch.
+func (s *SnapshotServer) NodeInfo() p2p.NodeInfo {
+	return s.nodeInfo
+}
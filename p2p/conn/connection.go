@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/go-kit/kit/metrics"
 
 	flow "github.com/cometbft/cometbft/libs/flowrate"
 	"github.com/cometbft/cometbft/libs/log"
@@ -93,6 +94,7 @@ type MConnection struct {
 	onError       errorCbFunc
 	errored       uint32
 	config        MConnConfig
+	metrics       *Metrics
 
 	// Closing quitSendRoutine will cause the sendRoutine to eventually quit.
 	// doneSendRoutine is closed when the sendRoutine actually quits.
@@ -115,6 +117,8 @@ type MConnection struct {
 
 	chStatsTimer *time.Ticker // update channel stats periodically
 
+	unflushedSince time.Time // time of the first write since the last flush
+
 	created time.Time // time of creation
 
 	_maxPacketMsgSize int
@@ -150,6 +154,14 @@ func DefaultMConnConfig() MConnConfig {
 	}
 }
 
+// MConnOption sets an optional parameter on the MConnection.
+type MConnOption func(*MConnection)
+
+// MConnMetrics sets the metrics of the MConnection.
+func MConnMetrics(metrics *Metrics) MConnOption {
+	return func(c *MConnection) { c.metrics = metrics }
+}
+
 // NewMConnection wraps net.Conn and creates multiplex connection
 func NewMConnection(
 	conn net.Conn,
@@ -172,6 +184,7 @@ func NewMConnectionWithConfig(
 	onReceive receiveCbFunc,
 	onError errorCbFunc,
 	config MConnConfig,
+	options ...MConnOption,
 ) *MConnection {
 	if config.PongTimeout >= config.PingInterval {
 		panic("pongTimeout must be less than pingInterval (otherwise, next ping will reset pong timer)")
@@ -188,8 +201,12 @@ func NewMConnectionWithConfig(
 		onReceive:     onReceive,
 		onError:       onError,
 		config:        config,
+		metrics:       NopMetrics(),
 		created:       time.Now(),
 	}
+	for _, option := range options {
+		option(mconn)
+	}
 
 	// Create channels
 	channelsIdx := map[byte]*Channel{}
@@ -328,6 +345,10 @@ func (c *MConnection) flush() {
 	if err != nil {
 		c.Logger.Debug("MConnection flush failed", "err", err)
 	}
+	if !c.unflushedSince.IsZero() {
+		c.metrics.FlushDelay.Observe(time.Since(c.unflushedSince).Seconds())
+		c.unflushedSince = time.Time{}
+	}
 }
 
 // Catch panics, usually caused by remote disconnects.
@@ -400,6 +421,8 @@ func (c *MConnection) TrySend(chID byte, msgBytes []byte) bool {
 		case c.send <- struct{}{}:
 		default:
 		}
+	} else {
+		channel.trySendFailures.Add(1)
 	}
 
 	return ok
@@ -507,7 +530,9 @@ func (c *MConnection) sendSomePacketMsgs() bool {
 	// Block until .sendMonitor says we can write.
 	// Once we're ready we send more than we asked for,
 	// but amortized it should even out.
+	start := time.Now()
 	c.sendMonitor.Limit(c._maxPacketMsgSize, atomic.LoadInt64(&c.config.SendRate), true)
+	c.metrics.SendThrottle.Add(time.Since(start).Seconds())
 
 	// Now send some PacketMsgs.
 	for i := 0; i < numBatchPacketMsgs; i++ {
@@ -551,6 +576,9 @@ func (c *MConnection) sendPacketMsg() bool {
 		return true
 	}
 	c.sendMonitor.Update(_n)
+	if c.unflushedSince.IsZero() {
+		c.unflushedSince = time.Now()
+	}
 	c.flushTimer.Set()
 	return false
 }
@@ -567,7 +595,9 @@ func (c *MConnection) recvRoutine() {
 FOR_LOOP:
 	for {
 		// Block until .recvMonitor says we can read.
+		start := time.Now()
 		c.recvMonitor.Limit(c._maxPacketMsgSize, atomic.LoadInt64(&c.config.RecvRate), true)
+		c.metrics.RecvThrottle.Add(time.Since(start).Seconds())
 
 		// Peek into bufConnReader for debugging
 		/*
@@ -749,28 +779,41 @@ func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
 type Channel struct {
 	conn          *MConnection
 	desc          ChannelDescriptor
-	sendQueue     chan []byte
+	sendQueue     chan queuedMsg
 	sendQueueSize int32 // atomic.
 	recving       []byte
 	sending       []byte
-	recentlySent  int64 // exponential moving average
+	sendingQueued time.Time // when the message being sent was queued
+	recentlySent  int64     // exponential moving average
+
+	trySendFailures metrics.Counter
+	sendLatency     metrics.Histogram
 
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
 }
 
+// queuedMsg is a message in the send queue of a channel.
+type queuedMsg struct {
+	bytes  []byte
+	queued time.Time
+}
+
 func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 	desc = desc.FillDefaults()
 	if desc.Priority <= 0 {
 		panic("Channel default priority must be a positive integer")
 	}
+	chID := fmt.Sprintf("%#x", desc.ID)
 	return &Channel{
 		conn:                    conn,
 		desc:                    desc,
-		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
+		sendQueue:               make(chan queuedMsg, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
+		trySendFailures:         conn.metrics.TrySendFailures.With("chID", chID),
+		sendLatency:             conn.metrics.SendLatency.With("chID", chID),
 	}
 }
 
@@ -783,7 +826,7 @@ func (ch *Channel) SetLogger(l log.Logger) {
 // Times out (and returns false) after defaultSendTimeout
 func (ch *Channel) sendBytes(bytes []byte) bool {
 	select {
-	case ch.sendQueue <- bytes:
+	case ch.sendQueue <- queuedMsg{bytes: bytes, queued: time.Now()}:
 		atomic.AddInt32(&ch.sendQueueSize, 1)
 		return true
 	case <-time.After(defaultSendTimeout):
@@ -796,7 +839,7 @@ func (ch *Channel) sendBytes(bytes []byte) bool {
 // Goroutine-safe
 func (ch *Channel) trySendBytes(bytes []byte) bool {
 	select {
-	case ch.sendQueue <- bytes:
+	case ch.sendQueue <- queuedMsg{bytes: bytes, queued: time.Now()}:
 		atomic.AddInt32(&ch.sendQueueSize, 1)
 		return true
 	default:
@@ -823,7 +866,8 @@ func (ch *Channel) isSendPending() bool {
 		if len(ch.sendQueue) == 0 {
 			return false
 		}
-		ch.sending = <-ch.sendQueue
+		msg := <-ch.sendQueue
+		ch.sending, ch.sendingQueued = msg.bytes, msg.queued
 	}
 	return true
 }
@@ -838,6 +882,7 @@ func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
 		packet.EOF = true
 		ch.sending = nil
 		atomic.AddInt32(&ch.sendQueueSize, -1) // decrement sendQueueSize
+		ch.sendLatency.Observe(time.Since(ch.sendingQueued).Seconds())
 	} else {
 		packet.EOF = false
 		ch.sending = ch.sending[cmtmath.MinInt(maxSize, len(ch.sending)):]
@@ -3,11 +3,13 @@ package conn
 import (
 	"encoding/hex"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/fortytw2/leaktest"
+	"github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -564,6 +566,88 @@ func TestMConnectionTrySend(t *testing.T) {
 	assert.Equal(t, "TrySend", <-resultCh)
 }
 
+// testMetric records the values of a metric, ignoring the labels.
+type testMetric struct {
+	mtx    sync.Mutex
+	values []float64
+}
+
+func (m *testMetric) record(value float64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.values = append(m.values, value)
+}
+
+func (m *testMetric) count() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return len(m.values)
+}
+
+func (m *testMetric) last() float64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if len(m.values) == 0 {
+		return 0
+	}
+	return m.values[len(m.values)-1]
+}
+
+type testCounter struct{ testMetric }
+
+func (c *testCounter) With(...string) metrics.Counter { return c }
+func (c *testCounter) Add(delta float64)              { c.record(delta) }
+
+type testHistogram struct{ testMetric }
+
+func (h *testHistogram) With(...string) metrics.Histogram { return h }
+func (h *testHistogram) Observe(value float64)            { h.record(value) }
+
+func TestMConnectionMetrics(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	var (
+		trySendFailures = &testCounter{}
+		sendLatency     = &testHistogram{}
+		flushDelay      = &testHistogram{}
+	)
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	mconn := NewMConnectionWithConfig(client, chDescs, func(byte, []byte) {}, func(interface{}) {},
+		DefaultMConnConfig(), MConnMetrics(&Metrics{
+			TrySendFailures: trySendFailures,
+			SendLatency:     sendLatency,
+			FlushDelay:      flushDelay,
+			SendThrottle:    &testCounter{},
+			RecvThrottle:    &testCounter{},
+		}))
+	mconn.SetLogger(log.TestingLogger())
+	require.NoError(t, mconn.Start())
+	defer mconn.Stop() //nolint:errcheck // ignore for tests
+
+	// The latency of a sent message and the delay of its flush are recorded.
+	assert.True(t, mconn.TrySend(0x01, []byte("Semicolon-Woman")))
+	_, err := server.Read(make([]byte, 100))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return sendLatency.count() == 1 && flushDelay.count() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Positive(t, flushDelay.last())
+
+	// Without the server reading, a large message blocks the connection and
+	// the messages which don't fit in the send queue are dropped.
+	assert.True(t, mconn.Send(0x01, make([]byte, 2*minWriteBufferSize)))
+	failures := 0
+	for i := 0; i < 3; i++ {
+		if !mconn.TrySend(0x01, []byte("Semicolon-Woman")) {
+			failures++
+		}
+	}
+	assert.Positive(t, failures)
+	assert.Equal(t, failures, trySendFailures.count())
+}
+
 //nolint:lll //ignore line length for tests
 func TestConnVectors(t *testing.T) {
 
@@ -0,0 +1,33 @@
+package conn
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+)
+
+// Metrics contains the metrics of an MConnection. The labels of the peer are
+// set by the caller, the connection adds the chID label to the per-channel
+// metrics.
+type Metrics struct {
+	// Number of messages dropped by TrySend as the send queue of a channel was full.
+	TrySendFailures metrics.Counter
+	// Time messages wait in the send queue of a channel until fully written, in seconds.
+	SendLatency metrics.Histogram
+	// Time between the first unflushed write and the flush, in seconds.
+	FlushDelay metrics.Histogram
+	// Time spent waiting to send, limited by the send rate, in seconds.
+	SendThrottle metrics.Counter
+	// Time spent waiting to receive, limited by the receive rate, in seconds.
+	RecvThrottle metrics.Counter
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		TrySendFailures: discard.NewCounter(),
+		SendLatency:     discard.NewHistogram(),
+		FlushDelay:      discard.NewHistogram(),
+		SendThrottle:    discard.NewCounter(),
+		RecvThrottle:    discard.NewCounter(),
+	}
+}
@@ -56,17 +56,63 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_send_bytes_total",
 			Help:      "Number of bytes of each message type sent.",
 		}, append(labels, "message_type")).With(labelsAndValues...),
+		PeerSendQueueSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_queue_size",
+			Help:      "Number of messages in the send queue of a channel to a given peer.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerTrySendFailuresTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_try_send_failures_total",
+			Help:      "Number of messages to a given peer dropped by TrySend as the send queue of the channel was full.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_latency_seconds",
+			Help:      "Time messages to a given peer wait in the send queue of a channel until fully written, in seconds.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 10, 8),
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerFlushDelaySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_flush_delay_seconds",
+			Help:      "Time between the first unflushed write to a given peer and the flush, delayed by the flush throttle, in seconds.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 1, 8),
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerSendThrottleSecondsTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_throttle_seconds_total",
+			Help:      "Time spent waiting to send to a given peer, limited by the send rate, in seconds.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerRecvThrottleSecondsTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_recv_throttle_seconds_total",
+			Help:      "Time spent waiting to receive from a given peer, limited by the receive rate, in seconds.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Peers:                    discard.NewGauge(),
-		PeerReceiveBytesTotal:    discard.NewCounter(),
-		PeerSendBytesTotal:       discard.NewCounter(),
-		PeerPendingSendBytes:     discard.NewGauge(),
-		NumTxs:                   discard.NewGauge(),
-		MessageReceiveBytesTotal: discard.NewCounter(),
-		MessageSendBytesTotal:    discard.NewCounter(),
+		Peers:                        discard.NewGauge(),
+		PeerReceiveBytesTotal:        discard.NewCounter(),
+		PeerSendBytesTotal:           discard.NewCounter(),
+		PeerPendingSendBytes:         discard.NewGauge(),
+		NumTxs:                       discard.NewGauge(),
+		MessageReceiveBytesTotal:     discard.NewCounter(),
+		MessageSendBytesTotal:        discard.NewCounter(),
+		PeerSendQueueSize:            discard.NewGauge(),
+		PeerTrySendFailuresTotal:     discard.NewCounter(),
+		PeerSendLatencySeconds:       discard.NewHistogram(),
+		PeerFlushDelaySeconds:        discard.NewHistogram(),
+		PeerSendThrottleSecondsTotal: discard.NewCounter(),
+		PeerRecvThrottleSecondsTotal: discard.NewCounter(),
 	}
 }
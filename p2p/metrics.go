@@ -7,6 +7,8 @@ import (
 	"sync"
 
 	"github.com/go-kit/kit/metrics"
+
+	cmtconn "github.com/cometbft/cometbft/p2p/conn"
 )
 
 const (
@@ -40,6 +42,34 @@ type Metrics struct {
 	MessageReceiveBytesTotal metrics.Counter `metrics_labels:"message_type"`
 	// Number of bytes of each message type sent.
 	MessageSendBytesTotal metrics.Counter `metrics_labels:"message_type"`
+	// Number of messages in the send queue of a channel to a given peer.
+	PeerSendQueueSize metrics.Gauge `metrics_labels:"peer_id,chID"`
+	// Number of messages to a given peer dropped by TrySend as the send queue
+	// of the channel was full.
+	PeerTrySendFailuresTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Time messages to a given peer wait in the send queue of a channel until
+	// fully written, in seconds.
+	PeerSendLatencySeconds metrics.Histogram `metrics_labels:"peer_id,chID" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 10, 8"`
+	// Time between the first unflushed write to a given peer and the flush,
+	// delayed by the flush throttle, in seconds.
+	PeerFlushDelaySeconds metrics.Histogram `metrics_labels:"peer_id" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 1, 8"`
+	// Time spent waiting to send to a given peer, limited by the send rate,
+	// in seconds.
+	PeerSendThrottleSecondsTotal metrics.Counter `metrics_labels:"peer_id"`
+	// Time spent waiting to receive from a given peer, limited by the receive
+	// rate, in seconds.
+	PeerRecvThrottleSecondsTotal metrics.Counter `metrics_labels:"peer_id"`
+}
+
+// connMetrics returns the metrics of the connection to the given peer.
+func (m *Metrics) connMetrics(peerID ID) *cmtconn.Metrics {
+	return &cmtconn.Metrics{
+		TrySendFailures: m.PeerTrySendFailuresTotal.With("peer_id", string(peerID)),
+		SendLatency:     m.PeerSendLatencySeconds.With("peer_id", string(peerID)),
+		FlushDelay:      m.PeerFlushDelaySeconds.With("peer_id", string(peerID)),
+		SendThrottle:    m.PeerSendThrottleSecondsTotal.With("peer_id", string(peerID)),
+		RecvThrottle:    m.PeerRecvThrottleSecondsTotal.With("peer_id", string(peerID)),
+	}
 }
 
 type metricsLabelCache struct {
@@ -42,9 +42,13 @@ func TestNewNetAddress(t *testing.T) {
 	addr := NewNetAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", tcpAddr)
 	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", addr.String())
 
+	udpAddr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000}
+	addr = NewNetAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", udpAddr)
+	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8000", addr.String())
+
 	assert.NotPanics(t, func() {
-		NewNetAddress("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
-	}, "Calling NewNetAddress with UDPAddr should not panic in testing")
+		NewNetAddress("", &net.UnixAddr{Name: "/tmp/test.sock", Net: "unix"})
+	}, "Calling NewNetAddress with UnixAddr should not panic in testing")
 }
 
 func TestNewNetAddressString(t *testing.T) {
@@ -152,6 +152,9 @@ func newPeer(
 		mlc:           mlc,
 	}
 
+	for _, option := range options {
+		option(p)
+	}
 	p.mconn = createMConnection(
 		pc.conn,
 		p,
@@ -162,9 +165,6 @@ func newPeer(
 		mConfig,
 	)
 	p.BaseService = *service.NewBaseService(nil, "Peer", p)
-	for _, option := range options {
-		option(p)
-	}
 
 	return p
 }
@@ -375,6 +375,10 @@ func (p *peer) metricsReporter() {
 			var sendQueueSize float64
 			for _, chStatus := range status.Channels {
 				sendQueueSize += float64(chStatus.SendQueueSize)
+				p.metrics.PeerSendQueueSize.With(
+					"peer_id", string(p.ID()),
+					"chID", fmt.Sprintf("%#x", chStatus.ID),
+				).Set(float64(chStatus.SendQueueSize))
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
@@ -398,46 +402,67 @@ func createMConnection(
 ) *cmtconn.MConnection {
 
 	onReceive := func(chID byte, msgBytes []byte) {
-		reactor := reactorsByCh[chID]
-		if reactor == nil {
-			// Note that its ok to panic here as it's caught in the conn._recover,
-			// which does onPeerError.
-			panic(fmt.Sprintf("Unknown channel %X", chID))
-		}
-		mt := msgTypeByChID[chID]
-		msg := proto.Clone(mt)
-		err := proto.Unmarshal(msgBytes, msg)
-		if err != nil {
-			panic(fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt)))
-		}
-		labels := []string{
-			"peer_id", string(p.ID()),
-			"chID", fmt.Sprintf("%#x", chID),
-		}
-		if w, ok := msg.(Unwrapper); ok {
-			msg, err = w.Unwrap()
-			if err != nil {
-				panic(fmt.Errorf("unwrapping message: %s", err))
-			}
-		}
-		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
-		p.metrics.MessageReceiveBytesTotal.With("message_type", p.mlc.ValueToMetricLabel(msg)).Add(float64(len(msgBytes)))
-		reactor.Receive(Envelope{
-			ChannelID: chID,
-			Src:       p,
-			Message:   msg,
-		})
+		deliverMessage(p, chID, msgBytes, reactorsByCh, msgTypeByChID, p.metrics, p.mlc)
 	}
 
 	onError := func(r interface{}) {
 		onPeerError(p, r)
 	}
 
+	var options []cmtconn.MConnOption
+	if p.metrics != nil {
+		options = append(options, cmtconn.MConnMetrics(p.metrics.connMetrics(p.ID())))
+	}
+
 	return cmtconn.NewMConnectionWithConfig(
 		conn,
 		chDescs,
 		onReceive,
 		onError,
 		config,
+		options...,
 	)
 }
+
+// deliverMessage decodes the message received from src on the channel chID and
+// passes it to the reactor of the channel. It panics if the channel is unknown
+// or the message cannot be decoded.
+func deliverMessage(
+	src Peer,
+	chID byte,
+	msgBytes []byte,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	metrics *Metrics,
+	mlc *metricsLabelCache,
+) {
+	reactor := reactorsByCh[chID]
+	if reactor == nil {
+		// Note that its ok to panic here as it is recovered by the connection,
+		// which does onPeerError.
+		panic(fmt.Sprintf("Unknown channel %X", chID))
+	}
+	mt := msgTypeByChID[chID]
+	msg := proto.Clone(mt)
+	err := proto.Unmarshal(msgBytes, msg)
+	if err != nil {
+		panic(fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt)))
+	}
+	labels := []string{
+		"peer_id", string(src.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	if w, ok := msg.(Unwrapper); ok {
+		msg, err = w.Unwrap()
+		if err != nil {
+			panic(fmt.Errorf("unwrapping message: %s", err))
+		}
+	}
+	metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	metrics.MessageReceiveBytesTotal.With("message_type", mlc.ValueToMetricLabel(msg)).Add(float64(len(msgBytes)))
+	reactor.Receive(Envelope{
+		ChannelID: chID,
+		Src:       src,
+		Message:   msg,
+	})
+}
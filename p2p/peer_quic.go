@@ -0,0 +1,532 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/libs/cmap"
+	flow "github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+
+	cmtconn "github.com/cometbft/cometbft/p2p/conn"
+)
+
+// quicSendTimeout is the time waited by Send for the send queue of a channel
+// to have room for the message, like MConnection.Send.
+const quicSendTimeout = 10 * time.Second
+
+// quicFlushTimeout is the time waited by FlushStop for the remote end to
+// close the connection once it has read all the streams. Closing the
+// connection first would discard the data not yet received by the remote end.
+const quicFlushTimeout = 2 * time.Second
+
+// quicChannel is a channel of a quicPeer. Its messages are written to their
+// own unidirectional stream, so that the channels do not block each other.
+type quicChannel struct {
+	desc      cmtconn.ChannelDescriptor
+	sendQueue chan []byte
+}
+
+// quicPeer implements Peer over a QUIC connection.
+//
+// Each channel is sent on a unidirectional stream opened by the sending end,
+// whose first byte is the ID of the channel, followed by the messages of the
+// channel prefixed with their uvarint-encoded length.
+type quicPeer struct {
+	service.BaseService
+
+	// raw peerConn and the QUIC connection
+	peerConn
+	qconn quic.Connection
+
+	// peer's node info and the channel it knows about
+	// channels = nodeInfo.Channels
+	// cached to avoid copying nodeInfo in hasChannel
+	nodeInfo NodeInfo
+	channels []byte
+
+	chs           map[byte]*quicChannel
+	reactorsByCh  map[byte]Reactor
+	msgTypeByChID map[byte]proto.Message
+	onPeerError   func(Peer, interface{})
+	errOnce       sync.Once
+
+	// ctx is canceled when the peer stops, and flushc is closed when it is
+	// stopped with FlushStop. sendWg tracks the send routines.
+	ctx    context.Context
+	cancel context.CancelFunc
+	flushc chan struct{}
+	sendWg sync.WaitGroup
+
+	// sendMonitor and recvMonitor throttle the messages of all the channels
+	// to the send and receive rates of the config, as with MConnection.
+	created     time.Time
+	config      cmtconn.MConnConfig
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+
+	// User data
+	Data *cmap.CMap
+
+	metrics       *Metrics
+	metricsTicker *time.Ticker
+	mlc           *metricsLabelCache
+
+	// When removal of a peer fails, we set this flag
+	removalAttemptFailed bool
+}
+
+var _ Peer = (*quicPeer)(nil)
+
+func newQUICPeer(
+	pc peerConn,
+	qconn quic.Connection,
+	nodeInfo NodeInfo,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	config cmtconn.MConnConfig,
+	onPeerError func(Peer, interface{}),
+	mlc *metricsLabelCache,
+	options ...PeerOption,
+) *quicPeer {
+	// The PeerOptions apply to a peer, so they are applied to a placeholder
+	// whose fields are then copied.
+	opts := &peer{metrics: NopMetrics()}
+	for _, option := range options {
+		option(opts)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &quicPeer{
+		peerConn:      pc,
+		qconn:         qconn,
+		nodeInfo:      nodeInfo,
+		channels:      nodeInfo.(DefaultNodeInfo).Channels,
+		chs:           make(map[byte]*quicChannel, len(chDescs)),
+		reactorsByCh:  reactorsByCh,
+		msgTypeByChID: msgTypeByChID,
+		onPeerError:   onPeerError,
+		ctx:           ctx,
+		cancel:        cancel,
+		flushc:        make(chan struct{}),
+		created:       time.Now(),
+		config:        config,
+		sendMonitor:   flow.New(0, 0),
+		recvMonitor:   flow.New(0, 0),
+		Data:          cmap.NewCMap(),
+		metrics:       opts.metrics,
+		metricsTicker: time.NewTicker(metricsTickerDuration),
+		mlc:           mlc,
+	}
+	for _, desc := range chDescs {
+		filled := desc.FillDefaults()
+		p.chs[desc.ID] = &quicChannel{
+			desc:      filled,
+			sendQueue: make(chan []byte, filled.SendQueueCapacity),
+		}
+	}
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+
+	return p
+}
+
+// String representation.
+func (p *quicPeer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{QUIC %v %v out}", p.RemoteAddr(), p.ID())
+	}
+
+	return fmt.Sprintf("Peer{QUIC %v %v in}", p.RemoteAddr(), p.ID())
+}
+
+//---------------------------------------------------
+// Implements service.Service
+
+// OnStart implements BaseService.
+func (p *quicPeer) OnStart() error {
+	if err := p.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	for _, ch := range p.chs {
+		p.sendWg.Add(1)
+		go p.sendRoutine(ch)
+	}
+	go p.acceptStreamsRoutine()
+	go p.metricsReporter()
+	return nil
+}
+
+// FlushStop mimics OnStop but additionally ensures that all successful
+// .Send() calls are written to their streams before closing the connection.
+// NOTE: it is not safe to call this method more than once.
+func (p *quicPeer) FlushStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	close(p.flushc)
+	p.sendWg.Wait()
+	select {
+	case <-p.qconn.Context().Done():
+	case <-time.After(quicFlushTimeout):
+	}
+	p.cancel()
+	_ = p.qconn.CloseWithError(0, "")
+}
+
+// OnStop implements BaseService.
+func (p *quicPeer) OnStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	p.cancel()
+	_ = p.qconn.CloseWithError(0, "")
+}
+
+//---------------------------------------------------
+// Implements Peer
+
+// ID returns the peer's ID - the hex encoded hash of its pubkey.
+func (p *quicPeer) ID() ID {
+	return p.nodeInfo.ID()
+}
+
+// IsOutbound returns true if the connection is outbound, false otherwise.
+func (p *quicPeer) IsOutbound() bool {
+	return p.peerConn.outbound
+}
+
+// IsPersistent returns true if the peer is persistent, false otherwise.
+func (p *quicPeer) IsPersistent() bool {
+	return p.peerConn.persistent
+}
+
+// NodeInfo returns a copy of the peer's NodeInfo.
+func (p *quicPeer) NodeInfo() NodeInfo {
+	return p.nodeInfo
+}
+
+// SocketAddr returns the address of the socket.
+func (p *quicPeer) SocketAddr() *NetAddress {
+	return p.peerConn.socketAddr
+}
+
+// Status returns the peer's ConnectionStatus.
+func (p *quicPeer) Status() cmtconn.ConnectionStatus {
+	status := cmtconn.ConnectionStatus{
+		Duration:    time.Since(p.created),
+		SendMonitor: p.sendMonitor.Status(),
+		RecvMonitor: p.recvMonitor.Status(),
+		Channels:    make([]cmtconn.ChannelStatus, 0, len(p.chs)),
+	}
+	for _, ch := range p.chs {
+		status.Channels = append(status.Channels, cmtconn.ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     len(ch.sendQueue),
+			Priority:          ch.desc.Priority,
+		})
+	}
+	return status
+}
+
+// Send msg bytes to the channel identified by chID byte. Returns false if the
+// send queue is full after quicSendTimeout.
+func (p *quicPeer) Send(e Envelope) bool {
+	return p.send(e.ChannelID, e.Message, true)
+}
+
+// TrySend msg bytes to the channel identified by chID byte. Immediately returns
+// false if the send queue is full.
+func (p *quicPeer) TrySend(e Envelope) bool {
+	return p.send(e.ChannelID, e.Message, false)
+}
+
+func (p *quicPeer) send(chID byte, msg proto.Message, block bool) bool {
+	if !p.IsRunning() {
+		return false
+	} else if !p.hasChannel(chID) {
+		return false
+	}
+	ch, ok := p.chs[chID]
+	if !ok {
+		p.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	metricLabelValue := p.mlc.ValueToMetricLabel(msg)
+	if w, ok := msg.(Wrapper); ok {
+		msg = w.Wrap()
+	}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		p.Logger.Error("marshaling message to send", "error", err)
+		return false
+	}
+
+	if block {
+		timer := time.NewTimer(quicSendTimeout)
+		defer timer.Stop()
+		select {
+		case ch.sendQueue <- msgBytes:
+		case <-timer.C:
+			return false
+		case <-p.ctx.Done():
+			return false
+		}
+	} else {
+		select {
+		case ch.sendQueue <- msgBytes:
+		default:
+			p.metrics.PeerTrySendFailuresTotal.With(
+				"peer_id", string(p.ID()),
+				"chID", fmt.Sprintf("%#x", chID),
+			).Add(1)
+			return false
+		}
+	}
+
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	p.metrics.MessageSendBytesTotal.With("message_type", metricLabelValue).Add(float64(len(msgBytes)))
+	return true
+}
+
+// Get the data for a given key.
+func (p *quicPeer) Get(key string) interface{} {
+	return p.Data.Get(key)
+}
+
+// Set sets the data for the given key.
+func (p *quicPeer) Set(key string, data interface{}) {
+	p.Data.Set(key, data)
+}
+
+// hasChannel returns true if the peer reported
+// knowing about the given chID.
+func (p *quicPeer) hasChannel(chID byte) bool {
+	for _, ch := range p.channels {
+		if ch == chID {
+			return true
+		}
+	}
+	p.Logger.Debug(
+		"Unknown channel for peer",
+		"channel",
+		chID,
+		"channels",
+		p.channels,
+	)
+	return false
+}
+
+// CloseConn closes original connection. Used for cleaning up in cases where the peer had not been started at all.
+func (p *quicPeer) CloseConn() error {
+	return p.peerConn.conn.Close()
+}
+
+// RemoteAddr returns peer's remote network address.
+func (p *quicPeer) RemoteAddr() net.Addr {
+	return p.peerConn.conn.RemoteAddr()
+}
+
+func (p *quicPeer) SetRemovalFailed() {
+	p.removalAttemptFailed = true
+}
+
+func (p *quicPeer) GetRemovalFailed() bool {
+	return p.removalAttemptFailed
+}
+
+//---------------------------------------------------
+
+// sendRoutine opens the stream of the channel, and writes the messages of its
+// send queue until the peer stops. If the peer is stopped with FlushStop, the
+// messages left in the queue are written before returning.
+func (p *quicPeer) sendRoutine(ch *quicChannel) {
+	defer p.sendWg.Done()
+
+	stream, err := p.qconn.OpenUniStreamSync(p.ctx)
+	if err != nil {
+		p.stopForError(fmt.Errorf("opening stream of channel %#x: %w", ch.desc.ID, err))
+		return
+	}
+	defer stream.Close()
+	w := bufio.NewWriter(stream)
+
+	write := func(msgBytes []byte) bool {
+		_, err := w.Write(binary.AppendUvarint(nil, uint64(len(msgBytes))))
+		if err == nil {
+			err = p.writeLimited(w, msgBytes)
+		}
+		// Flush when there is nothing left to write, so that a burst of
+		// messages is sent in as few packets as possible.
+		if err == nil && len(ch.sendQueue) == 0 {
+			err = w.Flush()
+		}
+		if err != nil {
+			p.stopForError(fmt.Errorf("writing to channel %#x: %w", ch.desc.ID, err))
+			return false
+		}
+		return true
+	}
+
+	if err := w.WriteByte(ch.desc.ID); err != nil {
+		p.stopForError(fmt.Errorf("writing to channel %#x: %w", ch.desc.ID, err))
+		return
+	}
+	for {
+		select {
+		case msgBytes := <-ch.sendQueue:
+			if !write(msgBytes) {
+				return
+			}
+		case <-p.flushc:
+			for {
+				select {
+				case msgBytes := <-ch.sendQueue:
+					if !write(msgBytes) {
+						return
+					}
+				default:
+					_ = w.Flush()
+					return
+				}
+			}
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// acceptStreamsRoutine accepts the streams of the channels opened by the
+// remote end, and reads them until the peer stops.
+func (p *quicPeer) acceptStreamsRoutine() {
+	for {
+		stream, err := p.qconn.AcceptUniStream(p.ctx)
+		if err != nil {
+			p.stopForError(fmt.Errorf("accepting stream: %w", err))
+			return
+		}
+		go p.recvRoutine(stream)
+	}
+}
+
+// recvRoutine reads the messages of a channel from its stream and delivers
+// them to the reactor of the channel. The stream ends when the remote end
+// stops with FlushStop, which is reported as an error so that the connection
+// is closed.
+func (p *quicPeer) recvRoutine(stream quic.ReceiveStream) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.stopForError(r)
+		}
+	}()
+
+	r := bufio.NewReader(stream)
+	chID, err := r.ReadByte()
+	if err != nil {
+		p.stopForError(fmt.Errorf("reading channel of stream: %w", err))
+		return
+	}
+	ch, ok := p.chs[chID]
+	if !ok {
+		p.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			p.stopForError(fmt.Errorf("reading from channel %#x: %w", chID, err))
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) {
+			p.stopForError(fmt.Errorf(
+				"received message exceeds available capacity of channel %#x: %v < %v",
+				chID, ch.desc.RecvMessageCapacity, size))
+			return
+		}
+		msgBytes := make([]byte, size)
+		if err := p.readLimited(r, msgBytes); err != nil {
+			p.stopForError(fmt.Errorf("reading from channel %#x: %w", chID, err))
+			return
+		}
+		deliverMessage(p, chID, msgBytes, p.reactorsByCh, p.msgTypeByChID, p.metrics, p.mlc)
+	}
+}
+
+// writeLimited writes bz to w, blocking until sendMonitor says each part of
+// it can be written without exceeding the send rate.
+func (p *quicPeer) writeLimited(w io.Writer, bz []byte) error {
+	for len(bz) > 0 {
+		n := p.sendMonitor.Limit(len(bz), p.config.SendRate, true)
+		if _, err := w.Write(bz[:n]); err != nil {
+			return err
+		}
+		p.sendMonitor.Update(n)
+		bz = bz[n:]
+	}
+	return nil
+}
+
+// readLimited fills bz from r, blocking until recvMonitor says each part of it
+// can be read without exceeding the receive rate.
+func (p *quicPeer) readLimited(r io.Reader, bz []byte) error {
+	for len(bz) > 0 {
+		n := p.recvMonitor.Limit(len(bz), p.config.RecvRate, true)
+		if _, err := io.ReadFull(r, bz[:n]); err != nil {
+			return err
+		}
+		p.recvMonitor.Update(n)
+		bz = bz[n:]
+	}
+	return nil
+}
+
+// stopForError reports the first error of the connection to the switch,
+// unless the peer is already stopping.
+func (p *quicPeer) stopForError(r interface{}) {
+	if p.ctx.Err() != nil {
+		return
+	}
+	p.errOnce.Do(func() {
+		p.onPeerError(p, r)
+	})
+}
+
+func (p *quicPeer) metricsReporter() {
+	for {
+		select {
+		case <-p.metricsTicker.C:
+			var sendQueueSize float64
+			for chID, ch := range p.chs {
+				sendQueueSize += float64(len(ch.sendQueue))
+				p.metrics.PeerSendQueueSize.With(
+					"peer_id", string(p.ID()),
+					"chID", fmt.Sprintf("%#x", chID),
+				).Set(float64(len(ch.sendQueue)))
+			}
+
+			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// SetLogger implements BaseService.
+func (p *quicPeer) SetLogger(l log.Logger) {
+	p.Logger = l
+}
@@ -360,7 +360,19 @@ func (mt *MultiplexTransport) cleanup(c net.Conn) error {
 	return c.Close()
 }
 
-func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
+func (mt *MultiplexTransport) filterConn(c net.Conn) error {
+	return filterConn(c, mt.conns, mt.connFilters, mt.resolver, mt.filterTimeout)
+}
+
+// filterConn rejects the connection if it is already present in conns or if
+// any of the filters rejects it, and adds it to conns otherwise.
+func filterConn(
+	c net.Conn,
+	conns ConnSet,
+	connFilters []ConnFilterFunc,
+	resolver IPResolver,
+	filterTimeout time.Duration,
+) (err error) {
 	defer func() {
 		if err != nil {
 			_ = c.Close()
@@ -368,21 +380,21 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 	}()
 
 	// Reject if connection is already present.
-	if mt.conns.Has(c) {
+	if conns.Has(c) {
 		return ErrRejected{conn: c, isDuplicate: true}
 	}
 
 	// Resolve ips for incoming conn.
-	ips, err := resolveIPs(mt.resolver, c)
+	ips, err := resolveIPs(resolver, c)
 	if err != nil {
 		return err
 	}
 
-	errc := make(chan error, len(mt.connFilters))
+	errc := make(chan error, len(connFilters))
 
-	for _, f := range mt.connFilters {
+	for _, f := range connFilters {
 		go func(f ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
-			errc <- f(mt.conns, c, ips)
+			errc <- f(conns, c, ips)
 		}(f, c, ips, errc)
 	}
 
@@ -392,13 +404,13 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 			if err != nil {
 				return ErrRejected{conn: c, err: err, isFiltered: true}
 			}
-		case <-time.After(mt.filterTimeout):
+		case <-time.After(filterTimeout):
 			return ErrFilterTimeout{}
 		}
 
 	}
 
-	mt.conns.Set(c, ips)
+	conns.Set(c, ips)
 
 	return nil
 }
@@ -448,8 +460,18 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	if err := checkNodeInfo(c, connID, mt.nodeInfo, nodeInfo); err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+// checkNodeInfo validates the NodeInfo received from the peer authenticated
+// with connID on c, and ensures that it is compatible with ours.
+func checkNodeInfo(c net.Conn, connID ID, ourNodeInfo, nodeInfo NodeInfo) error {
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -458,7 +480,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Ensure connection key matches self reported key.
 	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -471,8 +493,8 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+	if ourNodeInfo.ID() == nodeInfo.ID() {
+		return ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -480,8 +502,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+	if err := ourNodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -489,7 +511,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -499,17 +521,7 @@ func (mt *MultiplexTransport) wrapPeer(
 	socketAddr *NetAddress,
 ) Peer {
 
-	persistent := false
-	if cfg.isPersistent != nil {
-		if cfg.outbound {
-			persistent = cfg.isPersistent(socketAddr)
-		} else {
-			selfReportedAddr, err := ni.NetAddress()
-			if err == nil {
-				persistent = cfg.isPersistent(selfReportedAddr)
-			}
-		}
-	}
+	persistent := isPersistentPeer(cfg, ni, socketAddr)
 
 	peerConn := newPeerConn(
 		cfg.outbound,
@@ -533,6 +545,22 @@ func (mt *MultiplexTransport) wrapPeer(
 	return p
 }
 
+// isPersistentPeer tells if the peer with the given NodeInfo and socket
+// address is persistent, according to cfg.
+func isPersistentPeer(cfg peerConfig, ni NodeInfo, socketAddr *NetAddress) bool {
+	if cfg.isPersistent == nil {
+		return false
+	}
+	if cfg.outbound {
+		return cfg.isPersistent(socketAddr)
+	}
+	selfReportedAddr, err := ni.NetAddress()
+	if err != nil {
+		return false
+	}
+	return cfg.isPersistent(selfReportedAddr)
+}
+
 func handshake(
 	c net.Conn,
 	timeout time.Duration,
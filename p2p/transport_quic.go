@@ -0,0 +1,623 @@
+package p2p
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/p2p/conn"
+	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+const (
+	// quicALPN is the application protocol negotiated by the QUIC transport.
+	quicALPN = "cometbft-p2p"
+
+	// quicAuthLabel is the label of the TLS keying material signed with the
+	// node keys to authenticate the QUIC connections.
+	quicAuthLabel = "EXPORTER-cometbft-p2p-auth"
+
+	// quicAuthMaxSize is the maximum size of the authentication message.
+	quicAuthMaxSize = 1024
+)
+
+var errQUICConnIO = errors.New("read and write are not supported on QUIC connections, use streams")
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.connFilters = filters }
+}
+
+// QUICTransportFilterTimeout sets the timeout waited for filter calls to
+// return.
+func QUICTransportFilterTimeout(timeout time.Duration) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.filterTimeout = timeout }
+}
+
+// QUICTransportResolver sets the Resolver used for ip lookups, defaults to
+// net.DefaultResolver.
+func QUICTransportResolver(resolver IPResolver) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.resolver = resolver }
+}
+
+// QUICTransportMaxIncomingConnections sets the maximum number of simultaneous
+// connections (incoming). Default: 0 (unlimited)
+func QUICTransportMaxIncomingConnections(n int) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.maxIncomingConnections = n }
+}
+
+// QUICTransport accepts and dials QUIC connections and upgrades them to peers
+// whose channels are each mapped to their own QUIC streams.
+//
+// The QUIC connections are encrypted by TLS 1.3 with ephemeral certificates.
+// Both ends then authenticate themselves by signing keying material exported
+// from the TLS session with their node keys, like the secret connections of
+// the MultiplexTransport.
+type QUICTransport struct {
+	netAddr                NetAddress
+	listener               *quic.Listener
+	maxIncomingConnections int // see MaxIncomingConnections
+	tlsConfig              *tls.Config
+
+	acceptc chan accept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       ConnSet
+	connFilters []ConnFilterFunc
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeKey          NodeKey
+	resolver         IPResolver
+
+	mConfig conn.MConnConfig
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC transport of peers. It fails if the TLS
+// certificate of the transport cannot be generated.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+	options ...QUICTransportOption,
+) (*QUICTransport, error) {
+	tlsConfig, err := newQUICTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	qt := &QUICTransport{
+		tlsConfig:        tlsConfig,
+		acceptc:          make(chan accept),
+		closec:           make(chan struct{}),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		mConfig:          mConfig,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		conns:            NewConnSet(),
+		resolver:         net.DefaultResolver,
+	}
+	for _, option := range options {
+		option(qt)
+	}
+	return qt, nil
+}
+
+// NetAddress implements Transport.
+func (qt *QUICTransport) NetAddress() NetAddress {
+	return qt.netAddr
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-qt.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		cfg.outbound = false
+
+		return qt.wrapPeer(a.conn.(quicConn), a.nodeInfo, cfg, a.netAddr), nil
+	case <-qt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (qt *QUICTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout)
+	defer cancel()
+
+	qc, err := quic.DialAddr(ctx, addr.DialString(), qt.tlsConfig, qt.quicConfig())
+	if err != nil {
+		return nil, err
+	}
+	c := quicConn{qc}
+
+	if err := qt.filterConn(c); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgrade(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	close(qt.closec)
+
+	if qt.listener != nil {
+		return qt.listener.Close()
+	}
+
+	return nil
+}
+
+// Listen implements transportLifecycle. The transport listens on the UDP port
+// of the given address.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	ln, err := quic.ListenAddr(addr.DialString(), qt.tlsConfig, qt.quicConfig())
+	if err != nil {
+		return err
+	}
+
+	qt.netAddr = addr
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+// AddChannel registers a channel to nodeInfo.
+// NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated
+func (qt *QUICTransport) AddChannel(chID byte) {
+	if ni, ok := qt.nodeInfo.(DefaultNodeInfo); ok {
+		if !ni.HasChannel(chID) {
+			ni.Channels = append(ni.Channels, chID)
+		}
+		qt.nodeInfo = ni
+	}
+}
+
+// Cleanup removes the given address from the connections set and
+// closes the connection.
+func (qt *QUICTransport) Cleanup(p Peer) {
+	qt.conns.RemoveAddr(p.RemoteAddr())
+	_ = p.CloseConn()
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	// sem limits the number of simultaneous incoming connections, like
+	// netutil.LimitListener does for the MultiplexTransport.
+	var sem chan struct{}
+	if qt.maxIncomingConnections > 0 {
+		sem = make(chan struct{}, qt.maxIncomingConnections)
+	}
+
+	for {
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-qt.closec:
+				return
+			}
+		}
+
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- accept{err: err}
+			return
+		}
+		if sem != nil {
+			go func() {
+				<-qc.Context().Done()
+				<-sem
+			}()
+		}
+
+		// Connection upgrade and filtering are asynchronous to avoid
+		// head-of-line blocking, see MultiplexTransport.acceptPeers.
+		go func(c quicConn) {
+			defer func() {
+				if r := recover(); r != nil {
+					err := ErrRejected{
+						conn:          c,
+						err:           fmt.Errorf("recovered from panic: %v", r),
+						isAuthFailure: true,
+					}
+					select {
+					case qt.acceptc <- accept{err: err}:
+					case <-qt.closec:
+						// Give up if the transport was closed.
+						_ = c.Close()
+						return
+					}
+				}
+			}()
+
+			var (
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+			)
+
+			err := qt.filterConn(c)
+			if err == nil {
+				nodeInfo, err = qt.upgrade(c, nil)
+				if err == nil {
+					netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+				}
+			}
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = c.Close()
+				return
+			}
+		}(quicConn{qc})
+	}
+}
+
+func (qt *QUICTransport) filterConn(c quicConn) error {
+	return filterConn(c, qt.conns, qt.connFilters, qt.resolver, qt.filterTimeout)
+}
+
+// upgrade authenticates the connection with the node keys, and exchanges the
+// NodeInfos on a dedicated stream opened by the dialing end.
+func (qt *QUICTransport) upgrade(c quicConn, dialedAddr *NetAddress) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			qt.conns.Remove(c)
+			_ = c.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(c.Context(), qt.handshakeTimeout)
+	defer cancel()
+
+	var stream quic.Stream
+	if dialedAddr != nil {
+		stream, err = c.OpenStreamSync(ctx)
+	} else {
+		stream, err = c.AcceptStream(ctx)
+	}
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("opening handshake stream failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+	defer stream.Close()
+	sc := quicStreamConn{Stream: stream, conn: c}
+
+	remotePubKey, err := quicAuthenticate(sc, c.ConnectionState().TLS, qt.handshakeTimeout, qt.nodeKey.PrivKey)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("authentication failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	// For outgoing conns, ensure connection key matches dialed key.
+	connID := PubKeyToID(remotePubKey)
+	if dialedAddr != nil {
+		if dialedID := dialedAddr.ID; connID != dialedID {
+			return nil, ErrRejected{
+				conn: c,
+				id:   connID,
+				err: fmt.Errorf(
+					"conn.ID (%v) dialed ID (%v) mismatch",
+					connID,
+					dialedID,
+				),
+				isAuthFailure: true,
+			}
+		}
+	}
+
+	nodeInfo, err = handshake(sc, qt.handshakeTimeout, qt.nodeInfo)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	if err := checkNodeInfo(c, connID, qt.nodeInfo, nodeInfo); err != nil {
+		return nil, err
+	}
+
+	return nodeInfo, nil
+}
+
+func (qt *QUICTransport) wrapPeer(
+	c quicConn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) Peer {
+	peerConn := newPeerConn(
+		cfg.outbound,
+		isPersistentPeer(cfg, ni, socketAddr),
+		c,
+		socketAddr,
+	)
+
+	return newQUICPeer(
+		peerConn,
+		c.Connection,
+		ni,
+		cfg.reactorsByCh,
+		cfg.msgTypeByChID,
+		cfg.chDescs,
+		qt.mConfig,
+		cfg.onPeerError,
+		cfg.mlc,
+		PeerMetrics(cfg.metrics),
+	)
+}
+
+// quicConfig returns the QUIC configuration of the connections, whose
+// keep-alives and idle timeout follow the pings of the MConnections.
+func (qt *QUICTransport) quicConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout: qt.handshakeTimeout,
+		MaxIdleTimeout:       qt.mConfig.PingInterval + qt.mConfig.PongTimeout,
+		KeepAlivePeriod:      qt.mConfig.PingInterval,
+	}
+}
+
+// quicAuthenticate signs the keying material exported from the TLS session
+// with privKey, sends the signature with the public key over c, and returns
+// the public key of the remote end once its signature is verified.
+func quicAuthenticate(
+	c net.Conn,
+	tlsState tls.ConnectionState,
+	timeout time.Duration,
+	privKey crypto.PrivKey,
+) (crypto.PubKey, error) {
+	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	challenge, err := tlsState.ExportKeyingMaterial(quicAuthLabel, nil, 32)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := privKey.Sign(challenge)
+	if err != nil {
+		return nil, err
+	}
+	pbPubKey, err := cryptoenc.PubKeyToProto(privKey.PubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		errc = make(chan error, 2)
+
+		remoteAuthSig tmp2p.AuthSigMessage
+	)
+
+	go func(errc chan<- error, c net.Conn) {
+		_, err := protoio.NewDelimitedWriter(c).WriteMsg(&tmp2p.AuthSigMessage{PubKey: pbPubKey, Sig: sig})
+		errc <- err
+	}(errc, c)
+	go func(errc chan<- error, c net.Conn) {
+		_, err := protoio.NewDelimitedReader(c, quicAuthMaxSize).ReadMsg(&remoteAuthSig)
+		errc <- err
+	}(errc, c)
+
+	for i := 0; i < cap(errc); i++ {
+		if err := <-errc; err != nil {
+			return nil, err
+		}
+	}
+
+	remotePubKey, err := cryptoenc.PubKeyFromProto(remoteAuthSig.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !remotePubKey.VerifySignature(challenge, remoteAuthSig.Sig) {
+		return nil, errors.New("challenge verification failed")
+	}
+
+	return remotePubKey, c.SetDeadline(time.Time{})
+}
+
+// newQUICTLSConfig returns a TLS configuration with an ephemeral self-signed
+// certificate. The certificates are not verified since the peers are
+// authenticated by their node keys.
+func newQUICTLSConfig() (*tls.Config, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(100 * 365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{certDER},
+			PrivateKey:  priv,
+		}},
+		InsecureSkipVerify: true, //nolint:gosec // peers are authenticated by their node keys
+		NextProtos:         []string{quicALPN},
+		MinVersion:         tls.VersionTLS13,
+	}, nil
+}
+
+// quicConn adapts a QUIC connection to net.Conn, so that it can be tracked in
+// the ConnSet and passed to the connection filters. The data is sent over
+// streams, so reading and writing the connection itself is not supported.
+type quicConn struct {
+	quic.Connection
+}
+
+var _ net.Conn = quicConn{}
+
+func (quicConn) Read([]byte) (int, error)  { return 0, errQUICConnIO }
+func (quicConn) Write([]byte) (int, error) { return 0, errQUICConnIO }
+
+func (c quicConn) Close() error {
+	return c.CloseWithError(0, "")
+}
+
+func (quicConn) SetDeadline(time.Time) error      { return nil }
+func (quicConn) SetReadDeadline(time.Time) error  { return nil }
+func (quicConn) SetWriteDeadline(time.Time) error { return nil }
+
+// quicStreamConn adapts a QUIC stream to net.Conn.
+type quicStreamConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+var _ net.Conn = quicStreamConn{}
+
+func (sc quicStreamConn) LocalAddr() net.Addr  { return sc.conn.LocalAddr() }
+func (sc quicStreamConn) RemoteAddr() net.Addr { return sc.conn.RemoteAddr() }
+
+//----------------------------------------------------------
+
+// HybridTransport accepts peers over both TCP and QUIC, which allows a network
+// to migrate to QUIC progressively. Peers are dialed over QUIC first, and over
+// TCP if the QUIC connection cannot be established. Both transports listen on
+// the same port, TCP and UDP respectively.
+type HybridTransport struct {
+	tcp  *MultiplexTransport
+	quic *QUICTransport
+}
+
+// Test HybridTransport for interface completeness.
+var _ Transport = (*HybridTransport)(nil)
+var _ transportLifecycle = (*HybridTransport)(nil)
+
+// NewHybridTransport returns a transport combining the given TCP and QUIC
+// transports.
+func NewHybridTransport(tcp *MultiplexTransport, quic *QUICTransport) *HybridTransport {
+	return &HybridTransport{tcp: tcp, quic: quic}
+}
+
+// NetAddress implements Transport.
+func (ht *HybridTransport) NetAddress() NetAddress {
+	return ht.tcp.NetAddress()
+}
+
+// Accept implements Transport. It returns the next peer accepted by either
+// transport.
+func (ht *HybridTransport) Accept(cfg peerConfig) (Peer, error) {
+	cfg.outbound = false
+
+	select {
+	case a := <-ht.tcp.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+		return ht.tcp.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+	case a := <-ht.quic.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+		return ht.quic.wrapPeer(a.conn.(quicConn), a.nodeInfo, cfg, a.netAddr), nil
+	case <-ht.tcp.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport. If the peer cannot be reached over QUIC, it is
+// dialed over TCP. A peer rejected over QUIC is not dialed again.
+func (ht *HybridTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	p, err := ht.quic.Dial(addr, cfg)
+	if err == nil {
+		return p, nil
+	}
+	if _, ok := err.(ErrRejected); ok {
+		return nil, err
+	}
+	return ht.tcp.Dial(addr, cfg)
+}
+
+// Cleanup implements Transport.
+func (ht *HybridTransport) Cleanup(p Peer) {
+	if _, ok := p.(*quicPeer); ok {
+		ht.quic.Cleanup(p)
+		return
+	}
+	ht.tcp.Cleanup(p)
+}
+
+// Close implements transportLifecycle.
+func (ht *HybridTransport) Close() error {
+	tcpErr := ht.tcp.Close()
+	if err := ht.quic.Close(); err != nil {
+		return err
+	}
+	return tcpErr
+}
+
+// Listen implements transportLifecycle.
+func (ht *HybridTransport) Listen(addr NetAddress) error {
+	if err := ht.tcp.Listen(addr); err != nil {
+		return err
+	}
+	return ht.quic.Listen(addr)
+}
+
+// AddChannel registers a channel to the nodeInfo of both transports.
+func (ht *HybridTransport) AddChannel(chID byte) {
+	ht.tcp.AddChannel(chID)
+	ht.quic.AddChannel(chID)
+}
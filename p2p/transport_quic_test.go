@@ -0,0 +1,268 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/conn"
+	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+func newTestQUICTransport(t *testing.T, name string) *QUICTransport {
+	t.Helper()
+	return newTestQUICTransportWithConfig(t, name, conn.DefaultMConnConfig())
+}
+
+func newTestQUICTransportWithConfig(t *testing.T, name string, mConfig conn.MConnConfig) *QUICTransport {
+	t.Helper()
+
+	pv := ed25519.GenPrivKey()
+	qt, err := NewQUICTransport(
+		testNodeInfo(PubKeyToID(pv.PubKey()), name),
+		NodeKey{PrivKey: pv},
+		mConfig,
+	)
+	require.NoError(t, err)
+	return qt
+}
+
+func testSetupQUICTransport(t *testing.T) *QUICTransport {
+	t.Helper()
+
+	qt := newTestQUICTransport(t, "transport")
+	addr, err := NewNetAddressString(IDAddressString(qt.nodeInfo.ID(), "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+	t.Cleanup(func() { _ = qt.Close() })
+
+	qt.netAddr = *NewNetAddress(qt.nodeInfo.ID(), qt.listener.Addr())
+	return qt
+}
+
+// testQUICPeerConfig returns the configuration of the peers of a transport,
+// with a test reactor on testCh.
+func testQUICPeerConfig() (peerConfig, *TestReactor) {
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: testCh, Priority: 1, MessageType: &p2pproto.Message{}},
+	}
+	reactor := NewTestReactor(chDescs, true)
+	return peerConfig{
+		chDescs:       chDescs,
+		onPeerError:   func(Peer, interface{}) {},
+		reactorsByCh:  map[byte]Reactor{testCh: reactor},
+		msgTypeByChID: map[byte]proto.Message{testCh: &p2pproto.Message{}},
+		metrics:       NopMetrics(),
+		mlc:           newMetricsLabelCache(),
+	}, reactor
+}
+
+func TestTransportQUICDialAccept(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := newTestQUICTransport(t, "dialer")
+
+	type result struct {
+		peer Peer
+		err  error
+	}
+	acceptedc := make(chan result)
+	serverCfg, serverReactor := testQUICPeerConfig()
+	go func() {
+		p, err := qt.Accept(serverCfg)
+		acceptedc <- result{p, err}
+	}()
+
+	dialerCfg, dialerReactor := testQUICPeerConfig()
+	dialed, err := dialer.Dial(qt.NetAddress(), dialerCfg)
+	require.NoError(t, err)
+	accepted := <-acceptedc
+	require.NoError(t, accepted.err)
+
+	assert.True(t, dialed.IsOutbound())
+	assert.Equal(t, qt.nodeInfo.ID(), dialed.ID())
+	assert.False(t, accepted.peer.IsOutbound())
+	assert.Equal(t, dialer.nodeInfo.ID(), accepted.peer.ID())
+
+	for _, p := range []Peer{dialed, accepted.peer} {
+		p.SetLogger(log.TestingLogger())
+		require.NoError(t, p.Start())
+		t.Cleanup(func() { _ = p.Stop() })
+	}
+
+	// The messages are delivered in both directions.
+	require.True(t, dialed.Send(Envelope{ChannelID: testCh, Message: &p2pproto.PexRequest{}}))
+	require.True(t, accepted.peer.Send(Envelope{ChannelID: testCh, Message: &p2pproto.PexRequest{}}))
+	require.Eventually(t, func() bool {
+		return len(serverReactor.getMsgs(testCh)) == 1 && len(dialerReactor.getMsgs(testCh)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, &p2pproto.PexRequest{}, serverReactor.getMsgs(testCh)[0].Contents)
+
+	// Unknown channels are not sent.
+	assert.False(t, dialed.Send(Envelope{ChannelID: 0x42, Message: &p2pproto.PexRequest{}}))
+
+	status := dialed.Status()
+	require.Len(t, status.Channels, 1)
+	assert.EqualValues(t, testCh, status.Channels[0].ID)
+}
+
+func TestTransportQUICFlushStop(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := newTestQUICTransport(t, "dialer")
+
+	acceptedc := make(chan Peer)
+	serverCfg, serverReactor := testQUICPeerConfig()
+	go func() {
+		p, err := qt.Accept(serverCfg)
+		if err != nil {
+			t.Error(err)
+		}
+		acceptedc <- p
+	}()
+
+	dialerCfg, _ := testQUICPeerConfig()
+	dialed, err := dialer.Dial(qt.NetAddress(), dialerCfg)
+	require.NoError(t, err)
+	accepted := <-acceptedc
+	require.NoError(t, accepted.Start())
+	t.Cleanup(func() { _ = accepted.Stop() })
+	require.NoError(t, dialed.Start())
+
+	const numMsgs = 10
+	for i := 0; i < numMsgs; i++ {
+		require.True(t, dialed.Send(Envelope{ChannelID: testCh, Message: &p2pproto.PexRequest{}}))
+	}
+	dialed.FlushStop()
+
+	require.Eventually(t, func() bool {
+		return len(serverReactor.getMsgs(testCh)) == numMsgs
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTransportQUICRateLimit(t *testing.T) {
+	const rate = 20000 // bytes per second
+
+	addrs := make([]p2pproto.NetAddress, 50)
+	for i := range addrs {
+		addrs[i] = p2pproto.NetAddress{ID: string(PubKeyToID(ed25519.GenPrivKey().PubKey())), IP: "127.0.0.1", Port: uint32(i)}
+	}
+	msg := &p2pproto.PexAddrs{Addrs: addrs}
+	const numMsgs = 10
+	total := numMsgs * proto.Size(&p2pproto.Message{Sum: &p2pproto.Message_PexAddrs{PexAddrs: msg}})
+
+	testCases := map[string]struct {
+		dialerSendRate, serverRecvRate int64
+	}{
+		"send": {dialerSendRate: rate},
+		"recv": {serverRecvRate: rate},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			serverConfig, dialerConfig := conn.DefaultMConnConfig(), conn.DefaultMConnConfig()
+			if tc.dialerSendRate > 0 {
+				dialerConfig.SendRate = tc.dialerSendRate
+			}
+			if tc.serverRecvRate > 0 {
+				serverConfig.RecvRate = tc.serverRecvRate
+			}
+			qt := newTestQUICTransportWithConfig(t, "transport", serverConfig)
+			addr, err := NewNetAddressString(IDAddressString(qt.nodeInfo.ID(), "127.0.0.1:0"))
+			require.NoError(t, err)
+			require.NoError(t, qt.Listen(*addr))
+			t.Cleanup(func() { _ = qt.Close() })
+			qt.netAddr = *NewNetAddress(qt.nodeInfo.ID(), qt.listener.Addr())
+			dialer := newTestQUICTransportWithConfig(t, "dialer", dialerConfig)
+
+			acceptedc := make(chan Peer)
+			serverCfg, serverReactor := testQUICPeerConfig()
+			go func() {
+				p, err := qt.Accept(serverCfg)
+				if err != nil {
+					t.Error(err)
+				}
+				acceptedc <- p
+			}()
+			dialerCfg, _ := testQUICPeerConfig()
+			dialed, err := dialer.Dial(qt.NetAddress(), dialerCfg)
+			require.NoError(t, err)
+			accepted := <-acceptedc
+			for _, p := range []Peer{dialed, accepted} {
+				require.NoError(t, p.Start())
+				t.Cleanup(func() { _ = p.Stop() })
+			}
+
+			start := time.Now()
+			for i := 0; i < numMsgs; i++ {
+				require.True(t, dialed.Send(Envelope{ChannelID: testCh, Message: msg}))
+			}
+			require.Eventually(t, func() bool {
+				return len(serverReactor.getMsgs(testCh)) == numMsgs
+			}, 10*time.Second, 10*time.Millisecond)
+
+			// The first sample of the monitor is not throttled.
+			assert.Greater(t, time.Since(start), time.Duration(total-rate/10)*time.Second/rate)
+		})
+	}
+}
+
+func TestTransportQUICDialRejectWrongID(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := newTestQUICTransport(t, "dialer")
+
+	wrongID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	addr := NewNetAddress(wrongID, qt.listener.Addr())
+
+	_, err := dialer.Dial(*addr, peerConfig{})
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsAuthFailure())
+}
+
+func TestTransportQUICRejectIncompatible(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+
+	pv := ed25519.GenPrivKey()
+	dialer, err := NewQUICTransport(
+		testNodeInfoWithNetwork(PubKeyToID(pv.PubKey()), "dialer", "incompatible-network"),
+		NodeKey{PrivKey: pv},
+		conn.DefaultMConnConfig(),
+	)
+	require.NoError(t, err)
+
+	_, err = dialer.Dial(qt.NetAddress(), peerConfig{})
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsIncompatible())
+}
+
+func TestTransportHybridDialFallback(t *testing.T) {
+	// The remote node only listens over TCP.
+	mt := testSetupMultiplexTransport(t)
+	t.Cleanup(func() { _ = mt.Close() })
+	acceptedc := make(chan error)
+	go func() {
+		_, err := mt.Accept(peerConfig{})
+		acceptedc <- err
+	}()
+
+	pv := ed25519.GenPrivKey()
+	nodeInfo := testNodeInfo(PubKeyToID(pv.PubKey()), "dialer")
+	tcp := newMultiplexTransport(nodeInfo, NodeKey{PrivKey: pv})
+	quic, err := NewQUICTransport(nodeInfo, NodeKey{PrivKey: pv}, conn.DefaultMConnConfig())
+	require.NoError(t, err)
+	quic.dialTimeout = 100 * time.Millisecond
+	ht := NewHybridTransport(tcp, quic)
+
+	addr := NewNetAddress(mt.nodeInfo.ID(), mt.listener.Addr())
+	p, err := ht.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	require.NoError(t, <-acceptedc)
+	_, isQUIC := p.(*quicPeer)
+	assert.False(t, isQUIC)
+}
@@ -0,0 +1,410 @@
+package privval
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	defaultFailoverHealthCheckInterval = time.Second
+	defaultFailoverRetries             = 50 // 50 * 100ms = 5s total
+	defaultFailoverRetryTimeout        = 100 * time.Millisecond
+)
+
+// ErrNoSigners is returned when a FailoverSignerClient is created without
+// signers.
+var ErrNoSigners = errors.New("no signers")
+
+// FailoverSignerClient implements PrivValidator with several remote signers
+// holding the same key, in active/standby: the requests are sent to the active
+// signer, and to the next healthy one if the active signer fails. The health
+// of the signers is probed in the background with ping requests.
+//
+// The signers do not share their last sign state, so the client keeps its own
+// across all of them: it refuses to request a signature for a lower height,
+// round or step than the last one, or for different data at the same height,
+// round and step. The state is persisted before each request, so that a
+// restarted node does not request a conflicting signature from another signer
+// either.
+type FailoverSignerClient struct {
+	service.BaseService
+
+	// mtx serializes the requests, and guards the fields below
+	mtx     cmtsync.Mutex
+	signers []*SignerClient
+	healthy []bool
+	active  int
+	pubKey  crypto.PubKey
+	state   FailoverSignState
+
+	healthCheckInterval time.Duration
+	retries             int
+	retryTimeout        time.Duration
+}
+
+var _ types.PrivValidator = (*FailoverSignerClient)(nil)
+
+// FailoverSignerClientOption sets an optional parameter on the
+// FailoverSignerClient.
+type FailoverSignerClientOption func(*FailoverSignerClient)
+
+// FailoverSignerClientHealthCheckInterval sets how often the signers are
+// pinged.
+func FailoverSignerClientHealthCheckInterval(interval time.Duration) FailoverSignerClientOption {
+	return func(sc *FailoverSignerClient) { sc.healthCheckInterval = interval }
+}
+
+// FailoverSignerClientRetries sets how many times all the signers are tried
+// before a request fails, and the time waited between the attempts. If
+// retries is 0, the requests are retried indefinitely.
+func FailoverSignerClientRetries(retries int, timeout time.Duration) FailoverSignerClientOption {
+	return func(sc *FailoverSignerClient) {
+		sc.retries = retries
+		sc.retryTimeout = timeout
+	}
+}
+
+// NewFailoverSignerClient returns a FailoverSignerClient of the given
+// signers, the first one being active, whose last sign state is persisted
+// to stateFilePath. The state is loaded from the file if it exists.
+func NewFailoverSignerClient(
+	signers []*SignerClient,
+	stateFilePath string,
+	logger log.Logger,
+	options ...FailoverSignerClientOption,
+) (*FailoverSignerClient, error) {
+	if len(signers) == 0 {
+		return nil, ErrNoSigners
+	}
+	state, err := loadFailoverSignState(stateFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &FailoverSignerClient{
+		signers:             signers,
+		healthy:             make([]bool, len(signers)),
+		state:               state,
+		healthCheckInterval: defaultFailoverHealthCheckInterval,
+		retries:             defaultFailoverRetries,
+		retryTimeout:        defaultFailoverRetryTimeout,
+	}
+	for i := range sc.healthy {
+		sc.healthy[i] = true
+	}
+	for _, option := range options {
+		option(sc)
+	}
+	sc.BaseService = *service.NewBaseService(logger, "FailoverSignerClient", sc)
+	return sc, nil
+}
+
+// OnStart implements service.Service.
+func (sc *FailoverSignerClient) OnStart() error {
+	go sc.healthCheckRoutine()
+	return nil
+}
+
+// OnStop implements service.Service. It closes the connections to the
+// signers.
+func (sc *FailoverSignerClient) OnStop() {
+	for i, signer := range sc.signers {
+		if err := signer.Close(); err != nil {
+			sc.Logger.Error("Error closing signer", "signer", i, "err", err)
+		}
+	}
+}
+
+// ActiveSigner returns the index of the active signer.
+func (sc *FailoverSignerClient) ActiveSigner() int {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+	return sc.active
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey retrieves the public key from the signers. The key of the first
+// signer to respond is cached, and the signers with another key are never
+// used.
+func (sc *FailoverSignerClient) GetPubKey() (crypto.PubKey, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if sc.pubKey != nil {
+		return sc.pubKey, nil
+	}
+	err := sc.request("get pubkey", func(signer *SignerClient) error {
+		pubKey, err := signer.GetPubKey()
+		if err != nil {
+			return err
+		}
+		sc.pubKey = pubKey
+		return nil
+	})
+	return sc.pubKey, err
+}
+
+// SignVote requests the signers to sign a vote, unless it conflicts with the
+// last signed vote or proposal.
+func (sc *FailoverSignerClient) SignVote(chainID string, vote *cmtproto.Vote) error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	sameHRS, err := sc.state.checkHRS(vote.Height, vote.Round, voteToStep(vote))
+	if err != nil {
+		return err
+	}
+	signBytes := types.VoteSignBytes(chainID, vote)
+	if sameHRS && !bytes.Equal(signBytes, sc.state.SignBytes) {
+		// Use the timestamp of the vote requested before, like FilePV.
+		timestamp, ok := checkVotesOnlyDifferByTimestamp(sc.state.SignBytes, signBytes)
+		if !ok {
+			return errors.New("conflicting data")
+		}
+		vote.Timestamp = timestamp
+		signBytes = types.VoteSignBytes(chainID, vote)
+	}
+	if err := sc.state.save(vote.Height, vote.Round, voteToStep(vote), signBytes); err != nil {
+		return err
+	}
+
+	return sc.request("sign vote", func(signer *SignerClient) error {
+		signed := *vote
+		if err := signer.SignVote(chainID, &signed); err != nil {
+			return err
+		}
+		if !bytes.Equal(types.VoteSignBytes(chainID, &signed), signBytes) {
+			return &RemoteSignerError{Description: "signer returned a different vote"}
+		}
+		*vote = signed
+		return nil
+	})
+}
+
+// SignProposal requests the signers to sign a proposal, unless it conflicts
+// with the last signed vote or proposal.
+func (sc *FailoverSignerClient) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	sameHRS, err := sc.state.checkHRS(proposal.Height, proposal.Round, stepPropose)
+	if err != nil {
+		return err
+	}
+	signBytes := types.ProposalSignBytes(chainID, proposal)
+	if sameHRS && !bytes.Equal(signBytes, sc.state.SignBytes) {
+		// Use the timestamp of the proposal requested before, like FilePV.
+		timestamp, ok := checkProposalsOnlyDifferByTimestamp(sc.state.SignBytes, signBytes)
+		if !ok {
+			return errors.New("conflicting data")
+		}
+		proposal.Timestamp = timestamp
+		signBytes = types.ProposalSignBytes(chainID, proposal)
+	}
+	if err := sc.state.save(proposal.Height, proposal.Round, stepPropose, signBytes); err != nil {
+		return err
+	}
+
+	return sc.request("sign proposal", func(signer *SignerClient) error {
+		signed := *proposal
+		if err := signer.SignProposal(chainID, &signed); err != nil {
+			return err
+		}
+		if !bytes.Equal(types.ProposalSignBytes(chainID, &signed), signBytes) {
+			return &RemoteSignerError{Description: "signer returned a different proposal"}
+		}
+		*proposal = signed
+		return nil
+	})
+}
+
+// request sends a request to the active signer, and fails over to the other
+// signers, healthy ones first, if it fails. The errors returned by the signers
+// themselves are not retried, since another signer would refuse the request
+// as well. The caller must hold mtx.
+func (sc *FailoverSignerClient) request(name string, send func(*SignerClient) error) error {
+	var err error
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		for _, idx := range sc.failoverOrder() {
+			err = send(sc.signers[idx])
+			if err == nil {
+				if idx != sc.active {
+					sc.Logger.Info("Failed over to signer", "signer", idx, "previous", sc.active)
+					sc.active = idx
+				}
+				sc.healthy[idx] = true
+				return nil
+			}
+			if _, ok := err.(*RemoteSignerError); ok {
+				return err
+			}
+			sc.Logger.Error("Signer request failed", "request", name, "signer", idx, "err", err)
+			sc.healthy[idx] = false
+		}
+		time.Sleep(sc.retryTimeout)
+	}
+	return fmt.Errorf("exhausted all attempts to %s: %w", name, err)
+}
+
+// failoverOrder returns the indexes of the signers in the order in which they
+// are tried: the active one, the other healthy ones, then the unhealthy ones.
+func (sc *FailoverSignerClient) failoverOrder() []int {
+	order := make([]int, 0, len(sc.signers))
+	for _, wantHealthy := range []bool{true, false} {
+		for i := range sc.signers {
+			idx := (sc.active + i) % len(sc.signers)
+			if sc.healthy[idx] == wantHealthy {
+				order = append(order, idx)
+			}
+		}
+	}
+	return order
+}
+
+func (sc *FailoverSignerClient) healthCheckRoutine() {
+	ticker := time.NewTicker(sc.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sc.checkHealth()
+		case <-sc.Quit():
+			return
+		}
+	}
+}
+
+// checkHealth pings the signers and verifies their public keys. If the active
+// signer is unhealthy, the first healthy signer becomes active.
+func (sc *FailoverSignerClient) checkHealth() {
+	healthy := make([]bool, len(sc.signers))
+	for i, signer := range sc.signers {
+		// The requests are not blocked by the pings, which may time out.
+		err := signer.Ping()
+		if err == nil {
+			err = sc.checkPubKey(signer)
+		}
+		if err != nil {
+			sc.Logger.Debug("Signer is unhealthy", "signer", i, "err", err)
+			continue
+		}
+		healthy[i] = true
+	}
+
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+	copy(sc.healthy, healthy)
+	if sc.healthy[sc.active] {
+		return
+	}
+	for i, ok := range sc.healthy {
+		if ok {
+			sc.Logger.Info("Failed over to signer", "signer", i, "previous", sc.active)
+			sc.active = i
+			return
+		}
+	}
+}
+
+// checkPubKey returns an error if the signer has another key than the one
+// cached by GetPubKey.
+func (sc *FailoverSignerClient) checkPubKey(signer *SignerClient) error {
+	sc.mtx.Lock()
+	expected := sc.pubKey
+	sc.mtx.Unlock()
+	if expected == nil {
+		return nil
+	}
+
+	pubKey, err := signer.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !pubKey.Equals(expected) {
+		return fmt.Errorf("signer has public key %X, expected %X", pubKey.Bytes(), expected.Bytes())
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------
+
+// FailoverSignState is the state of the last signature requested by a
+// FailoverSignerClient, from any of its signers.
+type FailoverSignState struct {
+	Height    int64             `json:"height"`
+	Round     int32             `json:"round"`
+	Step      int8              `json:"step"`
+	SignBytes cmtbytes.HexBytes `json:"signbytes,omitempty"`
+
+	filePath string
+}
+
+func loadFailoverSignState(filePath string) (FailoverSignState, error) {
+	state := FailoverSignState{filePath: filePath}
+	jsonBytes, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	} else if err != nil {
+		return state, err
+	}
+	if err := cmtjson.Unmarshal(jsonBytes, &state); err != nil {
+		return state, fmt.Errorf("error reading signer failover state from %v: %w", filePath, err)
+	}
+	return state, nil
+}
+
+// checkHRS returns an error if the given height, round and step are a
+// regression, and whether they are the ones of the last signature.
+func (s *FailoverSignState) checkHRS(height int64, round int32, step int8) (bool, error) {
+	switch {
+	case s.Height > height:
+		return false, fmt.Errorf("height regression. Got %v, last height %v", height, s.Height)
+	case s.Height == height && s.Round > round:
+		return false, fmt.Errorf("round regression at height %v. Got %v, last round %v", height, round, s.Round)
+	case s.Height == height && s.Round == round && s.Step > step:
+		return false, fmt.Errorf(
+			"step regression at height %v round %v. Got %v, last step %v",
+			height,
+			round,
+			step,
+			s.Step,
+		)
+	}
+	return s.Height == height && s.Round == round && s.Step == step && len(s.SignBytes) > 0, nil
+}
+
+// save persists the state of a signature about to be requested.
+func (s *FailoverSignState) save(height int64, round int32, step int8, signBytes []byte) error {
+	next := FailoverSignState{
+		Height:    height,
+		Round:     round,
+		Step:      step,
+		SignBytes: signBytes,
+		filePath:  s.filePath,
+	}
+	jsonBytes, err := cmtjson.MarshalIndent(next, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(s.filePath, jsonBytes, 0600); err != nil {
+		return err
+	}
+	*s = next
+	return nil
+}
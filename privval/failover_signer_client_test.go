@@ -0,0 +1,152 @@
+package privval
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newFailoverTestSigners returns n signer clients connected over TCP to
+// signer servers holding privKey.
+func newFailoverTestSigners(
+	t *testing.T,
+	chainID string,
+	privKey crypto.PrivKey,
+	n int,
+) ([]*SignerClient, []*SignerServer) {
+	t.Helper()
+
+	clients := make([]*SignerClient, n)
+	servers := make([]*SignerServer, n)
+	for i := 0; i < n; i++ {
+		addr := GetFreeLocalhostAddrPort()
+		sl, sd := getMockEndpoints(t, addr, DialTCPFn(addr, testTimeoutReadWrite, ed25519.GenPrivKey()))
+		sc, err := NewSignerClient(sl, chainID)
+		require.NoError(t, err)
+		ss := NewSignerServer(sd, chainID, types.NewMockPVWithParams(privKey, false, false))
+		require.NoError(t, ss.Start())
+		t.Cleanup(func() {
+			if ss.IsRunning() {
+				_ = ss.Stop()
+			}
+		})
+
+		clients[i], servers[i] = sc, ss
+	}
+	return clients, servers
+}
+
+func newFailoverTestVote(height int64, round int32, voteType cmtproto.SignedMsgType) *cmtproto.Vote {
+	hash := cmtrand.Bytes(tmhash.Size)
+	vote := &types.Vote{
+		Type:             voteType,
+		Height:           height,
+		Round:            round,
+		BlockID:          types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+		Timestamp:        time.Now(),
+		ValidatorAddress: cmtrand.Bytes(crypto.AddressSize),
+		ValidatorIndex:   1,
+	}
+	return vote.ToProto()
+}
+
+func TestFailoverSignerClientFailover(t *testing.T) {
+	var (
+		chainID = cmtrand.Str(12)
+		privKey = ed25519.GenPrivKey()
+	)
+	signers, servers := newFailoverTestSigners(t, chainID, privKey, 2)
+
+	sc, err := NewFailoverSignerClient(signers, filepath.Join(t.TempDir(), "state.json"), log.TestingLogger(),
+		FailoverSignerClientRetries(2, 10*time.Millisecond))
+	require.NoError(t, err)
+	require.NoError(t, sc.Start())
+	t.Cleanup(func() { _ = sc.Stop() })
+
+	pubKey, err := sc.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	vote := newFailoverTestVote(1, 0, cmtproto.PrevoteType)
+	require.NoError(t, sc.SignVote(chainID, vote))
+	assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+	assert.Equal(t, 0, sc.ActiveSigner())
+
+	// The requests fail over to the standby signer when the active one dies.
+	require.NoError(t, servers[0].Stop())
+	vote = newFailoverTestVote(1, 0, cmtproto.PrecommitType)
+	require.NoError(t, sc.SignVote(chainID, vote))
+	assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+	assert.Equal(t, 1, sc.ActiveSigner())
+}
+
+func TestFailoverSignerClientSignState(t *testing.T) {
+	var (
+		chainID   = cmtrand.Str(12)
+		privKey   = ed25519.GenPrivKey()
+		stateFile = filepath.Join(t.TempDir(), "state.json")
+	)
+	signers, _ := newFailoverTestSigners(t, chainID, privKey, 1)
+
+	sc, err := NewFailoverSignerClient(signers, stateFile, log.TestingLogger())
+	require.NoError(t, err)
+
+	vote := newFailoverTestVote(2, 1, cmtproto.PrevoteType)
+	require.NoError(t, sc.SignVote(chainID, vote))
+
+	// The same vote with another timestamp gets the timestamp of the first one.
+	sameVote := *vote
+	sameVote.Timestamp = vote.Timestamp.Add(time.Second)
+	sameVote.Signature = nil
+	require.NoError(t, sc.SignVote(chainID, &sameVote))
+	assert.Equal(t, vote.Timestamp, sameVote.Timestamp)
+	assert.Equal(t, vote.Signature, sameVote.Signature)
+
+	// Conflicting votes and regressions are refused.
+	conflicting := newFailoverTestVote(2, 1, cmtproto.PrevoteType)
+	assert.ErrorContains(t, sc.SignVote(chainID, conflicting), "conflicting data")
+	assert.ErrorContains(t, sc.SignVote(chainID, newFailoverTestVote(2, 0, cmtproto.PrecommitType)), "round regression")
+	assert.ErrorContains(t, sc.SignVote(chainID, newFailoverTestVote(1, 3, cmtproto.PrecommitType)), "height regression")
+
+	// The state is persisted, so it is kept by another client.
+	sc2, err := NewFailoverSignerClient(signers, stateFile, log.TestingLogger())
+	require.NoError(t, err)
+	assert.ErrorContains(t, sc2.SignVote(chainID, conflicting), "conflicting data")
+	require.NoError(t, sc2.SignProposal(chainID, &cmtproto.Proposal{
+		Type:      cmtproto.ProposalType,
+		Height:    3,
+		Timestamp: time.Now(),
+	}))
+}
+
+func TestFailoverSignerClientCheckPubKey(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	signers, _ := newFailoverTestSigners(t, chainID, ed25519.GenPrivKey(), 1)
+	otherSigners, _ := newFailoverTestSigners(t, chainID, ed25519.GenPrivKey(), 1)
+
+	sc, err := NewFailoverSignerClient(append(signers, otherSigners...), filepath.Join(t.TempDir(), "state.json"),
+		log.TestingLogger())
+	require.NoError(t, err)
+	_, err = sc.GetPubKey()
+	require.NoError(t, err)
+
+	// The signer holding another key is unhealthy, so it never becomes active.
+	sc.checkHealth()
+	assert.Equal(t, []bool{true, false}, sc.healthy)
+}
+
+func TestFailoverSignerClientNoSigners(t *testing.T) {
+	_, err := NewFailoverSignerClient(nil, filepath.Join(t.TempDir(), "state.json"), log.TestingLogger())
+	assert.Equal(t, ErrNoSigners, err)
+}
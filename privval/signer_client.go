@@ -57,12 +57,12 @@ func (sc *SignerClient) Ping() error {
 	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.PingRequest{}))
 	if err != nil {
 		sc.endpoint.Logger.Error("SignerClient::Ping", "err", err)
-		return nil
+		return err
 	}
 
 	pb := response.GetPingResponse()
 	if pb == nil {
-		return err
+		return cmterrors.ErrRequiredField{Field: "response"}
 	}
 
 	return nil
@@ -0,0 +1,1057 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/mempool/wal.proto
+
+package mempool
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddedTx records a transaction that was added to the mempool.
+type AddedTx struct {
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *AddedTx) Reset()         { *m = AddedTx{} }
+func (m *AddedTx) String() string { return proto.CompactTextString(m) }
+func (*AddedTx) ProtoMessage()    {}
+func (*AddedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09de78accaf12d29, []int{0}
+}
+func (m *AddedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddedTx.Merge(m, src)
+}
+func (m *AddedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddedTx proto.InternalMessageInfo
+
+func (m *AddedTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// RemovedTx records the key of a transaction that was removed from the
+// mempool, either because it was committed, it became invalid or it was
+// evicted.
+type RemovedTx struct {
+	TxKey []byte `protobuf:"bytes,1,opt,name=tx_key,json=txKey,proto3" json:"tx_key,omitempty"`
+}
+
+func (m *RemovedTx) Reset()         { *m = RemovedTx{} }
+func (m *RemovedTx) String() string { return proto.CompactTextString(m) }
+func (*RemovedTx) ProtoMessage()    {}
+func (*RemovedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09de78accaf12d29, []int{1}
+}
+func (m *RemovedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovedTx.Merge(m, src)
+}
+func (m *RemovedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovedTx proto.InternalMessageInfo
+
+func (m *RemovedTx) GetTxKey() []byte {
+	if m != nil {
+		return m.TxKey
+	}
+	return nil
+}
+
+type WALMessage struct {
+	// Types that are valid to be assigned to Sum:
+	//
+	//	*WALMessage_AddedTx
+	//	*WALMessage_RemovedTx
+	Sum isWALMessage_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *WALMessage) Reset()         { *m = WALMessage{} }
+func (m *WALMessage) String() string { return proto.CompactTextString(m) }
+func (*WALMessage) ProtoMessage()    {}
+func (*WALMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09de78accaf12d29, []int{2}
+}
+func (m *WALMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WALMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WALMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WALMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WALMessage.Merge(m, src)
+}
+func (m *WALMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *WALMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WALMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WALMessage proto.InternalMessageInfo
+
+type isWALMessage_Sum interface {
+	isWALMessage_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type WALMessage_AddedTx struct {
+	AddedTx *AddedTx `protobuf:"bytes,1,opt,name=added_tx,json=addedTx,proto3,oneof" json:"added_tx,omitempty"`
+}
+type WALMessage_RemovedTx struct {
+	RemovedTx *RemovedTx `protobuf:"bytes,2,opt,name=removed_tx,json=removedTx,proto3,oneof" json:"removed_tx,omitempty"`
+}
+
+func (*WALMessage_AddedTx) isWALMessage_Sum()   {}
+func (*WALMessage_RemovedTx) isWALMessage_Sum() {}
+
+func (m *WALMessage) GetSum() isWALMessage_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *WALMessage) GetAddedTx() *AddedTx {
+	if x, ok := m.GetSum().(*WALMessage_AddedTx); ok {
+		return x.AddedTx
+	}
+	return nil
+}
+
+func (m *WALMessage) GetRemovedTx() *RemovedTx {
+	if x, ok := m.GetSum().(*WALMessage_RemovedTx); ok {
+		return x.RemovedTx
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WALMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WALMessage_AddedTx)(nil),
+		(*WALMessage_RemovedTx)(nil),
+	}
+}
+
+// TimedWALMessage wraps WALMessage and adds Time for debugging purposes.
+type TimedWALMessage struct {
+	Time time.Time   `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	Msg  *WALMessage `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *TimedWALMessage) Reset()         { *m = TimedWALMessage{} }
+func (m *TimedWALMessage) String() string { return proto.CompactTextString(m) }
+func (*TimedWALMessage) ProtoMessage()    {}
+func (*TimedWALMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09de78accaf12d29, []int{3}
+}
+func (m *TimedWALMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedWALMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedWALMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimedWALMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedWALMessage.Merge(m, src)
+}
+func (m *TimedWALMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedWALMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedWALMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedWALMessage proto.InternalMessageInfo
+
+func (m *TimedWALMessage) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TimedWALMessage) GetMsg() *WALMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddedTx)(nil), "tendermint.mempool.AddedTx")
+	proto.RegisterType((*RemovedTx)(nil), "tendermint.mempool.RemovedTx")
+	proto.RegisterType((*WALMessage)(nil), "tendermint.mempool.WALMessage")
+	proto.RegisterType((*TimedWALMessage)(nil), "tendermint.mempool.TimedWALMessage")
+}
+
+func init() { proto.RegisterFile("tendermint/mempool/wal.proto", fileDescriptor_09de78accaf12d29) }
+
+var fileDescriptor_09de78accaf12d29 = []byte{
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x4f, 0x4b, 0xfb, 0x40,
+	0x14, 0xcc, 0xb6, 0xbf, 0xfe, 0x7b, 0x3f, 0x51, 0x08, 0x0a, 0x5a, 0x75, 0x2b, 0x39, 0x79, 0xda,
+	0x88, 0x22, 0x78, 0x12, 0xda, 0x53, 0x41, 0x45, 0x08, 0x05, 0xc1, 0x4b, 0x49, 0x9a, 0xd7, 0x35,
+	0xd8, 0xed, 0x96, 0x64, 0xab, 0xe9, 0xc1, 0x8f, 0x20, 0xf4, 0x63, 0xf5, 0xd8, 0xa3, 0x27, 0x95,
+	0xf6, 0x8b, 0x48, 0x92, 0xad, 0x41, 0xda, 0xdb, 0x3e, 0x66, 0xe6, 0xcd, 0xec, 0x3c, 0x38, 0x52,
+	0x38, 0xf4, 0x31, 0x14, 0xc1, 0x50, 0xd9, 0x02, 0xc5, 0x48, 0xca, 0x81, 0xfd, 0xea, 0x0e, 0xd8,
+	0x28, 0x94, 0x4a, 0x9a, 0x66, 0x8e, 0x32, 0x8d, 0xd6, 0x77, 0xb9, 0xe4, 0x32, 0x85, 0xed, 0xe4,
+	0x95, 0x31, 0xeb, 0x0d, 0x2e, 0x25, 0x1f, 0xa0, 0x9d, 0x4e, 0xde, 0xb8, 0x6f, 0xab, 0x40, 0x60,
+	0xa4, 0x5c, 0x31, 0xca, 0x08, 0xd6, 0x01, 0x54, 0x9a, 0xbe, 0x8f, 0x7e, 0x27, 0x36, 0xb7, 0xa1,
+	0xa0, 0xe2, 0x7d, 0x72, 0x42, 0x4e, 0xb7, 0x9c, 0x82, 0x8a, 0x2d, 0x0b, 0x6a, 0x0e, 0x0a, 0xf9,
+	0x92, 0x82, 0x7b, 0x50, 0x56, 0x71, 0xf7, 0x19, 0x27, 0x9a, 0x50, 0x52, 0xf1, 0x0d, 0x4e, 0xac,
+	0x77, 0x02, 0xf0, 0xd0, 0xbc, 0xbd, 0xc3, 0x28, 0x72, 0x39, 0x9a, 0x57, 0x50, 0x75, 0x93, 0x6d,
+	0x5d, 0xbd, 0xe8, 0xff, 0xf9, 0x21, 0x5b, 0xcf, 0xca, 0xb4, 0x63, 0xdb, 0x70, 0x2a, 0xae, 0x36,
+	0xbf, 0x06, 0x08, 0x33, 0xb3, 0x44, 0x5b, 0x48, 0xb5, 0xc7, 0x9b, 0xb4, 0xbf, 0x91, 0xda, 0x86,
+	0x53, 0x0b, 0x57, 0x43, 0xab, 0x04, 0xc5, 0x68, 0x2c, 0xac, 0x37, 0xd8, 0xe9, 0x04, 0x02, 0xfd,
+	0x3f, 0x99, 0xfe, 0x25, 0x9f, 0xd6, 0x79, 0xea, 0x2c, 0x6b, 0x84, 0xad, 0x1a, 0x61, 0x9d, 0x55,
+	0x23, 0xad, 0xea, 0xec, 0xb3, 0x61, 0x4c, 0xbf, 0x1a, 0xc4, 0x49, 0x15, 0xe6, 0x19, 0x14, 0x45,
+	0xc4, 0x75, 0x18, 0xba, 0x29, 0x4c, 0x6e, 0xe3, 0x24, 0xd4, 0xd6, 0xfd, 0x6c, 0x41, 0xc9, 0x7c,
+	0x41, 0xc9, 0xf7, 0x82, 0x92, 0xe9, 0x92, 0x1a, 0xf3, 0x25, 0x35, 0x3e, 0x96, 0xd4, 0x78, 0xbc,
+	0xe4, 0x81, 0x7a, 0x1a, 0x7b, 0xac, 0x27, 0x85, 0xdd, 0x93, 0x02, 0x95, 0xd7, 0x57, 0xf9, 0x23,
+	0xbb, 0xdb, 0xfa, 0xcd, 0xbd, 0x72, 0x8a, 0x5c, 0xfc, 0x0c, 0x00, 0x44, 0xad, 0xa7, 0xf0, 0x10,
+	0x02, 0x00, 0x00,
+}
+
+func (m *AddedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintWal(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxKey) > 0 {
+		i -= len(m.TxKey)
+		copy(dAtA[i:], m.TxKey)
+		i = encodeVarintWal(dAtA, i, uint64(len(m.TxKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WALMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WALMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WALMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WALMessage_AddedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WALMessage_AddedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddedTx != nil {
+		{
+			size, err := m.AddedTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WALMessage_RemovedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WALMessage_RemovedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemovedTx != nil {
+		{
+			size, err := m.RemovedTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TimedWALMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedWALMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedWALMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintWal(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovWal(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxKey)
+	if l > 0 {
+		n += 1 + l + sovWal(uint64(l))
+	}
+	return n
+}
+
+func (m *WALMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *WALMessage_AddedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddedTx != nil {
+		l = m.AddedTx.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	return n
+}
+func (m *WALMessage_RemovedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemovedTx != nil {
+		l = m.RemovedTx.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	return n
+}
+func (m *TimedWALMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovWal(uint64(l))
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	return n
+}
+
+func sovWal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWal(x uint64) (n int) {
+	return sovWal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKey = append(m.TxKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxKey == nil {
+				m.TxKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WALMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WALMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WALMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AddedTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &WALMessage_AddedTx{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RemovedTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &WALMessage_RemovedTx{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimedWALMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedWALMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedWALMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &WALMessage{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWal = fmt.Errorf("proto: unexpected end of group")
+)
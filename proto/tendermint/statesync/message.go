@@ -12,6 +12,10 @@ var _ p2p.Wrapper = &ChunkRequest{}
 var _ p2p.Wrapper = &ChunkResponse{}
 var _ p2p.Wrapper = &SnapshotsRequest{}
 var _ p2p.Wrapper = &SnapshotsResponse{}
+var _ p2p.Wrapper = &LightBlockRequest{}
+var _ p2p.Wrapper = &LightBlockResponse{}
+var _ p2p.Wrapper = &ParamsRequest{}
+var _ p2p.Wrapper = &ParamsResponse{}
 
 func (m *SnapshotsResponse) Wrap() proto.Message {
 	sm := &Message{}
@@ -37,6 +41,30 @@ func (m *ChunkRequest) Wrap() proto.Message {
 	return sm
 }
 
+func (m *LightBlockRequest) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_LightBlockRequest{LightBlockRequest: m}
+	return sm
+}
+
+func (m *LightBlockResponse) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_LightBlockResponse{LightBlockResponse: m}
+	return sm
+}
+
+func (m *ParamsRequest) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_ParamsRequest{ParamsRequest: m}
+	return sm
+}
+
+func (m *ParamsResponse) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_ParamsResponse{ParamsResponse: m}
+	return sm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped state sync
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -53,6 +81,18 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_SnapshotsResponse:
 		return m.GetSnapshotsResponse(), nil
 
+	case *Message_LightBlockRequest:
+		return m.GetLightBlockRequest(), nil
+
+	case *Message_LightBlockResponse:
+		return m.GetLightBlockResponse(), nil
+
+	case *Message_ParamsRequest:
+		return m.GetParamsRequest(), nil
+
+	case *Message_ParamsResponse:
+		return m.GetParamsResponse(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
@@ -0,0 +1,1572 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/store/archive.proto
+
+package store
+
+import (
+	fmt "fmt"
+	types1 "github.com/cometbft/cometbft/abci/types"
+	state "github.com/cometbft/cometbft/proto/tendermint/state"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ArchiveHeader is the first message of an archive of the blockchain data.
+type ArchiveHeader struct {
+	// version of the archive format.
+	Version     uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainID     string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	StartHeight int64  `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64  `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// state after the block at end_height was committed.
+	State *state.State `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *ArchiveHeader) Reset()         { *m = ArchiveHeader{} }
+func (m *ArchiveHeader) String() string { return proto.CompactTextString(m) }
+func (*ArchiveHeader) ProtoMessage()    {}
+func (*ArchiveHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65f463503f872c37, []int{0}
+}
+func (m *ArchiveHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveHeader.Merge(m, src)
+}
+func (m *ArchiveHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveHeader proto.InternalMessageInfo
+
+func (m *ArchiveHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ArchiveHeader) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetState() *state.State {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// ArchiveBlock holds a block and the data stored along with it.
+type ArchiveBlock struct {
+	Block      *types.Block  `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	SeenCommit *types.Commit `protobuf:"bytes,2,opt,name=seen_commit,json=seenCommit,proto3" json:"seen_commit,omitempty"`
+	// extended_commit is only set when vote extensions were enabled at the
+	// height of the block.
+	ExtendedCommit *types.ExtendedCommit `protobuf:"bytes,3,opt,name=extended_commit,json=extendedCommit,proto3" json:"extended_commit,omitempty"`
+	// finalize_block_response is not set when the node exporting the block did
+	// not persist it.
+	FinalizeBlockResponse *types1.ResponseFinalizeBlock `protobuf:"bytes,4,opt,name=finalize_block_response,json=finalizeBlockResponse,proto3" json:"finalize_block_response,omitempty"`
+	Validators            *types.ValidatorSet           `protobuf:"bytes,5,opt,name=validators,proto3" json:"validators,omitempty"`
+	ConsensusParams       *types.ConsensusParams        `protobuf:"bytes,6,opt,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty"`
+}
+
+func (m *ArchiveBlock) Reset()         { *m = ArchiveBlock{} }
+func (m *ArchiveBlock) String() string { return proto.CompactTextString(m) }
+func (*ArchiveBlock) ProtoMessage()    {}
+func (*ArchiveBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65f463503f872c37, []int{1}
+}
+func (m *ArchiveBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveBlock.Merge(m, src)
+}
+func (m *ArchiveBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveBlock proto.InternalMessageInfo
+
+func (m *ArchiveBlock) GetBlock() *types.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetSeenCommit() *types.Commit {
+	if m != nil {
+		return m.SeenCommit
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetExtendedCommit() *types.ExtendedCommit {
+	if m != nil {
+		return m.ExtendedCommit
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetFinalizeBlockResponse() *types1.ResponseFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlockResponse
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetValidators() *types.ValidatorSet {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetConsensusParams() *types.ConsensusParams {
+	if m != nil {
+		return m.ConsensusParams
+	}
+	return nil
+}
+
+// ArchiveChecksum is the last message of an archive. It holds the SHA-256
+// checksum of all the bytes preceding it.
+type ArchiveChecksum struct {
+	Sha256 []byte `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *ArchiveChecksum) Reset()         { *m = ArchiveChecksum{} }
+func (m *ArchiveChecksum) String() string { return proto.CompactTextString(m) }
+func (*ArchiveChecksum) ProtoMessage()    {}
+func (*ArchiveChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65f463503f872c37, []int{2}
+}
+func (m *ArchiveChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveChecksum.Merge(m, src)
+}
+func (m *ArchiveChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveChecksum proto.InternalMessageInfo
+
+func (m *ArchiveChecksum) GetSha256() []byte {
+	if m != nil {
+		return m.Sha256
+	}
+	return nil
+}
+
+// ArchiveMessage is a message of an archive, written in a length-delimited
+// stream.
+type ArchiveMessage struct {
+	// Types that are valid to be assigned to Sum:
+	//
+	//	*ArchiveMessage_Header
+	//	*ArchiveMessage_Block
+	//	*ArchiveMessage_Checksum
+	Sum isArchiveMessage_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *ArchiveMessage) Reset()         { *m = ArchiveMessage{} }
+func (m *ArchiveMessage) String() string { return proto.CompactTextString(m) }
+func (*ArchiveMessage) ProtoMessage()    {}
+func (*ArchiveMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65f463503f872c37, []int{3}
+}
+func (m *ArchiveMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveMessage.Merge(m, src)
+}
+func (m *ArchiveMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveMessage proto.InternalMessageInfo
+
+type isArchiveMessage_Sum interface {
+	isArchiveMessage_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ArchiveMessage_Header struct {
+	Header *ArchiveHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type ArchiveMessage_Block struct {
+	Block *ArchiveBlock `protobuf:"bytes,2,opt,name=block,proto3,oneof" json:"block,omitempty"`
+}
+type ArchiveMessage_Checksum struct {
+	Checksum *ArchiveChecksum `protobuf:"bytes,3,opt,name=checksum,proto3,oneof" json:"checksum,omitempty"`
+}
+
+func (*ArchiveMessage_Header) isArchiveMessage_Sum()   {}
+func (*ArchiveMessage_Block) isArchiveMessage_Sum()    {}
+func (*ArchiveMessage_Checksum) isArchiveMessage_Sum() {}
+
+func (m *ArchiveMessage) GetSum() isArchiveMessage_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *ArchiveMessage) GetHeader() *ArchiveHeader {
+	if x, ok := m.GetSum().(*ArchiveMessage_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *ArchiveMessage) GetBlock() *ArchiveBlock {
+	if x, ok := m.GetSum().(*ArchiveMessage_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (m *ArchiveMessage) GetChecksum() *ArchiveChecksum {
+	if x, ok := m.GetSum().(*ArchiveMessage_Checksum); ok {
+		return x.Checksum
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ArchiveMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ArchiveMessage_Header)(nil),
+		(*ArchiveMessage_Block)(nil),
+		(*ArchiveMessage_Checksum)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*ArchiveHeader)(nil), "tendermint.store.ArchiveHeader")
+	proto.RegisterType((*ArchiveBlock)(nil), "tendermint.store.ArchiveBlock")
+	proto.RegisterType((*ArchiveChecksum)(nil), "tendermint.store.ArchiveChecksum")
+	proto.RegisterType((*ArchiveMessage)(nil), "tendermint.store.ArchiveMessage")
+}
+
+func init() { proto.RegisterFile("tendermint/store/archive.proto", fileDescriptor_65f463503f872c37) }
+
+var fileDescriptor_65f463503f872c37 = []byte{
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0x6b, 0x9a, 0xd2, 0x75, 0xff, 0xb4, 0x02, 0x6a, 0x15, 0xea, 0xba, 0x39, 0x54, 0xe1,
+	0x80, 0x2d, 0xa5, 0xa2, 0x52, 0x2f, 0x20, 0x5a, 0x40, 0xa9, 0x44, 0x25, 0xb4, 0x95, 0x38, 0x70,
+	0xc0, 0xda, 0xd8, 0x93, 0x78, 0xd5, 0xd8, 0x1b, 0x79, 0x37, 0x11, 0xf0, 0x14, 0xbc, 0x0a, 0xaf,
+	0xc0, 0x09, 0x89, 0x4b, 0x8f, 0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0xbd, 0x6e, 0xe3, 0x9a, 0x5e,
+	0x92, 0x9d, 0xf9, 0xbe, 0x6f, 0xb2, 0xf3, 0xcd, 0x64, 0x91, 0x2b, 0x21, 0x8b, 0x21, 0x4f, 0x59,
+	0x26, 0x03, 0x21, 0x79, 0x0e, 0x01, 0xcd, 0xa3, 0x84, 0x4d, 0xc1, 0x1f, 0xe7, 0x5c, 0x72, 0xbc,
+	0x75, 0x83, 0xfb, 0x0a, 0xdf, 0x79, 0x30, 0xe4, 0x43, 0xae, 0xc0, 0xa0, 0x38, 0x95, 0xbc, 0x9d,
+	0xc7, 0x0b, 0x75, 0x68, 0x3f, 0x62, 0x81, 0xfc, 0x32, 0x06, 0xa1, 0xc1, 0x27, 0xb5, 0x1f, 0xa1,
+	0x12, 0xee, 0x44, 0x55, 0x3e, 0xe8, 0x8f, 0x78, 0x74, 0xa9, 0xd1, 0xdd, 0x06, 0x3a, 0xa6, 0x39,
+	0x4d, 0xef, 0x16, 0x2f, 0x96, 0xf6, 0x1a, 0xe8, 0x94, 0x8e, 0x58, 0x4c, 0x25, 0xcf, 0x4b, 0x46,
+	0xfb, 0x87, 0x89, 0xd6, 0x5f, 0x95, 0x1d, 0xf7, 0x80, 0xc6, 0x90, 0x63, 0x07, 0xad, 0x4c, 0x21,
+	0x17, 0x8c, 0x67, 0x8e, 0xe9, 0x99, 0x9d, 0x75, 0x52, 0x85, 0xf8, 0x00, 0xdd, 0x8f, 0x12, 0xca,
+	0xb2, 0x90, 0xc5, 0xce, 0x92, 0x67, 0x76, 0x56, 0x4f, 0xec, 0xd9, 0x9f, 0xbd, 0x95, 0xd3, 0x22,
+	0x77, 0xf6, 0x9a, 0xac, 0x28, 0xf0, 0x2c, 0xc6, 0xfb, 0x68, 0x4d, 0x48, 0x9a, 0xcb, 0x30, 0x01,
+	0x36, 0x4c, 0xa4, 0x63, 0x79, 0x66, 0xc7, 0x22, 0xb6, 0xca, 0xf5, 0x54, 0x0a, 0xef, 0x22, 0x04,
+	0x59, 0x5c, 0x11, 0xee, 0x29, 0xc2, 0x2a, 0x64, 0xb1, 0x86, 0x9f, 0xa1, 0x65, 0xe5, 0x93, 0xb3,
+	0xec, 0x99, 0x1d, 0xbb, 0xbb, 0xed, 0xd7, 0xa6, 0x40, 0x25, 0xf8, 0x17, 0xc5, 0x27, 0x29, 0x59,
+	0xed, 0xef, 0x16, 0x5a, 0xd3, 0x4d, 0x9c, 0x14, 0xd6, 0x15, 0x7a, 0xe5, 0xa1, 0x63, 0x36, 0xf5,
+	0xa5, 0x3f, 0x8a, 0x47, 0x4a, 0x16, 0x3e, 0x46, 0xb6, 0x00, 0xc8, 0xc2, 0x88, 0xa7, 0x29, 0x93,
+	0xaa, 0x37, 0xbb, 0xeb, 0x34, 0x45, 0xa7, 0x0a, 0x27, 0xa8, 0x20, 0x97, 0x67, 0x7c, 0x86, 0x36,
+	0xe1, 0xb3, 0x22, 0xc6, 0x95, 0xdc, 0x52, 0x72, 0xaf, 0x29, 0x7f, 0xa3, 0x89, 0xba, 0xcc, 0x06,
+	0xd4, 0x62, 0xfc, 0x09, 0x6d, 0x0f, 0x58, 0x46, 0x47, 0xec, 0x2b, 0x84, 0xea, 0x5e, 0x61, 0x0e,
+	0x62, 0xcc, 0x33, 0x01, 0xca, 0x20, 0xbb, 0x7b, 0xb0, 0x58, 0xb2, 0x58, 0x32, 0x9f, 0x68, 0xc2,
+	0x5b, 0xad, 0x2b, 0xbb, 0x7a, 0x38, 0xa8, 0x85, 0x9a, 0x83, 0x5f, 0x20, 0x74, 0x3d, 0x7d, 0xa1,
+	0x9d, 0x75, 0x9b, 0xb7, 0xfc, 0x50, 0x71, 0x2e, 0x40, 0x92, 0x05, 0x05, 0x7e, 0x87, 0xb6, 0xa2,
+	0xa2, 0x50, 0x26, 0x26, 0x22, 0x2c, 0x97, 0xd0, 0x69, 0xa9, 0x2a, 0xfb, 0xff, 0xb3, 0x4a, 0x33,
+	0xdf, 0x2b, 0x22, 0xd9, 0x8c, 0xea, 0x89, 0xf6, 0x53, 0xb4, 0xa9, 0x47, 0x76, 0x9a, 0x40, 0x74,
+	0x29, 0x26, 0x29, 0x7e, 0x84, 0x5a, 0x22, 0xa1, 0xdd, 0xe7, 0x47, 0x6a, 0x6c, 0x6b, 0x44, 0x47,
+	0xed, 0x5f, 0x26, 0xda, 0xd0, 0xdc, 0x73, 0x10, 0x82, 0x0e, 0x01, 0x1f, 0xa3, 0x56, 0xa2, 0xd6,
+	0x55, 0x4f, 0x78, 0xcf, 0xbf, 0xfd, 0x3f, 0xf5, 0x6b, 0x5b, 0xdd, 0x33, 0x88, 0x16, 0xe0, 0xa3,
+	0x6a, 0x37, 0x96, 0x9a, 0x0e, 0xd4, 0x94, 0xca, 0xbd, 0x9e, 0x51, 0x2d, 0xc9, 0xcb, 0x62, 0xfb,
+	0xcb, 0x9b, 0x3a, 0x56, 0xb3, 0xed, 0x9a, 0xb4, 0x6a, 0xa9, 0x67, 0x90, 0x6b, 0xd1, 0xc9, 0x32,
+	0xb2, 0x8a, 0xaf, 0xf3, 0x9f, 0x33, 0xd7, 0xbc, 0x9a, 0xb9, 0xe6, 0xdf, 0x99, 0x6b, 0x7e, 0x9b,
+	0xbb, 0xc6, 0xd5, 0xdc, 0x35, 0x7e, 0xcf, 0x5d, 0xe3, 0xe3, 0xe1, 0x90, 0xc9, 0x64, 0xd2, 0xf7,
+	0x23, 0x9e, 0x06, 0x11, 0x4f, 0x41, 0xf6, 0x07, 0xf2, 0xe6, 0x50, 0x3e, 0x39, 0xb7, 0x9f, 0xab,
+	0x7e, 0x4b, 0xe5, 0x0f, 0xff, 0x0d, 0x00, 0x95, 0x35, 0x2f, 0x6a, 0xc9, 0x04, 0x00, 0x00,
+}
+
+func (m *ArchiveHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusParams != nil {
+		{
+			size, err := m.ConsensusParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Validators != nil {
+		{
+			size, err := m.Validators.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FinalizeBlockResponse != nil {
+		{
+			size, err := m.FinalizeBlockResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExtendedCommit != nil {
+		{
+			size, err := m.ExtendedCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SeenCommit != nil {
+		{
+			size, err := m.SeenCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveMessage_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveMessage_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ArchiveMessage_Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveMessage_Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ArchiveMessage_Checksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveMessage_Checksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Checksum != nil {
+		{
+			size, err := m.Checksum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchiveHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovArchive(uint64(m.Version))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovArchive(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovArchive(uint64(m.EndHeight))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchiveBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.SeenCommit != nil {
+		l = m.SeenCommit.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.ExtendedCommit != nil {
+		l = m.ExtendedCommit.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.FinalizeBlockResponse != nil {
+		l = m.FinalizeBlockResponse.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Validators != nil {
+		l = m.Validators.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.ConsensusParams != nil {
+		l = m.ConsensusParams.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchiveChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchiveMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ArchiveMessage_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+func (m *ArchiveMessage_Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+func (m *ArchiveMessage_Checksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func sovArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArchive(x uint64) (n int) {
+	return sovArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchiveHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &state.State{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SeenCommit == nil {
+				m.SeenCommit = &types.Commit{}
+			}
+			if err := m.SeenCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtendedCommit == nil {
+				m.ExtendedCommit = &types.ExtendedCommit{}
+			}
+			if err := m.ExtendedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlockResponse == nil {
+				m.FinalizeBlockResponse = &types1.ResponseFinalizeBlock{}
+			}
+			if err := m.FinalizeBlockResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validators == nil {
+				m.Validators = &types.ValidatorSet{}
+			}
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParams == nil {
+				m.ConsensusParams = &types.ConsensusParams{}
+			}
+			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = append(m.Sha256[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha256 == nil {
+				m.Sha256 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ArchiveHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ArchiveMessage_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ArchiveBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ArchiveMessage_Block{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ArchiveChecksum{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ArchiveMessage_Checksum{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
+)
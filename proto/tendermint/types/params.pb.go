@@ -35,6 +35,8 @@ type ConsensusParams struct {
 	Validator *ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
 	Version   *VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	Abci      *ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci,omitempty"`
+	Synchrony *SynchronyParams `protobuf:"bytes,6,opt,name=synchrony,proto3" json:"synchrony,omitempty"`
+	Feature   *FeatureParams   `protobuf:"bytes,7,opt,name=feature,proto3" json:"feature,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -105,6 +107,20 @@ func (m *ConsensusParams) GetAbci() *ABCIParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetSynchrony() *SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetFeature() *FeatureParams {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -435,6 +451,120 @@ func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
 	return 0
 }
 
+// SynchronyParams determine the bounds of the clock drift between validators
+// and of the message delay used by proposer-based timestamps (PBTS).
+type SynchronyParams struct {
+	// Bound for how skewed the clock of a proposer may be from the clock of any
+	// validator on the network while still producing valid proposals.
+	Precision *time.Duration `protobuf:"bytes,1,opt,name=precision,proto3,stdduration" json:"precision,omitempty"`
+	// Bound for how long a proposal message may take to reach all validators on
+	// the network and still be considered valid. It is increased by 10% for
+	// every round after the first one, so that consensus eventually progresses
+	// if it is set too low.
+	MessageDelay *time.Duration `protobuf:"bytes,2,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay,omitempty"`
+}
+
+func (m *SynchronyParams) Reset()         { *m = SynchronyParams{} }
+func (m *SynchronyParams) String() string { return proto.CompactTextString(m) }
+func (*SynchronyParams) ProtoMessage()    {}
+func (*SynchronyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{7}
+}
+func (m *SynchronyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SynchronyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SynchronyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SynchronyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynchronyParams.Merge(m, src)
+}
+func (m *SynchronyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SynchronyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynchronyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SynchronyParams proto.InternalMessageInfo
+
+func (m *SynchronyParams) GetPrecision() *time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return nil
+}
+
+func (m *SynchronyParams) GetMessageDelay() *time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return nil
+}
+
+// FeatureParams configure the heights from which features of consensus are
+// enabled.
+type FeatureParams struct {
+	// pbts_enable_height configures the first height during which proposer-based
+	// timestamps (PBTS) are enabled. From this height on, the time of a block is
+	// the time of its proposer's clock, instead of the median of the times of the
+	// precommits in the last commit (BFT time), and validators only prevote for
+	// new proposals that they receive in a timely manner, according to the
+	// synchrony parameters.
+	//
+	// 0 disables PBTS. Once enabled, PBTS cannot be disabled.
+	PbtsEnableHeight int64 `protobuf:"varint,1,opt,name=pbts_enable_height,json=pbtsEnableHeight,proto3" json:"pbts_enable_height,omitempty"`
+}
+
+func (m *FeatureParams) Reset()         { *m = FeatureParams{} }
+func (m *FeatureParams) String() string { return proto.CompactTextString(m) }
+func (*FeatureParams) ProtoMessage()    {}
+func (*FeatureParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{8}
+}
+func (m *FeatureParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureParams.Merge(m, src)
+}
+func (m *FeatureParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureParams proto.InternalMessageInfo
+
+func (m *FeatureParams) GetPbtsEnableHeight() int64 {
+	if m != nil {
+		return m.PbtsEnableHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
@@ -443,48 +573,57 @@ func init() {
 	proto.RegisterType((*VersionParams)(nil), "tendermint.types.VersionParams")
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 	proto.RegisterType((*ABCIParams)(nil), "tendermint.types.ABCIParams")
+	proto.RegisterType((*SynchronyParams)(nil), "tendermint.types.SynchronyParams")
+	proto.RegisterType((*FeatureParams)(nil), "tendermint.types.FeatureParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x75, 0xda, 0xa6, 0x4f, 0x7e, 0x69, 0xa2, 0xd3, 0x4f, 0xc2, 0x14, 0xe2, 0x04,
-	0x0f, 0xa8, 0x52, 0x25, 0x1b, 0x91, 0x09, 0x84, 0x54, 0x25, 0x25, 0x6a, 0x0b, 0x2a, 0x7f, 0x2c,
-	0xc4, 0xd0, 0xc5, 0x3a, 0x27, 0x57, 0xc7, 0x6a, 0xec, 0xb3, 0x7c, 0xe7, 0x28, 0x79, 0x17, 0x8c,
-	0x8c, 0x1d, 0x61, 0x65, 0xe2, 0x25, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x59, 0x78, 0x19, 0xc8, 0x67,
-	0xbb, 0x6e, 0x12, 0xb6, 0xbb, 0x7b, 0x3e, 0x9f, 0xfb, 0xf3, 0x7d, 0x74, 0xd0, 0x14, 0x34, 0x18,
-	0xd2, 0xc8, 0xf7, 0x02, 0x61, 0x8a, 0x59, 0x48, 0xb9, 0x19, 0x92, 0x88, 0xf8, 0xdc, 0x08, 0x23,
-	0x26, 0x18, 0x6e, 0x14, 0x65, 0x43, 0x96, 0xf7, 0xfe, 0x77, 0x99, 0xcb, 0x64, 0xd1, 0x4c, 0x46,
-	0x29, 0xb7, 0xa7, 0xb9, 0x8c, 0xb9, 0x63, 0x6a, 0xca, 0x99, 0x13, 0x5f, 0x98, 0xc3, 0x38, 0x22,
-	0xc2, 0x63, 0x41, 0x5a, 0xd7, 0xbf, 0x6d, 0x40, 0xfd, 0x88, 0x05, 0x9c, 0x06, 0x3c, 0xe6, 0xef,
-	0xe4, 0x09, 0xb8, 0x03, 0x9b, 0xce, 0x98, 0x0d, 0x2e, 0x55, 0xd4, 0x46, 0xfb, 0xd5, 0xa7, 0x4d,
-	0x63, 0xf5, 0x2c, 0xa3, 0x97, 0x94, 0x53, 0xda, 0x4a, 0x59, 0xfc, 0x02, 0x2a, 0x74, 0xe2, 0x0d,
-	0x69, 0x30, 0xa0, 0xea, 0x86, 0xf4, 0xda, 0xeb, 0x5e, 0x3f, 0x23, 0x32, 0xf5, 0xd6, 0xc0, 0x87,
-	0xb0, 0x33, 0x21, 0x63, 0x6f, 0x48, 0x04, 0x8b, 0x54, 0x45, 0xea, 0x8f, 0xd6, 0xf5, 0x8f, 0x39,
-	0x92, 0xf9, 0x85, 0x83, 0x9f, 0xc1, 0xf6, 0x84, 0x46, 0xdc, 0x63, 0x81, 0x5a, 0x96, 0x7a, 0xeb,
-	0x1f, 0x7a, 0x0a, 0x64, 0x72, 0xce, 0xe3, 0x27, 0x50, 0x26, 0xce, 0xc0, 0x53, 0x37, 0xa5, 0xf7,
-	0x70, 0xdd, 0xeb, 0xf6, 0x8e, 0x4e, 0x33, 0x49, 0x92, 0xfa, 0x29, 0x54, 0xef, 0x24, 0x80, 0x1f,
-	0xc0, 0x8e, 0x4f, 0xa6, 0xb6, 0x33, 0x13, 0x94, 0xcb, 0xcc, 0x14, 0xab, 0xe2, 0x93, 0x69, 0x2f,
-	0x99, 0xe3, 0x7b, 0xb0, 0x9d, 0x14, 0x5d, 0xc2, 0x65, 0x2c, 0x8a, 0xb5, 0xe5, 0x93, 0xe9, 0x31,
-	0xe1, 0xaf, 0xca, 0x15, 0xa5, 0x51, 0xd6, 0xbf, 0x22, 0xd8, 0x5d, 0x4e, 0x05, 0x1f, 0x00, 0x4e,
-	0x0c, 0xe2, 0x52, 0x3b, 0x88, 0x7d, 0x5b, 0xc6, 0x9b, 0xef, 0x5b, 0xf7, 0xc9, 0xb4, 0xeb, 0xd2,
-	0x37, 0xb1, 0x2f, 0x2f, 0xc0, 0xf1, 0x19, 0x34, 0x72, 0x38, 0xef, 0x6c, 0x16, 0xff, 0x7d, 0x23,
-	0x6d, 0xbd, 0x91, 0xb7, 0xde, 0x78, 0x99, 0x01, 0xbd, 0xca, 0xf5, 0xcf, 0x56, 0xe9, 0xf3, 0xaf,
-	0x16, 0xb2, 0x76, 0xd3, 0xfd, 0xf2, 0xca, 0xf2, 0x53, 0x94, 0xe5, 0xa7, 0xe8, 0x87, 0x50, 0x5f,
-	0xe9, 0x00, 0xd6, 0xa1, 0x16, 0xc6, 0x8e, 0x7d, 0x49, 0x67, 0xb6, 0xcc, 0x4a, 0x45, 0x6d, 0x65,
-	0x7f, 0xc7, 0xaa, 0x86, 0xb1, 0xf3, 0x9a, 0xce, 0x3e, 0x24, 0x4b, 0xcf, 0x2b, 0xdf, 0xaf, 0x5a,
-	0xe8, 0xcf, 0x55, 0x0b, 0xe9, 0x07, 0x50, 0x5b, 0xea, 0x01, 0x6e, 0x80, 0x42, 0xc2, 0x50, 0xbe,
-	0xad, 0x6c, 0x25, 0xc3, 0x3b, 0xf0, 0x39, 0xfc, 0x77, 0x42, 0xf8, 0x88, 0x0e, 0x33, 0xf6, 0x31,
-	0xd4, 0x65, 0x14, 0xf6, 0x6a, 0xd6, 0x35, 0xb9, 0x7c, 0x96, 0x07, 0xae, 0x43, 0xad, 0xe0, 0x8a,
-	0xd8, 0xab, 0x39, 0x75, 0x4c, 0xb8, 0xfe, 0x16, 0xa0, 0x68, 0x2a, 0xee, 0x42, 0x73, 0xc2, 0x04,
-	0xb5, 0xe9, 0x54, 0xd0, 0x20, 0xb9, 0x1d, 0xb7, 0x69, 0x40, 0x9c, 0x31, 0xb5, 0x47, 0xd4, 0x73,
-	0x47, 0x22, 0x3b, 0x67, 0x2f, 0x81, 0xfa, 0xb7, 0x4c, 0x5f, 0x22, 0x27, 0x92, 0xe8, 0xbd, 0xff,
-	0x32, 0xd7, 0xd0, 0xf5, 0x5c, 0x43, 0x37, 0x73, 0x0d, 0xfd, 0x9e, 0x6b, 0xe8, 0xd3, 0x42, 0x2b,
-	0xdd, 0x2c, 0xb4, 0xd2, 0x8f, 0x85, 0x56, 0x3a, 0xef, 0xb8, 0x9e, 0x18, 0xc5, 0x8e, 0x31, 0x60,
-	0xbe, 0x39, 0x60, 0x3e, 0x15, 0xce, 0x85, 0x28, 0x06, 0xe9, 0x9f, 0x5d, 0xfd, 0xee, 0xce, 0x96,
-	0x5c, 0xef, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xe8, 0xce, 0x9a, 0x09, 0x04, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x4f, 0xdb, 0x4c,
+	0x10, 0xc6, 0x63, 0x1c, 0x20, 0x99, 0x10, 0x12, 0xad, 0x5e, 0xe9, 0xf5, 0xcb, 0x5b, 0x1c, 0xea,
+	0x43, 0x85, 0x44, 0xe5, 0x54, 0xe5, 0xd4, 0xaa, 0x08, 0x11, 0xa0, 0x40, 0x2b, 0xfa, 0x27, 0xad,
+	0x7a, 0xe0, 0x62, 0xad, 0x93, 0xc1, 0xb1, 0x88, 0xbd, 0x96, 0x77, 0x1d, 0x25, 0xdf, 0xa2, 0xa7,
+	0xaa, 0x47, 0x8e, 0xed, 0xa5, 0xe7, 0x7e, 0x04, 0x8e, 0x1c, 0x7b, 0x6a, 0xab, 0x70, 0xe9, 0xc7,
+	0xa8, 0xbc, 0xb6, 0x31, 0x09, 0xa0, 0xf6, 0xb6, 0xde, 0xf9, 0x3d, 0xbb, 0x33, 0xf3, 0x8c, 0x17,
+	0x96, 0x05, 0xfa, 0x5d, 0x0c, 0x3d, 0xd7, 0x17, 0x4d, 0x31, 0x0a, 0x90, 0x37, 0x03, 0x1a, 0x52,
+	0x8f, 0x9b, 0x41, 0xc8, 0x04, 0x23, 0xf5, 0x3c, 0x6c, 0xca, 0xf0, 0xd2, 0x3f, 0x0e, 0x73, 0x98,
+	0x0c, 0x36, 0xe3, 0x55, 0xc2, 0x2d, 0xe9, 0x0e, 0x63, 0x4e, 0x1f, 0x9b, 0xf2, 0xcb, 0x8e, 0x8e,
+	0x9b, 0xdd, 0x28, 0xa4, 0xc2, 0x65, 0x7e, 0x12, 0x37, 0xbe, 0xa8, 0x50, 0xdb, 0x66, 0x3e, 0x47,
+	0x9f, 0x47, 0xfc, 0x95, 0xbc, 0x81, 0xac, 0xc3, 0xac, 0xdd, 0x67, 0x9d, 0x13, 0x4d, 0x59, 0x51,
+	0x56, 0x2b, 0x0f, 0x97, 0xcd, 0xe9, 0xbb, 0xcc, 0x56, 0x1c, 0x4e, 0xe8, 0x76, 0xc2, 0x92, 0x27,
+	0x50, 0xc2, 0x81, 0xdb, 0x45, 0xbf, 0x83, 0xda, 0x8c, 0xd4, 0xad, 0x5c, 0xd7, 0xed, 0xa6, 0x44,
+	0x2a, 0xbd, 0x54, 0x90, 0x4d, 0x28, 0x0f, 0x68, 0xdf, 0xed, 0x52, 0xc1, 0x42, 0x4d, 0x95, 0xf2,
+	0xbb, 0xd7, 0xe5, 0xef, 0x32, 0x24, 0xd5, 0xe7, 0x1a, 0xf2, 0x08, 0xe6, 0x07, 0x18, 0x72, 0x97,
+	0xf9, 0x5a, 0x51, 0xca, 0x1b, 0x37, 0xc8, 0x13, 0x20, 0x15, 0x67, 0x3c, 0x79, 0x00, 0x45, 0x6a,
+	0x77, 0x5c, 0x6d, 0x56, 0xea, 0xee, 0x5c, 0xd7, 0x6d, 0xb5, 0xb6, 0x0f, 0x52, 0x91, 0x24, 0xe3,
+	0x6c, 0xf9, 0xc8, 0xef, 0xf4, 0x42, 0xe6, 0x8f, 0xb4, 0xb9, 0xdb, 0xb2, 0x7d, 0x93, 0x21, 0x59,
+	0xb6, 0x97, 0x9a, 0x38, 0xdb, 0x63, 0xa4, 0x22, 0x0a, 0x51, 0x9b, 0xbf, 0x2d, 0xdb, 0xa7, 0x09,
+	0x90, 0x65, 0x9b, 0xf2, 0xc6, 0x01, 0x54, 0xae, 0x74, 0x9f, 0xfc, 0x0f, 0x65, 0x8f, 0x0e, 0x2d,
+	0x7b, 0x24, 0x90, 0x4b, 0xbf, 0xd4, 0x76, 0xc9, 0xa3, 0xc3, 0x56, 0xfc, 0x4d, 0xfe, 0x85, 0xf9,
+	0x38, 0xe8, 0x50, 0x2e, 0x2d, 0x51, 0xdb, 0x73, 0x1e, 0x1d, 0xee, 0x51, 0xfe, 0xac, 0x58, 0x52,
+	0xeb, 0x45, 0xe3, 0xb3, 0x02, 0x8b, 0x93, 0x8e, 0x90, 0x35, 0x20, 0xb1, 0x82, 0x3a, 0x68, 0xf9,
+	0x91, 0x67, 0x49, 0x6b, 0xb3, 0x73, 0x6b, 0x1e, 0x1d, 0x6e, 0x39, 0xf8, 0x22, 0xf2, 0x64, 0x02,
+	0x9c, 0x1c, 0x42, 0x3d, 0x83, 0xb3, 0xa9, 0x4a, 0xad, 0xff, 0xcf, 0x4c, 0xc6, 0xce, 0xcc, 0xc6,
+	0xce, 0xdc, 0x49, 0x81, 0x56, 0xe9, 0xec, 0x7b, 0xa3, 0xf0, 0xf1, 0x47, 0x43, 0x69, 0x2f, 0x26,
+	0xe7, 0x65, 0x91, 0xc9, 0x52, 0xd4, 0xc9, 0x52, 0x8c, 0x4d, 0xa8, 0x4d, 0xb9, 0x4f, 0x0c, 0xa8,
+	0x06, 0x91, 0x6d, 0x9d, 0xe0, 0xc8, 0x92, 0x1d, 0xd3, 0x94, 0x15, 0x75, 0xb5, 0xdc, 0xae, 0x04,
+	0x91, 0xfd, 0x1c, 0x47, 0x6f, 0xe3, 0xad, 0xc7, 0xa5, 0xaf, 0xa7, 0x0d, 0xe5, 0xd7, 0x69, 0x43,
+	0x31, 0xd6, 0xa0, 0x3a, 0xe1, 0x3f, 0xa9, 0x83, 0x4a, 0x83, 0x40, 0xd6, 0x56, 0x6c, 0xc7, 0xcb,
+	0x2b, 0xf0, 0x11, 0x2c, 0xec, 0x53, 0xde, 0xc3, 0x6e, 0xca, 0xde, 0x83, 0x9a, 0x6c, 0x85, 0x35,
+	0xdd, 0xeb, 0xaa, 0xdc, 0x3e, 0xcc, 0x1a, 0x6e, 0x40, 0x35, 0xe7, 0xf2, 0xb6, 0x57, 0x32, 0x6a,
+	0x8f, 0x72, 0xe3, 0x25, 0x40, 0x3e, 0x50, 0x64, 0x0b, 0x96, 0x07, 0x4c, 0xa0, 0x85, 0x43, 0x81,
+	0x7e, 0x9c, 0x1d, 0xb7, 0xd0, 0xa7, 0x76, 0x1f, 0xad, 0x1e, 0xba, 0x4e, 0x4f, 0xa4, 0xf7, 0x2c,
+	0xc5, 0xd0, 0xee, 0x25, 0xb3, 0x2b, 0x91, 0x7d, 0x49, 0x18, 0x1f, 0x14, 0xa8, 0x4d, 0xcd, 0x1a,
+	0xd9, 0x80, 0x72, 0x10, 0x62, 0xc7, 0x95, 0x3f, 0x84, 0xf2, 0x27, 0x4f, 0x8a, 0xd2, 0x8f, 0x5c,
+	0x41, 0x76, 0xa0, 0xea, 0x21, 0xe7, 0xd2, 0x59, 0xec, 0xd3, 0x91, 0x36, 0xf3, 0x77, 0x47, 0x2c,
+	0xa4, 0xaa, 0x9d, 0x58, 0x64, 0x6c, 0x40, 0x75, 0x62, 0x88, 0xc9, 0x7d, 0x20, 0x81, 0x2d, 0x6e,
+	0xae, 0xb0, 0x1e, 0x47, 0xae, 0xd6, 0xd5, 0x7a, 0xfd, 0x69, 0xac, 0x2b, 0x67, 0x63, 0x5d, 0x39,
+	0x1f, 0xeb, 0xca, 0xcf, 0xb1, 0xae, 0xbc, 0xbf, 0xd0, 0x0b, 0xe7, 0x17, 0x7a, 0xe1, 0xdb, 0x85,
+	0x5e, 0x38, 0x5a, 0x77, 0x5c, 0xd1, 0x8b, 0x6c, 0xb3, 0xc3, 0xbc, 0x66, 0x87, 0x79, 0x28, 0xec,
+	0x63, 0x91, 0x2f, 0x92, 0x77, 0x70, 0xfa, 0x09, 0xb5, 0xe7, 0xe4, 0xfe, 0xfa, 0xef, 0x01, 0x00,
+	0xc8, 0x24, 0x44, 0xb6, 0x5d, 0x05, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -521,6 +660,12 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Abci.Equal(that1.Abci) {
 		return false
 	}
+	if !this.Synchrony.Equal(that1.Synchrony) {
+		return false
+	}
+	if !this.Feature.Equal(that1.Feature) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -684,6 +829,69 @@ func (this *ABCIParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SynchronyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SynchronyParams)
+	if !ok {
+		that2, ok := that.(SynchronyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precision != nil && that1.Precision != nil {
+		if *this.Precision != *that1.Precision {
+			return false
+		}
+	} else if this.Precision != nil {
+		return false
+	} else if that1.Precision != nil {
+		return false
+	}
+	if this.MessageDelay != nil && that1.MessageDelay != nil {
+		if *this.MessageDelay != *that1.MessageDelay {
+			return false
+		}
+	} else if this.MessageDelay != nil {
+		return false
+	} else if that1.MessageDelay != nil {
+		return false
+	}
+	return true
+}
+func (this *FeatureParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureParams)
+	if !ok {
+		that2, ok := that.(FeatureParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PbtsEnableHeight != that1.PbtsEnableHeight {
+		return false
+	}
+	return true
+}
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -704,6 +912,30 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Feature != nil {
+		{
+			size, err := m.Feature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Synchrony != nil {
+		{
+			size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Abci != nil {
 		{
 			size, err := m.Abci.MarshalToSizedBuffer(dAtA[:i])
@@ -825,12 +1057,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -962,6 +1194,77 @@ func (m *ABCIParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SynchronyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SynchronyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynchronyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageDelay != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MessageDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MessageDelay):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintParams(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Precision != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Precision, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Precision):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintParams(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PbtsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PbtsEnableHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -1091,6 +1394,14 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Abci.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.Synchrony != nil {
+		l = m.Synchrony.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Feature != nil {
+		l = m.Feature.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1180,6 +1491,35 @@ func (m *ABCIParams) Size() (n int) {
 	return n
 }
 
+func (m *SynchronyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Precision != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Precision)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MessageDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MessageDelay)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *FeatureParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PbtsEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.PbtsEnableHeight))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1395,6 +1735,78 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchrony == nil {
+				m.Synchrony = &SynchronyParams{}
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feature == nil {
+				m.Feature = &FeatureParams{}
+			}
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1933,6 +2345,197 @@ func (m *ABCIParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SynchronyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Precision == nil {
+				m.Precision = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Precision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageDelay == nil {
+				m.MessageDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PbtsEnableHeight", wireType)
+			}
+			m.PbtsEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PbtsEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -69,6 +69,89 @@ func (c *connSyncLocalClientCreator) NewABCIClient() (abcicli.Client, error) {
 	return abcicli.NewLocalClient(nil, c.app), nil
 }
 
+//----------------------------------------------------
+// local proxy letting some connections run concurrently
+
+// LocalConnSync defines how the calls of a local connection to the app are
+// synchronized.
+type LocalConnSync int
+
+const (
+	// LocalConnSyncShared serializes the calls of the connection with a mutex
+	// shared with the other connections using it, including the consensus
+	// connection.
+	LocalConnSyncShared LocalConnSync = iota
+	// LocalConnSyncOwn serializes the calls of the connection with a mutex of
+	// its own, so that they run concurrently with the other connections.
+	LocalConnSyncOwn
+	// LocalConnSyncNone doesn't serialize the calls of the connection, which
+	// run concurrently with each other and with the other connections.
+	LocalConnSyncNone
+)
+
+// LocalClientOption sets the synchronization of a connection of a local
+// client creator.
+type LocalClientOption func(*concurrentLocalClientCreator)
+
+// WithQueryConnSync sets the synchronization of the query connection.
+func WithQueryConnSync(sync LocalConnSync) LocalClientOption {
+	return func(c *concurrentLocalClientCreator) { c.connSync[connQuery] = sync }
+}
+
+// WithMempoolConnSync sets the synchronization of the mempool connection.
+// With LocalConnSyncNone, CheckTx may be called from multiple goroutines.
+func WithMempoolConnSync(sync LocalConnSync) LocalClientOption {
+	return func(c *concurrentLocalClientCreator) { c.connSync[connMempool] = sync }
+}
+
+// WithSnapshotConnSync sets the synchronization of the snapshot connection.
+func WithSnapshotConnSync(sync LocalConnSync) LocalClientOption {
+	return func(c *concurrentLocalClientCreator) { c.connSync[connSnapshot] = sync }
+}
+
+type concurrentLocalClientCreator struct {
+	mtx      *cmtsync.Mutex
+	app      types.Application
+	connSync map[string]LocalConnSync
+}
+
+// NewConcurrentLocalClientCreator returns a local [ClientCreator] for the given
+// app, where the app declares which connections may run concurrently.
+//
+// By default, the clients of all the connections share a single mutex, as
+// with [NewLocalClientCreator]. The options let the query, mempool and
+// snapshot connections use a mutex of their own, or none at all, so that e.g.
+// Query and CheckTx run concurrently with FinalizeBlock. The app must be safe
+// for the concurrent calls it allows.
+func NewConcurrentLocalClientCreator(app types.Application, options ...LocalClientOption) ClientCreator {
+	c := &concurrentLocalClientCreator{
+		mtx:      new(cmtsync.Mutex),
+		app:      app,
+		connSync: make(map[string]LocalConnSync),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// NewABCIClient returns a client using the shared mutex, as the connection it
+// is used for is unknown.
+func (c *concurrentLocalClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return abcicli.NewLocalClient(c.mtx, c.app), nil
+}
+
+func (c *concurrentLocalClientCreator) newABCIClientFor(conn string) (abcicli.Client, error) {
+	switch c.connSync[conn] {
+	case LocalConnSyncOwn:
+		return abcicli.NewLocalClient(nil, c.app), nil
+	case LocalConnSyncNone:
+		return abcicli.NewUnsyncLocalClient(c.app), nil
+	default:
+		return c.NewABCIClient()
+	}
+}
+
 //---------------------------------------------------------------
 // remote proxy opens new connections to an external app process
 
@@ -106,7 +189,9 @@ func (r *remoteClientCreator) NewABCIClient() (abcicli.Client, error) {
 //
 // Each of "kvstore", "persistent_kvstore" and "e2e" also currently have an
 // "_connsync" variant (i.e. "kvstore_connsync", etc.), which attempts to
-// replicate the same concurrency model as the remote client.
+// replicate the same concurrency model as the remote client. "kvstore" and
+// "persistent_kvstore" also have a "_concurrent" variant, whose query and
+// mempool connections run concurrently with the consensus connection.
 func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
 	switch addr {
 	case "kvstore":
@@ -117,6 +202,10 @@ func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
 		return NewLocalClientCreator(kvstore.NewPersistentApplication(dbDir))
 	case "persistent_kvstore_connsync":
 		return NewConnSyncLocalClientCreator(kvstore.NewPersistentApplication(dbDir))
+	case "kvstore_concurrent":
+		return newConcurrentKVStoreClientCreator(kvstore.NewInMemoryApplication())
+	case "persistent_kvstore_concurrent":
+		return newConcurrentKVStoreClientCreator(kvstore.NewPersistentApplication(dbDir))
 	case "e2e":
 		app, err := e2e.NewApplication(e2e.DefaultConfig(dbDir))
 		if err != nil {
@@ -136,3 +225,12 @@ func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
 		return NewRemoteClientCreator(addr, transport, mustConnect)
 	}
 }
+
+// newConcurrentKVStoreClientCreator returns a local [ClientCreator] for the
+// kvstore app, which is safe for concurrent Query, Info and CheckTx calls.
+func newConcurrentKVStoreClientCreator(app *kvstore.Application) ClientCreator {
+	return NewConcurrentLocalClientCreator(app,
+		WithQueryConnSync(LocalConnSyncNone),
+		WithMempoolConnSync(LocalConnSyncNone),
+	)
+}
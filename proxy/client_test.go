@@ -0,0 +1,203 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/abci/types"
+)
+
+// blockingApp blocks FinalizeBlock until unblock is closed.
+type blockingApp struct {
+	types.BaseApplication
+
+	finalizing chan struct{}
+	unblock    chan struct{}
+}
+
+func (app *blockingApp) FinalizeBlock(context.Context, *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	close(app.finalizing)
+	<-app.unblock
+	return &types.ResponseFinalizeBlock{}, nil
+}
+
+func TestConcurrentLocalClientCreator(t *testing.T) {
+	testCases := map[string]struct {
+		options    []LocalClientOption
+		concurrent bool
+	}{
+		"shared":    {nil, false},
+		"own mutex": {[]LocalClientOption{WithQueryConnSync(LocalConnSyncOwn)}, true},
+		"no mutex":  {[]LocalClientOption{WithQueryConnSync(LocalConnSyncNone)}, true},
+		"other":     {[]LocalClientOption{WithMempoolConnSync(LocalConnSyncNone)}, false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &blockingApp{finalizing: make(chan struct{}), unblock: make(chan struct{})}
+			appConns := NewAppConns(NewConcurrentLocalClientCreator(app, tc.options...), NopMetrics())
+			require.NoError(t, appConns.Start())
+			t.Cleanup(func() { require.NoError(t, appConns.Stop()) })
+
+			go func() {
+				_, err := appConns.Consensus().FinalizeBlock(context.Background(), &types.RequestFinalizeBlock{})
+				assert.NoError(t, err)
+			}()
+			<-app.finalizing
+
+			queried := make(chan struct{})
+			go func() {
+				_, err := appConns.Query().Query(context.Background(), &types.RequestQuery{})
+				assert.NoError(t, err)
+				close(queried)
+			}()
+
+			select {
+			case <-queried:
+				assert.True(t, tc.concurrent, "Query ran concurrently with FinalizeBlock")
+			case <-time.After(100 * time.Millisecond):
+				assert.False(t, tc.concurrent, "Query was blocked by FinalizeBlock")
+			}
+			close(app.unblock)
+			<-queried
+		})
+	}
+}
+
+func TestConcurrentKVStore(t *testing.T) {
+	appConns := NewAppConns(DefaultClientCreator("kvstore_concurrent", "", ""), NopMetrics())
+	require.NoError(t, appConns.Start())
+	t.Cleanup(func() { require.NoError(t, appConns.Stop()) })
+
+	ctx := context.Background()
+	_, err := appConns.Consensus().InitChain(ctx, &types.RequestInitChain{})
+	require.NoError(t, err)
+
+	const blocks = 20
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for height := int64(1); height <= blocks; height++ {
+			tx := []byte(fmt.Sprintf("key%d=value%d", height, height))
+			_, err := appConns.Consensus().FinalizeBlock(ctx, &types.RequestFinalizeBlock{
+				Height: height,
+				Txs:    [][]byte{tx},
+			})
+			assert.NoError(t, err)
+			_, err = appConns.Consensus().Commit(ctx)
+			assert.NoError(t, err)
+		}
+	}()
+
+	// Query and check transactions from multiple goroutines while the blocks
+	// are executed.
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for height := 1; height <= blocks; height++ {
+				// The key of a height is committed along with the height.
+				res, err := appConns.Query().Query(ctx, &types.RequestQuery{Data: []byte(fmt.Sprintf("key%d", height))})
+				assert.NoError(t, err)
+				if res.Height >= int64(height) {
+					assert.Equal(t, fmt.Sprintf("value%d", height), string(res.Value))
+				} else {
+					assert.Nil(t, res.Value)
+				}
+				_, err = appConns.Query().Info(ctx, &types.RequestInfo{})
+				assert.NoError(t, err)
+
+				checkRes, err := appConns.Mempool().CheckTx(ctx, &types.RequestCheckTx{Tx: []byte(fmt.Sprintf("tx%d=%d", i, height))})
+				assert.NoError(t, err)
+				assert.Equal(t, kvstore.CodeTypeOK, checkRes.Code)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	res, err := appConns.Query().Query(ctx, &types.RequestQuery{Data: []byte(fmt.Sprintf("key%d", blocks))})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("value%d", blocks), string(res.Value))
+	assert.Equal(t, int64(blocks), res.Height)
+}
+
+// pausingKVStore pauses after each FinalizeBlock of the kvstore app until
+// resumed.
+type pausingKVStore struct {
+	*kvstore.Application
+
+	finalized chan struct{}
+	resume    chan struct{}
+}
+
+func (app *pausingKVStore) FinalizeBlock(ctx context.Context, req *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	res, err := app.Application.FinalizeBlock(ctx, req)
+	app.finalized <- struct{}{}
+	<-app.resume
+	return res, err
+}
+
+func TestConcurrentKVStoreQueryDuringFinalizeBlock(t *testing.T) {
+	app := &pausingKVStore{
+		Application: kvstore.NewInMemoryApplication(),
+		finalized:   make(chan struct{}),
+		resume:      make(chan struct{}),
+	}
+	appConns := NewAppConns(NewConcurrentLocalClientCreator(app,
+		WithQueryConnSync(LocalConnSyncNone),
+		WithMempoolConnSync(LocalConnSyncNone),
+	), NopMetrics())
+	require.NoError(t, appConns.Start())
+	t.Cleanup(func() { require.NoError(t, appConns.Stop()) })
+
+	ctx := context.Background()
+	_, err := appConns.Consensus().InitChain(ctx, &types.RequestInitChain{})
+	require.NoError(t, err)
+
+	query := func(key string) *types.ResponseQuery {
+		res, err := appConns.Query().Query(ctx, &types.RequestQuery{Data: []byte(key)})
+		require.NoError(t, err)
+		return res
+	}
+	for height := int64(1); height <= 3; height++ {
+		key, value := fmt.Sprintf("key%d", height), fmt.Sprintf("value%d", height)
+		finalized := make(chan struct{})
+		go func() {
+			defer close(finalized)
+			_, err := appConns.Consensus().FinalizeBlock(ctx, &types.RequestFinalizeBlock{
+				Height: height,
+				Txs:    [][]byte{[]byte(key + "=" + value)},
+			})
+			assert.NoError(t, err)
+		}()
+		<-app.finalized
+
+		// While the block is being finalized, the queries are served from the
+		// state of the previous block.
+		res := query(key)
+		assert.Nil(t, res.Value)
+		assert.Equal(t, height-1, res.Height)
+		info, err := appConns.Query().Info(ctx, &types.RequestInfo{})
+		require.NoError(t, err)
+		assert.Equal(t, height-1, info.LastBlockHeight)
+		checkRes, err := appConns.Mempool().CheckTx(ctx, &types.RequestCheckTx{Tx: []byte("a=b")})
+		require.NoError(t, err)
+		assert.Equal(t, kvstore.CodeTypeOK, checkRes.Code)
+
+		app.resume <- struct{}{}
+		<-finalized
+		_, err = appConns.Consensus().Commit(ctx)
+		require.NoError(t, err)
+
+		res = query(key)
+		assert.Equal(t, value, string(res.Value))
+		assert.Equal(t, height, res.Height)
+	}
+}
@@ -180,8 +180,22 @@ func (app *multiAppConn) stopAllClients() {
 	}
 }
 
+// connClientCreator is implemented by the client creators whose clients depend
+// on the connection they are created for.
+type connClientCreator interface {
+	newABCIClientFor(conn string) (abcicli.Client, error)
+}
+
 func (app *multiAppConn) abciClientFor(conn string) (abcicli.Client, error) {
-	c, err := app.clientCreator.NewABCIClient()
+	var (
+		c   abcicli.Client
+		err error
+	)
+	if cc, ok := app.clientCreator.(connClientCreator); ok {
+		c, err = cc.newABCIClientFor(conn)
+	} else {
+		c, err = app.clientCreator.NewABCIClient()
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating ABCI client (%s connection): %w", conn, err)
 	}
@@ -135,6 +135,21 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
 
+		committed, err := c.CommittedEvidence(context.Background(), correct.Height(), correct.Height(),
+			"duplicate_vote", nil, nil)
+		require.NoError(t, err)
+		var found bool
+		for _, ev := range committed.Evidence {
+			if bytes.Equal(ev.Evidence.Hash(), correct.Hash()) {
+				found = true
+				assert.Equal(t, []types.Address{pv.Key.Address}, ev.ValidatorAddresses)
+			}
+		}
+		assert.True(t, found, "evidence %X was not committed", correct.Hash())
+		pending, err := c.PendingEvidence(context.Background(), correct.Height(), correct.Height(), "", nil, nil)
+		require.NoError(t, err)
+		assert.Zero(t, pending.TotalCount)
+
 		ed25519pub := pv.Key.PubKey.(ed25519.PubKey)
 		rawpub := ed25519pub.Bytes()
 		result2, err := c.ABCIQuery(context.Background(), "/val", rawpub)
@@ -0,0 +1,398 @@
+// Package grpc implements a client of the gRPC RPC service of a CometBFT node.
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	coregrpc "github.com/cometbft/cometbft/rpc/grpc"
+	"github.com/cometbft/cometbft/types"
+)
+
+/*
+Client is a client of the gRPC RPC service of a CometBFT node, enabled with the
+rpc.grpc_laddr setting. It implements the parts of the Client interface of the
+rpc/client package served by the gRPC service: the ABCIClient, SignClient and
+StatusClient interfaces. The results are the same as with the HTTP client.
+
+Example:
+
+	c, err := New("tcp://192.168.1.10:26670")
+	if err != nil {
+		// handle error
+	}
+	defer c.Close()
+
+	res, err := c.Status(context.Background())
+	if err != nil {
+		// handle error
+	}
+
+	// handle result
+*/
+type Client struct {
+	remote string
+	conn   *grpc.ClientConn
+	rpc    coregrpc.RPCServiceClient
+}
+
+// rpcClient groups the interfaces implemented by Client.
+type rpcClient interface {
+	rpcclient.ABCIClient
+	rpcclient.SignClient
+	rpcclient.StatusClient
+}
+
+var _ rpcClient = (*Client)(nil)
+
+// New returns a client of the gRPC server at remote, in the form
+// <protocol>://<host>:<port>. The connection is established lazily.
+func New(remote string) (*Client, error) {
+	conn, err := coregrpc.Dial(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		remote: remote,
+		conn:   conn,
+		rpc:    coregrpc.NewRPCServiceClient(conn),
+	}, nil
+}
+
+// Remote returns the remote network address in a string form.
+func (c *Client) Remote() string {
+	return c.remote
+}
+
+// Close closes the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+//-----------------------------------------------------------------------------
+// StatusClient
+
+func (c *Client) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	res, err := c.rpc.Status(ctx, &coregrpc.RequestStatus{})
+	if err != nil {
+		return nil, err
+	}
+	nodeInfo, err := p2p.DefaultNodeInfoFromToProto(&res.NodeInfo)
+	if err != nil {
+		return nil, err
+	}
+	valInfo := ctypes.ValidatorInfo{
+		Address:     res.ValidatorInfo.Address,
+		VotingPower: res.ValidatorInfo.VotingPower,
+	}
+	if res.ValidatorInfo.PubKey != nil {
+		if valInfo.PubKey, err = cryptoenc.PubKeyFromProto(*res.ValidatorInfo.PubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &ctypes.ResultStatus{
+		NodeInfo: nodeInfo,
+		SyncInfo: ctypes.SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+		},
+		ValidatorInfo: valInfo,
+	}, nil
+}
+
+//-----------------------------------------------------------------------------
+// ABCIClient
+
+func (c *Client) ABCIInfo(ctx context.Context) (*ctypes.ResultABCIInfo, error) {
+	res, err := c.rpc.ABCIInfo(ctx, &coregrpc.RequestABCIInfo{})
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIInfo{Response: res.Response}, nil
+}
+
+func (c *Client) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (c *Client) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	res, err := c.rpc.ABCIQuery(ctx, &coregrpc.RequestABCIQuery{
+		Path:   path,
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: res.Response}, nil
+}
+
+func (c *Client) BroadcastTxCommit(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	res, err := c.broadcastTx(ctx, tx, coregrpc.BroadcastModeCommit)
+	if err != nil {
+		return nil, err
+	}
+	result := &ctypes.ResultBroadcastTxCommit{Hash: res.Hash, Height: res.Height}
+	if res.CheckTx != nil {
+		result.CheckTx = *res.CheckTx
+	}
+	if res.TxResult != nil {
+		result.TxResult = *res.TxResult
+	}
+	return result, nil
+}
+
+func (c *Client) BroadcastTxAsync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	res, err := c.broadcastTx(ctx, tx, coregrpc.BroadcastModeAsync)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBroadcastTx{Hash: res.Hash}, nil
+}
+
+func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	res, err := c.broadcastTx(ctx, tx, coregrpc.BroadcastModeSync)
+	if err != nil {
+		return nil, err
+	}
+	result := &ctypes.ResultBroadcastTx{Hash: res.Hash}
+	if res.CheckTx != nil {
+		result.Code = res.CheckTx.Code
+		result.Data = res.CheckTx.Data
+		result.Log = res.CheckTx.Log
+		result.Codespace = res.CheckTx.Codespace
+	}
+	return result, nil
+}
+
+func (c *Client) broadcastTx(
+	ctx context.Context,
+	tx types.Tx,
+	mode coregrpc.BroadcastMode,
+) (*coregrpc.ResponseBroadcastTx, error) {
+	return c.rpc.BroadcastTx(ctx, &coregrpc.RequestBroadcastTx{Tx: tx, Mode: mode})
+}
+
+//-----------------------------------------------------------------------------
+// SignClient
+
+func (c *Client) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	res, err := c.rpc.Block(ctx, &coregrpc.RequestBlock{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	return resultBlock(res)
+}
+
+func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error) {
+	res, err := c.rpc.BlockByHash(ctx, &coregrpc.RequestBlockByHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return resultBlock(res)
+}
+
+func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	res, err := c.rpc.BlockResults(ctx, &coregrpc.RequestBlockResults{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		FinalizeBlockEvents:   res.FinalizeBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+		AppHash:               res.AppHash,
+	}, nil
+}
+
+func (c *Client) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	res, err := c.rpc.Header(ctx, &coregrpc.RequestHeader{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	return resultHeader(res)
+}
+
+func (c *Client) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*ctypes.ResultHeader, error) {
+	res, err := c.rpc.HeaderByHash(ctx, &coregrpc.RequestHeaderByHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return resultHeader(res)
+}
+
+func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	res, err := c.rpc.Commit(ctx, &coregrpc.RequestCommit{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	sh, err := types.SignedHeaderFromProto(&res.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultCommit{SignedHeader: *sh, CanonicalCommit: res.Canonical}, nil
+}
+
+func (c *Client) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+	res, err := c.rpc.Validators(ctx, &coregrpc.RequestValidators{
+		Height:  heightValue(height),
+		Page:    intValue(page),
+		PerPage: intValue(perPage),
+	})
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]*types.Validator, len(res.Validators))
+	for i, val := range res.Validators {
+		if vals[i], err = types.ValidatorFromProto(val); err != nil {
+			return nil, err
+		}
+	}
+	return &ctypes.ResultValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  vals,
+		Count:       int(res.Count),
+		Total:       int(res.Total),
+	}, nil
+}
+
+func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	res, err := c.rpc.Tx(ctx, &coregrpc.RequestTx{Hash: hash, Prove: prove})
+	if err != nil {
+		return nil, err
+	}
+	return resultTx(res)
+}
+
+func (c *Client) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	res, err := c.rpc.TxSearch(ctx, &coregrpc.RequestTxSearch{
+		Query:   query,
+		Prove:   prove,
+		Page:    intValue(page),
+		PerPage: intValue(perPage),
+		OrderBy: orderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*ctypes.ResultTx, len(res.Txs))
+	for i, tx := range res.Txs {
+		if txs[i], err = resultTx(tx); err != nil {
+			return nil, err
+		}
+	}
+	return &ctypes.ResultTxSearch{Txs: txs, TotalCount: int(res.TotalCount)}, nil
+}
+
+func (c *Client) BlockSearch(
+	ctx context.Context,
+	query string,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	res, err := c.rpc.BlockSearch(ctx, &coregrpc.RequestBlockSearch{
+		Query:   query,
+		Page:    intValue(page),
+		PerPage: intValue(perPage),
+		OrderBy: orderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*ctypes.ResultBlock, len(res.Blocks))
+	for i, block := range res.Blocks {
+		if blocks[i], err = resultBlock(block); err != nil {
+			return nil, err
+		}
+	}
+	return &ctypes.ResultBlockSearch{Blocks: blocks, TotalCount: int(res.TotalCount)}, nil
+}
+
+//-----------------------------------------------------------------------------
+
+func resultBlock(res *coregrpc.ResponseBlock) (*ctypes.ResultBlock, error) {
+	blockID, err := types.BlockIDFromProto(&res.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	result := &ctypes.ResultBlock{BlockID: *blockID}
+	if res.Block != nil {
+		if result.Block, err = types.BlockFromProto(res.Block); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func resultHeader(res *coregrpc.ResponseHeader) (*ctypes.ResultHeader, error) {
+	if res.Header == nil {
+		return &ctypes.ResultHeader{}, nil
+	}
+	header, err := types.HeaderFromProto(res.Header)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultHeader{Header: &header}, nil
+}
+
+func resultTx(res *coregrpc.ResponseTx) (*ctypes.ResultTx, error) {
+	result := &ctypes.ResultTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: res.TxResult,
+		Tx:       res.Tx,
+	}
+	// The proof is empty, and not valid, if it was not requested.
+	if len(res.Proof.RootHash) > 0 {
+		proof, err := types.TxProofFromProto(res.Proof)
+		if err != nil {
+			return nil, err
+		}
+		result.Proof = proof
+	}
+	return result, nil
+}
+
+// heightValue returns zero, which means the latest height, for a nil height.
+func heightValue(height *int64) int64 {
+	if height == nil {
+		return 0
+	}
+	return *height
+}
+
+// intValue returns zero, which means the default value, for nil.
+func intValue(i *int) int32 {
+	if i == nil {
+		return 0
+	}
+	return int32(*i)
+}
@@ -0,0 +1,176 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	nm "github.com/cometbft/cometbft/node"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpcgrpc "github.com/cometbft/cometbft/rpc/client/grpc"
+	rpclocal "github.com/cometbft/cometbft/rpc/client/local"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+var node *nm.Node
+
+func TestMain(m *testing.M) {
+	// start a CometBFT node in the background to test against
+	app := kvstore.NewInMemoryApplication()
+	node = rpctest.StartTendermint(app)
+
+	code := m.Run()
+
+	// and shut down proper at the end
+	rpctest.StopTendermint(node)
+	os.Exit(code)
+}
+
+func getClient(t *testing.T) *rpcgrpc.Client {
+	t.Helper()
+
+	c, err := rpcgrpc.New(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+	res, err := getClient(t).Status(ctx)
+	require.NoError(t, err)
+
+	expected, err := rpclocal.New(node).Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected.NodeInfo, res.NodeInfo)
+	assert.Equal(t, expected.ValidatorInfo, res.ValidatorInfo)
+	assert.GreaterOrEqual(t, res.SyncInfo.LatestBlockHeight, expected.SyncInfo.EarliestBlockHeight)
+}
+
+func TestABCI(t *testing.T) {
+	ctx := context.Background()
+	c := getClient(t)
+
+	info, err := c.ABCIInfo(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, info.Response.Data, "size")
+
+	tx := types.Tx("grpc-client=abci")
+	res, err := c.BroadcastTxCommit(ctx, tx)
+	require.NoError(t, err)
+	require.True(t, res.CheckTx.IsOK())
+	require.True(t, res.TxResult.IsOK())
+
+	query, err := c.ABCIQueryWithOptions(ctx, "/key", []byte("grpc-client"),
+		rpcclient.ABCIQueryOptions{Height: res.Height + 1})
+	require.NoError(t, err)
+	assert.EqualValues(t, "abci", query.Response.Value)
+
+	syncRes, err := c.BroadcastTxSync(ctx, types.Tx("grpc-client=sync"))
+	require.NoError(t, err)
+	assert.EqualValues(t, kvstore.CodeTypeOK, syncRes.Code)
+
+	asyncRes, err := c.BroadcastTxAsync(ctx, types.Tx("grpc-client=async"))
+	require.NoError(t, err)
+	assert.EqualValues(t, types.Tx("grpc-client=async").Hash(), asyncRes.Hash)
+}
+
+// TestSign checks that the results are the same as with the local client.
+func TestSign(t *testing.T) {
+	ctx := context.Background()
+	c := getClient(t)
+	local := rpclocal.New(node)
+
+	tx := types.Tx("grpc-client=sign")
+	res, err := c.BroadcastTxCommit(ctx, tx)
+	require.NoError(t, err)
+	require.True(t, res.TxResult.IsOK())
+	height := res.Height
+	// The commit of the block is canonical once the next block is committed.
+	require.NoError(t, rpcclient.WaitForHeight(c, height+1, nil))
+
+	block, err := c.Block(ctx, &height)
+	require.NoError(t, err)
+	expectedBlock, err := local.Block(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, expectedBlock.BlockID, block.BlockID)
+	assert.Equal(t, expectedBlock.Block.Hash(), block.Block.Hash())
+
+	byHash, err := c.BlockByHash(ctx, block.BlockID.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, block.BlockID, byHash.BlockID)
+
+	header, err := c.Header(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Hash(), header.Header.Hash())
+	header, err = c.HeaderByHash(ctx, block.BlockID.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Hash(), header.Header.Hash())
+	header, err = c.HeaderByHash(ctx, []byte("unknown"))
+	require.NoError(t, err)
+	assert.Nil(t, header.Header)
+
+	commit, err := c.Commit(ctx, &height)
+	require.NoError(t, err)
+	expectedCommit, err := local.Commit(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, expectedCommit, commit)
+	assert.True(t, commit.CanonicalCommit)
+
+	results, err := c.BlockResults(ctx, &height)
+	require.NoError(t, err)
+	expectedResults, err := local.BlockResults(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, expectedResults, results)
+
+	vals, err := c.Validators(ctx, &height, nil, nil)
+	require.NoError(t, err)
+	expectedVals, err := local.Validators(ctx, &height, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expectedVals, vals)
+
+	txRes, err := c.Tx(ctx, tx.Hash(), true)
+	require.NoError(t, err)
+	assert.Equal(t, height, txRes.Height)
+	require.NoError(t, txRes.Proof.Validate(block.Block.DataHash))
+
+	txRes, err = c.Tx(ctx, tx.Hash(), false)
+	require.NoError(t, err)
+	assert.Equal(t, height, txRes.Height)
+	assert.Equal(t, types.TxProof{}, txRes.Proof)
+
+	query := fmt.Sprintf("tx.height=%d", height)
+	search, err := c.TxSearch(ctx, query, true, nil, nil, "asc")
+	require.NoError(t, err)
+	expectedSearch, err := local.TxSearch(ctx, query, true, nil, nil, "asc")
+	require.NoError(t, err)
+	assert.Equal(t, expectedSearch.TotalCount, search.TotalCount)
+	require.Len(t, search.Txs, len(expectedSearch.Txs))
+	for i, txRes := range search.Txs {
+		expected := expectedSearch.Txs[i]
+		assert.Equal(t, expected.Hash, txRes.Hash)
+		assert.Equal(t, expected.Index, txRes.Index)
+		assert.Equal(t, expected.TxResult, txRes.TxResult)
+		assert.NoError(t, txRes.Proof.Validate(block.Block.DataHash))
+	}
+
+	search, err = c.TxSearch(ctx, query, false, nil, nil, "asc")
+	require.NoError(t, err)
+	require.Len(t, search.Txs, len(expectedSearch.Txs))
+	for _, txRes := range search.Txs {
+		assert.Equal(t, types.TxProof{}, txRes.Proof)
+	}
+
+	query = fmt.Sprintf("block.height=%d", height)
+	blockSearch, err := c.BlockSearch(ctx, query, nil, nil, "asc")
+	require.NoError(t, err)
+	require.Len(t, blockSearch.Blocks, 1)
+	assert.Equal(t, block.BlockID, blockSearch.Blocks[0].BlockID)
+	assert.Equal(t, 1, blockSearch.TotalCount)
+}
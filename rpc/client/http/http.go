@@ -591,6 +591,49 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	_, err := c.caller.Call(ctx, "evidence_pending", evidenceParams(minHeight, maxHeight, evType, page, perPage), result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) CommittedEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultCommittedEvidence, error) {
+	result := new(ctypes.ResultCommittedEvidence)
+	_, err := c.caller.Call(ctx, "evidence_committed", evidenceParams(minHeight, maxHeight, evType, page, perPage), result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func evidenceParams(minHeight, maxHeight int64, evType string, page, perPage *int) map[string]interface{} {
+	params := map[string]interface{}{
+		"min_height": minHeight,
+		"max_height": maxHeight,
+		"type":       evType,
+	}
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+	return params
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
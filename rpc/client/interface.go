@@ -11,6 +11,10 @@ For connecting to a node running in the same process (eg. when
 compiling the abci app in the same process), you can use the client.Local
 implementation.
 
+For connecting to the gRPC service of a node (see rpc.grpc_laddr), you can use
+the client of the grpc package, which implements the ABCIClient, SignClient
+and StatusClient interfaces.
+
 For mocking out server responses during testing to see behavior for
 arbitrary return values, use the mock package.
 
@@ -140,9 +144,21 @@ type MempoolClient interface {
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior, and listing the pending and committed evidence.
 type EvidenceClient interface {
 	BroadcastEvidence(context.Context, types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(
+		ctx context.Context,
+		minHeight, maxHeight int64,
+		evType string,
+		page, perPage *int,
+	) (*ctypes.ResultPendingEvidence, error)
+	CommittedEvidence(
+		ctx context.Context,
+		minHeight, maxHeight int64,
+		evType string,
+		page, perPage *int,
+	) (*ctypes.ResultCommittedEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
@@ -212,6 +212,24 @@ func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(
+	_ context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(c.ctx, minHeight, maxHeight, evType, page, perPage)
+}
+
+func (c *Local) CommittedEvidence(
+	_ context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(c.ctx, minHeight, maxHeight, evType, page, perPage)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
@@ -181,3 +181,21 @@ func (c Client) Validators(_ context.Context, height *int64, page, perPage *int)
 func (c Client) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.env.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(
+	_ context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(&rpctypes.Context{}, minHeight, maxHeight, evType, page, perPage)
+}
+
+func (c Client) CommittedEvidence(
+	_ context.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	page, perPage *int,
+) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(&rpctypes.Context{}, minHeight, maxHeight, evType, page, perPage)
+}
@@ -344,6 +344,29 @@ func (_m *Client) Commit(ctx context.Context, height *int64) (*coretypes.ResultC
 	return r0, r1
 }
 
+// CommittedEvidence provides a mock function with given fields: ctx, minHeight, maxHeight, evType, page, perPage
+func (_m *Client) CommittedEvidence(ctx context.Context, minHeight int64, maxHeight int64, evType string, page *int, perPage *int) (*coretypes.ResultCommittedEvidence, error) {
+	ret := _m.Called(ctx, minHeight, maxHeight, evType, page, perPage)
+
+	var r0 *coretypes.ResultCommittedEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, *int, *int) *coretypes.ResultCommittedEvidence); ok {
+		r0 = rf(ctx, minHeight, maxHeight, evType, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultCommittedEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string, *int, *int) error); ok {
+		r1 = rf(ctx, minHeight, maxHeight, evType, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusParams provides a mock function with given fields: ctx, height
 func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	ret := _m.Called(ctx, height)
@@ -621,6 +644,29 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// PendingEvidence provides a mock function with given fields: ctx, minHeight, maxHeight, evType, page, perPage
+func (_m *Client) PendingEvidence(ctx context.Context, minHeight int64, maxHeight int64, evType string, page *int, perPage *int) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(ctx, minHeight, maxHeight, evType, page, perPage)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, *int, *int) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(ctx, minHeight, maxHeight, evType, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string, *int, *int) error); ok {
+		r1 = rf(ctx, minHeight, maxHeight, evType, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
@@ -3,7 +3,10 @@ package core
 import (
 	"errors"
 	"fmt"
+	"math"
 
+	"github.com/cometbft/cometbft/evidence"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
@@ -28,3 +31,166 @@ func (env *Environment) BroadcastEvidence(
 	}
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// evidenceLister is implemented by the evidence pools which can list their
+// pending and committed evidence.
+type evidenceLister interface {
+	PendingEvidenceInRange(minHeight, maxHeight int64) ([]types.Evidence, error)
+	CommittedEvidenceInRange(
+		minHeight, maxHeight int64,
+		match func(types.Evidence) bool,
+		skip, limit int,
+	) ([]evidence.CommittedEvidence, int, error)
+}
+
+// PendingEvidence returns the evidence which is not committed yet, of the
+// misbehavior which happened between minHeight and maxHeight (both optional)
+// and of the given type ("duplicate_vote" or "light_client_attack", optional).
+// It returns a page of the evidence (maximum ?per_page entries), from the
+// oldest misbehavior to the newest, and the total count.
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/evidence_pending
+func (env *Environment) PendingEvidence(
+	_ *rpctypes.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	pagePtr, perPagePtr *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	pool, err := env.evidenceLister()
+	if err != nil {
+		return nil, err
+	}
+	minHeight, maxHeight, err = evidenceHeightRange(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+	match, err := evidenceTypeFilter(evType)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := pool.PendingEvidenceInRange(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]types.Evidence, 0, len(pending))
+	for _, ev := range pending {
+		if match == nil || match(ev) {
+			results = append(results, ev)
+		}
+	}
+
+	skipCount, pageSize, err := env.evidencePage(pagePtr, perPagePtr, len(results))
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultPendingEvidence{
+		Evidence:   results[skipCount : skipCount+pageSize],
+		TotalCount: len(results),
+	}, nil
+}
+
+// CommittedEvidence returns the evidence committed in the blocks, of the
+// misbehavior which happened between minHeight and maxHeight (both optional)
+// and of the given type ("duplicate_vote" or "light_client_attack", optional).
+// It returns a page of the evidence (maximum ?per_page entries), from the
+// oldest misbehavior to the newest, and the total count. The evidence of the
+// pruned blocks is not returned.
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/evidence_committed
+func (env *Environment) CommittedEvidence(
+	_ *rpctypes.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	pagePtr, perPagePtr *int,
+) (*ctypes.ResultCommittedEvidence, error) {
+	pool, err := env.evidenceLister()
+	if err != nil {
+		return nil, err
+	}
+	minHeight, maxHeight, err = evidenceHeightRange(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+	match, err := evidenceTypeFilter(evType)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page is validated once the total count is known.
+	perPage := env.validatePerPage(perPagePtr)
+	page := 1
+	if pagePtr != nil {
+		page = *pagePtr
+	}
+	committed, totalCount, err := pool.CommittedEvidenceInRange(
+		minHeight, maxHeight, match, validateSkipCount(page, perPage), perPage)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := validatePage(pagePtr, perPage, totalCount); err != nil {
+		return nil, err
+	}
+
+	results := make([]*ctypes.CommittedEvidence, 0, len(committed))
+	for _, ev := range committed {
+		results = append(results, &ctypes.CommittedEvidence{
+			Evidence:           ev.Evidence,
+			Height:             ev.Height,
+			ValidatorAddresses: types.EvidenceValidatorAddresses(ev.Evidence),
+		})
+	}
+	return &ctypes.ResultCommittedEvidence{Evidence: results, TotalCount: totalCount}, nil
+}
+
+func (env *Environment) evidenceLister() (evidenceLister, error) {
+	pool, ok := env.EvidencePool.(evidenceLister)
+	if !ok {
+		return nil, errors.New("listing evidence is not supported by the evidence pool")
+	}
+	return pool, nil
+}
+
+func (env *Environment) evidencePage(pagePtr, perPagePtr *int, totalCount int) (skipCount, pageSize int, err error) {
+	perPage := env.validatePerPage(perPagePtr)
+	page, err := validatePage(pagePtr, perPage, totalCount)
+	if err != nil {
+		return 0, 0, err
+	}
+	skipCount = validateSkipCount(page, perPage)
+	return skipCount, cmtmath.MinInt(perPage, totalCount-skipCount), nil
+}
+
+// evidenceHeightRange returns the range of heights of the misbehavior to list.
+// The heights are unbounded if 0.
+func evidenceHeightRange(minHeight, maxHeight int64) (int64, int64, error) {
+	if minHeight < 0 || maxHeight < 0 {
+		return minHeight, maxHeight, errors.New("heights must be non-negative")
+	}
+	if maxHeight == 0 {
+		maxHeight = math.MaxInt64
+	}
+	if minHeight > maxHeight {
+		return minHeight, maxHeight, fmt.Errorf("min height %d can't be greater than max height %d", minHeight, maxHeight)
+	}
+	return minHeight, maxHeight, nil
+}
+
+// evidenceTypeFilter returns a function matching the evidence of the given
+// type, or nil to match any evidence if the type is empty.
+func evidenceTypeFilter(evType string) (func(types.Evidence) bool, error) {
+	switch evType {
+	case "":
+		return nil, nil
+	case "duplicate_vote":
+		return func(ev types.Evidence) bool {
+			_, ok := ev.(*types.DuplicateVoteEvidence)
+			return ok
+		}, nil
+	case "light_client_attack":
+		return func(ev types.Evidence) bool {
+			_, ok := ev.(*types.LightClientAttackEvidence)
+			return ok
+		}, nil
+	default:
+		return nil, errors.New("expected type to be either `duplicate_vote` or `light_client_attack` or empty")
+	}
+}
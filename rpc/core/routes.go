@@ -51,6 +51,8 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence"),
+		"evidence_pending":   rpc.NewRPCFunc(env.PendingEvidence, "min_height,max_height,type,page,per_page"),
+		"evidence_committed": rpc.NewRPCFunc(env.CommittedEvidence, "min_height,max_height,type,page,per_page"),
 	}
 }
 
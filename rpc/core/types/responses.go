@@ -239,6 +239,26 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// Result of listing the pending evidence
+type ResultPendingEvidence struct {
+	Evidence   []types.Evidence `json:"evidence"`
+	TotalCount int              `json:"total_count"`
+}
+
+// CommittedEvidence is evidence committed in the block at Height.
+type CommittedEvidence struct {
+	Evidence types.Evidence `json:"evidence"`
+	Height   int64          `json:"height"`
+	// Addresses of the validators which misbehaved.
+	ValidatorAddresses []types.Address `json:"validator_addresses"`
+}
+
+// Result of listing the committed evidence
+type ResultCommittedEvidence struct {
+	Evidence   []*CommittedEvidence `json:"evidence"`
+	TotalCount int                  `json:"total_count"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
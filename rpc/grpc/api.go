@@ -0,0 +1,347 @@
+package coregrpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"google.golang.org/grpc/peer"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	core "github.com/cometbft/cometbft/rpc/core"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+// rpcService implements RPCServiceServer with the same Environment methods as
+// the JSON-RPC server.
+type rpcService struct {
+	env *core.Environment
+
+	// number of NewBlocks streams opened so far, to generate unique
+	// subscriber IDs
+	numStreams uint64
+}
+
+var _ RPCServiceServer = (*rpcService)(nil)
+
+func (s *rpcService) Version(context.Context, *RequestVersion) (*ResponseVersion, error) {
+	return &ResponseVersion{
+		Node:  version.TMCoreSemVer,
+		ABCI:  version.ABCISemVer,
+		P2P:   version.P2PProtocol,
+		Block: version.BlockProtocol,
+	}, nil
+}
+
+func (s *rpcService) Status(ctx context.Context, _ *RequestStatus) (*ResponseStatus, error) {
+	res, err := s.env.Status(rpcContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	valInfo := ValidatorInfo{
+		Address:     res.ValidatorInfo.Address,
+		VotingPower: res.ValidatorInfo.VotingPower,
+	}
+	if res.ValidatorInfo.PubKey != nil {
+		pk, err := cryptoenc.PubKeyToProto(res.ValidatorInfo.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		valInfo.PubKey = &pk
+	}
+	return &ResponseStatus{
+		NodeInfo: *res.NodeInfo.ToProto(),
+		SyncInfo: SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+		},
+		ValidatorInfo: valInfo,
+	}, nil
+}
+
+func (s *rpcService) ABCIInfo(ctx context.Context, _ *RequestABCIInfo) (*ResponseABCIInfo, error) {
+	res, err := s.env.ABCIInfo(rpcContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseABCIInfo{Response: res.Response}, nil
+}
+
+func (s *rpcService) ABCIQuery(ctx context.Context, req *RequestABCIQuery) (*ResponseABCIQuery, error) {
+	res, err := s.env.ABCIQuery(rpcContext(ctx), req.Path, req.Data, req.Height, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseABCIQuery{Response: res.Response}, nil
+}
+
+func (s *rpcService) Block(ctx context.Context, req *RequestBlock) (*ResponseBlock, error) {
+	res, err := s.env.Block(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return newResponseBlock(res.BlockID, res.Block)
+}
+
+func (s *rpcService) BlockByHash(ctx context.Context, req *RequestBlockByHash) (*ResponseBlock, error) {
+	res, err := s.env.BlockByHash(rpcContext(ctx), req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return newResponseBlock(res.BlockID, res.Block)
+}
+
+func (s *rpcService) Header(ctx context.Context, req *RequestHeader) (*ResponseHeader, error) {
+	res, err := s.env.Header(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseHeader{Header: res.Header.ToProto()}, nil
+}
+
+func (s *rpcService) HeaderByHash(ctx context.Context, req *RequestHeaderByHash) (*ResponseHeader, error) {
+	res, err := s.env.HeaderByHash(rpcContext(ctx), req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseHeader{Header: res.Header.ToProto()}, nil
+}
+
+func (s *rpcService) Commit(ctx context.Context, req *RequestCommit) (*ResponseCommit, error) {
+	res, err := s.env.Commit(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	// There is no commit at the requested height.
+	if res == nil {
+		return &ResponseCommit{}, nil
+	}
+	return &ResponseCommit{
+		SignedHeader: *res.SignedHeader.ToProto(),
+		Canonical:    res.CanonicalCommit,
+	}, nil
+}
+
+func (s *rpcService) BlockResults(ctx context.Context, req *RequestBlockResults) (*ResponseBlockResults, error) {
+	res, err := s.env.BlockResults(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		FinalizeBlockEvents:   res.FinalizeBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+		AppHash:               res.AppHash,
+	}, nil
+}
+
+func (s *rpcService) Tx(ctx context.Context, req *RequestTx) (*ResponseTx, error) {
+	res, err := s.env.Tx(rpcContext(ctx), req.Hash, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return newResponseTx(res), nil
+}
+
+func (s *rpcService) TxSearch(ctx context.Context, req *RequestTxSearch) (*ResponseTxSearch, error) {
+	res, err := s.env.TxSearch(rpcContext(ctx), req.Query, req.Prove, intPtr(req.Page), intPtr(req.PerPage), req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*ResponseTx, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = newResponseTx(tx)
+	}
+	return &ResponseTxSearch{Txs: txs, TotalCount: int64(res.TotalCount)}, nil
+}
+
+func (s *rpcService) BlockSearch(ctx context.Context, req *RequestBlockSearch) (*ResponseBlockSearch, error) {
+	res, err := s.env.BlockSearch(rpcContext(ctx), req.Query, intPtr(req.Page), intPtr(req.PerPage), req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*ResponseBlock, len(res.Blocks))
+	for i, block := range res.Blocks {
+		if blocks[i], err = newResponseBlock(block.BlockID, block.Block); err != nil {
+			return nil, err
+		}
+	}
+	return &ResponseBlockSearch{Blocks: blocks, TotalCount: int64(res.TotalCount)}, nil
+}
+
+func (s *rpcService) Validators(ctx context.Context, req *RequestValidators) (*ResponseValidators, error) {
+	res, err := s.env.Validators(rpcContext(ctx), heightPtr(req.Height), intPtr(req.Page), intPtr(req.PerPage))
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]*cmtproto.Validator, len(res.Validators))
+	for i, val := range res.Validators {
+		if validators[i], err = val.ToProto(); err != nil {
+			return nil, err
+		}
+	}
+	return &ResponseValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  validators,
+		Count:       int64(res.Count),
+		Total:       int64(res.Total),
+	}, nil
+}
+
+func (s *rpcService) BroadcastTx(ctx context.Context, req *RequestBroadcastTx) (*ResponseBroadcastTx, error) {
+	switch req.Mode {
+	case BroadcastModeAsync:
+		res, err := s.env.BroadcastTxAsync(rpcContext(ctx), req.Tx)
+		if err != nil {
+			return nil, err
+		}
+		return &ResponseBroadcastTx{Hash: res.Hash}, nil
+
+	case BroadcastModeSync:
+		res, err := s.env.BroadcastTxSync(rpcContext(ctx), req.Tx)
+		if err != nil {
+			return nil, err
+		}
+		return &ResponseBroadcastTx{
+			CheckTx: &abci.ResponseCheckTx{
+				Code:      res.Code,
+				Data:      res.Data,
+				Log:       res.Log,
+				Codespace: res.Codespace,
+			},
+			Hash: res.Hash,
+		}, nil
+
+	case BroadcastModeCommit:
+		res, err := s.env.BroadcastTxCommit(rpcContext(ctx), req.Tx)
+		if err != nil {
+			return nil, err
+		}
+		return &ResponseBroadcastTx{
+			CheckTx:  &res.CheckTx,
+			TxResult: &res.TxResult,
+			Hash:     res.Hash,
+			Height:   res.Height,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown broadcast mode: %v", req.Mode)
+	}
+}
+
+func (s *rpcService) NewBlocks(_ *RequestNewBlocks, stream RPCService_NewBlocksServer) error {
+	env := s.env
+	ctx := stream.Context()
+
+	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
+		return fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	}
+
+	// A client may open several streams over the same connection, so each
+	// stream is a different subscriber.
+	subscriber := fmt.Sprintf("%s#%d", remoteAddr(ctx), atomic.AddUint64(&s.numStreams, 1))
+	subCtx, cancel := context.WithTimeout(ctx, core.SubscribeTimeout)
+	defer cancel()
+	sub, err := env.EventBus.Subscribe(subCtx, subscriber, types.EventQueryNewBlock, env.Config.SubscriptionBufferSize)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+	defer func() {
+		if err := env.EventBus.Unsubscribe(context.Background(), subscriber, types.EventQueryNewBlock); err != nil {
+			env.Logger.Error("Error unsubscribing from eventBus", "err", err)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			data := msg.Data().(types.EventDataNewBlock)
+			res, err := newResponseBlock(data.BlockID, data.Block)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		case <-sub.Canceled():
+			reason := "CometBFT exited"
+			if sub.Err() != nil {
+				reason = sub.Err().Error()
+			}
+			return fmt.Errorf("subscription was canceled (reason: %s)", reason)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func newResponseBlock(blockID types.BlockID, block *types.Block) (*ResponseBlock, error) {
+	res := &ResponseBlock{BlockID: blockID.ToProto()}
+	if block != nil {
+		pbBlock, err := block.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		res.Block = pbBlock
+	}
+	return res, nil
+}
+
+func newResponseTx(res *ctypes.ResultTx) *ResponseTx {
+	return &ResponseTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: res.TxResult,
+		Tx:       res.Tx,
+		Proof:    res.Proof.ToProto(),
+	}
+}
+
+// rpcContext returns the context of a JSON-RPC request equivalent to the gRPC
+// request with the given context, so that the Environment methods see the
+// same remote address and cancellation.
+func rpcContext(ctx context.Context) *rpctypes.Context {
+	req := (&http.Request{}).WithContext(ctx)
+	req.RemoteAddr = remoteAddr(ctx)
+	return &rpctypes.Context{HTTPReq: req}
+}
+
+func remoteAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return "grpc:" + p.Addr.String()
+	}
+	return "grpc"
+}
+
+// heightPtr returns nil for a zero height, which means the latest height.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+// intPtr returns nil for zero, which means the default value.
+func intPtr(i int32) *int {
+	if i == 0 {
+		return nil
+	}
+	v := int(i)
+	return &v
+}
@@ -0,0 +1,65 @@
+package coregrpc
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	core "github.com/cometbft/cometbft/rpc/core"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+// StartGRPCServer starts a new gRPC RPCService server using the given
+// net.Listener, backed by env. It returns when the listener is closed.
+// NOTE: This function blocks - you may want to call it in a go-routine.
+func StartGRPCServer(env *core.Environment, ln net.Listener, opts ...grpc.ServerOption) error {
+	grpcServer := grpc.NewServer(opts...)
+	RegisterRPCServiceServer(grpcServer, &rpcService{env: env})
+	return grpcServer.Serve(ln)
+}
+
+// StartPrivilegedGRPCServer starts a new gRPC server serving the
+// PruningService of pruner using the given net.Listener. It must not be
+// exposed publicly. It returns when the listener is closed.
+// NOTE: This function blocks - you may want to call it in a go-routine.
+func StartPrivilegedGRPCServer(pruner *sm.Pruner, ln net.Listener, opts ...grpc.ServerOption) error {
+	grpcServer := grpc.NewServer(opts...)
+	RegisterPruningServiceServer(grpcServer, &pruningService{pruner: pruner})
+	return grpcServer.Serve(ln)
+}
+
+// StartGRPCClient dials the gRPC server using protoAddr and returns a new
+// RPCServiceClient.
+func StartGRPCClient(protoAddr string) (RPCServiceClient, error) {
+	conn, err := Dial(protoAddr)
+	if err != nil {
+		return nil, err
+	}
+	return NewRPCServiceClient(conn), nil
+}
+
+// StartPrivilegedGRPCClient dials the privileged gRPC server using protoAddr
+// and returns a new PruningServiceClient.
+func StartPrivilegedGRPCClient(protoAddr string) (PruningServiceClient, error) {
+	conn, err := Dial(protoAddr)
+	if err != nil {
+		return nil, err
+	}
+	return NewPruningServiceClient(conn), nil
+}
+
+// Dial returns an insecure connection to the gRPC server at protoAddr, e.g.
+// "tcp://127.0.0.1:26670" or "unix:///tmp/grpc.sock".
+func Dial(protoAddr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(protoAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialerFunc),
+	)
+}
+
+func dialerFunc(_ context.Context, addr string) (net.Conn, error) {
+	return cmtnet.Connect(addr)
+}
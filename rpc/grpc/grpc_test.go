@@ -0,0 +1,128 @@
+package coregrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	core_grpc "github.com/cometbft/cometbft/rpc/grpc"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+func TestMain(m *testing.M) {
+	// start a CometBFT node in the background to test against, with the data
+	// companion enabled for the pruning service
+	app := kvstore.NewInMemoryApplication()
+	node := rpctest.StartTendermint(app, rpctest.EnableDataCompanion)
+
+	code := m.Run()
+
+	// and shut down proper at the end
+	rpctest.StopTendermint(node)
+	os.Exit(code)
+}
+
+func TestVersion(t *testing.T) {
+	res, err := rpctest.GetGRPCClient().Version(context.Background(), &core_grpc.RequestVersion{})
+	require.NoError(t, err)
+	assert.Equal(t, version.TMCoreSemVer, res.Node)
+	assert.Equal(t, version.BlockProtocol, res.Block)
+}
+
+func TestBroadcastTx(t *testing.T) {
+	client := rpctest.GetGRPCClient()
+	tx := []byte("grpc=commit")
+
+	res, err := client.BroadcastTx(
+		context.Background(),
+		&core_grpc.RequestBroadcastTx{Tx: tx, Mode: core_grpc.BroadcastModeCommit},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.CheckTx.Code)
+	require.EqualValues(t, 0, res.TxResult.Code)
+	assert.EqualValues(t, types.Tx(tx).Hash(), res.Hash)
+
+	txRes, err := client.Tx(context.Background(), &core_grpc.RequestTx{Hash: res.Hash, Prove: true})
+	require.NoError(t, err)
+	assert.Equal(t, res.Height, txRes.Height)
+	assert.EqualValues(t, tx, txRes.Tx)
+	assert.EqualValues(t, tx, txRes.Proof.Data)
+
+	blockRes, err := client.Block(context.Background(), &core_grpc.RequestBlock{Height: res.Height})
+	require.NoError(t, err)
+	assert.Equal(t, res.Height, blockRes.Block.Header.Height)
+	assert.Equal(t, [][]byte{tx}, blockRes.Block.Data.Txs)
+
+	resultsRes, err := client.BlockResults(context.Background(), &core_grpc.RequestBlockResults{Height: res.Height})
+	require.NoError(t, err)
+	require.Len(t, resultsRes.TxsResults, 1)
+	assert.Equal(t, res.TxResult.Data, resultsRes.TxsResults[0].Data)
+
+	res, err = client.BroadcastTx(
+		context.Background(),
+		&core_grpc.RequestBroadcastTx{Tx: []byte("grpc=sync"), Mode: core_grpc.BroadcastModeSync},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.CheckTx.Code)
+	assert.Nil(t, res.TxResult)
+}
+
+func TestValidators(t *testing.T) {
+	res, err := rpctest.GetGRPCClient().Validators(context.Background(), &core_grpc.RequestValidators{})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	assert.EqualValues(t, 1, res.Total)
+	assert.Positive(t, res.BlockHeight)
+}
+
+func TestNewBlocks(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := rpctest.GetGRPCClient().NewBlocks(ctx, &core_grpc.RequestNewBlocks{})
+	require.NoError(t, err)
+
+	var lastHeight int64
+	for i := 0; i < 2; i++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.NotNil(t, res.Block)
+		assert.Greater(t, res.Block.Header.Height, lastHeight)
+		lastHeight = res.Block.Header.Height
+	}
+}
+
+func TestPruningService(t *testing.T) {
+	client := rpctest.GetPrivilegedGRPCClient()
+	ctx := context.Background()
+
+	res, err := client.GetBlockRetainHeight(ctx, &core_grpc.RequestGetBlockRetainHeight{})
+	require.NoError(t, err)
+	assert.Zero(t, res.PruningServiceRetainHeight)
+
+	// The retain height can be set once the first block is committed.
+	require.Eventually(t, func() bool {
+		_, err = client.SetBlockRetainHeight(ctx, &core_grpc.RequestSetBlockRetainHeight{Height: 1})
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	res, err = client.GetBlockRetainHeight(ctx, &core_grpc.RequestGetBlockRetainHeight{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, res.PruningServiceRetainHeight)
+
+	// The retain height cannot be beyond the latest block.
+	_, err = client.SetBlockRetainHeight(ctx, &core_grpc.RequestSetBlockRetainHeight{Height: 1 << 40})
+	require.Error(t, err)
+
+	_, err = client.SetBlockResultsRetainHeight(ctx, &core_grpc.RequestSetBlockResultsRetainHeight{Height: 1})
+	require.NoError(t, err)
+	resultsRes, err := client.GetBlockResultsRetainHeight(ctx, &core_grpc.RequestGetBlockResultsRetainHeight{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resultsRes.PruningServiceRetainHeight)
+}
@@ -0,0 +1,1493 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/rpc/grpc/privileged.proto
+
+package coregrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RequestSetBlockRetainHeight struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestSetBlockRetainHeight) Reset()         { *m = RequestSetBlockRetainHeight{} }
+func (m *RequestSetBlockRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*RequestSetBlockRetainHeight) ProtoMessage()    {}
+func (*RequestSetBlockRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{0}
+}
+func (m *RequestSetBlockRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestSetBlockRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestSetBlockRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestSetBlockRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSetBlockRetainHeight.Merge(m, src)
+}
+func (m *RequestSetBlockRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestSetBlockRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSetBlockRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestSetBlockRetainHeight proto.InternalMessageInfo
+
+func (m *RequestSetBlockRetainHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type RequestGetBlockRetainHeight struct {
+}
+
+func (m *RequestGetBlockRetainHeight) Reset()         { *m = RequestGetBlockRetainHeight{} }
+func (m *RequestGetBlockRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*RequestGetBlockRetainHeight) ProtoMessage()    {}
+func (*RequestGetBlockRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{1}
+}
+func (m *RequestGetBlockRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestGetBlockRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestGetBlockRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestGetBlockRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestGetBlockRetainHeight.Merge(m, src)
+}
+func (m *RequestGetBlockRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestGetBlockRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestGetBlockRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestGetBlockRetainHeight proto.InternalMessageInfo
+
+type RequestSetBlockResultsRetainHeight struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestSetBlockResultsRetainHeight) Reset()         { *m = RequestSetBlockResultsRetainHeight{} }
+func (m *RequestSetBlockResultsRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*RequestSetBlockResultsRetainHeight) ProtoMessage()    {}
+func (*RequestSetBlockResultsRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{2}
+}
+func (m *RequestSetBlockResultsRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestSetBlockResultsRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestSetBlockResultsRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestSetBlockResultsRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSetBlockResultsRetainHeight.Merge(m, src)
+}
+func (m *RequestSetBlockResultsRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestSetBlockResultsRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSetBlockResultsRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestSetBlockResultsRetainHeight proto.InternalMessageInfo
+
+func (m *RequestSetBlockResultsRetainHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type RequestGetBlockResultsRetainHeight struct {
+}
+
+func (m *RequestGetBlockResultsRetainHeight) Reset()         { *m = RequestGetBlockResultsRetainHeight{} }
+func (m *RequestGetBlockResultsRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*RequestGetBlockResultsRetainHeight) ProtoMessage()    {}
+func (*RequestGetBlockResultsRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{3}
+}
+func (m *RequestGetBlockResultsRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestGetBlockResultsRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestGetBlockResultsRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestGetBlockResultsRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestGetBlockResultsRetainHeight.Merge(m, src)
+}
+func (m *RequestGetBlockResultsRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestGetBlockResultsRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestGetBlockResultsRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestGetBlockResultsRetainHeight proto.InternalMessageInfo
+
+type ResponseSetBlockRetainHeight struct {
+}
+
+func (m *ResponseSetBlockRetainHeight) Reset()         { *m = ResponseSetBlockRetainHeight{} }
+func (m *ResponseSetBlockRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*ResponseSetBlockRetainHeight) ProtoMessage()    {}
+func (*ResponseSetBlockRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{4}
+}
+func (m *ResponseSetBlockRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseSetBlockRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseSetBlockRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseSetBlockRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseSetBlockRetainHeight.Merge(m, src)
+}
+func (m *ResponseSetBlockRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseSetBlockRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseSetBlockRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseSetBlockRetainHeight proto.InternalMessageInfo
+
+type ResponseGetBlockRetainHeight struct {
+	// The block retain height requested by the application.
+	AppRetainHeight int64 `protobuf:"varint,1,opt,name=app_retain_height,json=appRetainHeight,proto3" json:"app_retain_height,omitempty"`
+	// The block retain height set through the pruning service.
+	PruningServiceRetainHeight int64 `protobuf:"varint,2,opt,name=pruning_service_retain_height,json=pruningServiceRetainHeight,proto3" json:"pruning_service_retain_height,omitempty"`
+}
+
+func (m *ResponseGetBlockRetainHeight) Reset()         { *m = ResponseGetBlockRetainHeight{} }
+func (m *ResponseGetBlockRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*ResponseGetBlockRetainHeight) ProtoMessage()    {}
+func (*ResponseGetBlockRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{5}
+}
+func (m *ResponseGetBlockRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseGetBlockRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseGetBlockRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseGetBlockRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseGetBlockRetainHeight.Merge(m, src)
+}
+func (m *ResponseGetBlockRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseGetBlockRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseGetBlockRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseGetBlockRetainHeight proto.InternalMessageInfo
+
+func (m *ResponseGetBlockRetainHeight) GetAppRetainHeight() int64 {
+	if m != nil {
+		return m.AppRetainHeight
+	}
+	return 0
+}
+
+func (m *ResponseGetBlockRetainHeight) GetPruningServiceRetainHeight() int64 {
+	if m != nil {
+		return m.PruningServiceRetainHeight
+	}
+	return 0
+}
+
+type ResponseSetBlockResultsRetainHeight struct {
+}
+
+func (m *ResponseSetBlockResultsRetainHeight) Reset()         { *m = ResponseSetBlockResultsRetainHeight{} }
+func (m *ResponseSetBlockResultsRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*ResponseSetBlockResultsRetainHeight) ProtoMessage()    {}
+func (*ResponseSetBlockResultsRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{6}
+}
+func (m *ResponseSetBlockResultsRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseSetBlockResultsRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseSetBlockResultsRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseSetBlockResultsRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseSetBlockResultsRetainHeight.Merge(m, src)
+}
+func (m *ResponseSetBlockResultsRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseSetBlockResultsRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseSetBlockResultsRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseSetBlockResultsRetainHeight proto.InternalMessageInfo
+
+type ResponseGetBlockResultsRetainHeight struct {
+	// The retain height of the block results set through the pruning service.
+	PruningServiceRetainHeight int64 `protobuf:"varint,1,opt,name=pruning_service_retain_height,json=pruningServiceRetainHeight,proto3" json:"pruning_service_retain_height,omitempty"`
+}
+
+func (m *ResponseGetBlockResultsRetainHeight) Reset()         { *m = ResponseGetBlockResultsRetainHeight{} }
+func (m *ResponseGetBlockResultsRetainHeight) String() string { return proto.CompactTextString(m) }
+func (*ResponseGetBlockResultsRetainHeight) ProtoMessage()    {}
+func (*ResponseGetBlockResultsRetainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772847082e5ed4c, []int{7}
+}
+func (m *ResponseGetBlockResultsRetainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseGetBlockResultsRetainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseGetBlockResultsRetainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseGetBlockResultsRetainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseGetBlockResultsRetainHeight.Merge(m, src)
+}
+func (m *ResponseGetBlockResultsRetainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseGetBlockResultsRetainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseGetBlockResultsRetainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseGetBlockResultsRetainHeight proto.InternalMessageInfo
+
+func (m *ResponseGetBlockResultsRetainHeight) GetPruningServiceRetainHeight() int64 {
+	if m != nil {
+		return m.PruningServiceRetainHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RequestSetBlockRetainHeight)(nil), "tendermint.rpc.grpc.RequestSetBlockRetainHeight")
+	proto.RegisterType((*RequestGetBlockRetainHeight)(nil), "tendermint.rpc.grpc.RequestGetBlockRetainHeight")
+	proto.RegisterType((*RequestSetBlockResultsRetainHeight)(nil), "tendermint.rpc.grpc.RequestSetBlockResultsRetainHeight")
+	proto.RegisterType((*RequestGetBlockResultsRetainHeight)(nil), "tendermint.rpc.grpc.RequestGetBlockResultsRetainHeight")
+	proto.RegisterType((*ResponseSetBlockRetainHeight)(nil), "tendermint.rpc.grpc.ResponseSetBlockRetainHeight")
+	proto.RegisterType((*ResponseGetBlockRetainHeight)(nil), "tendermint.rpc.grpc.ResponseGetBlockRetainHeight")
+	proto.RegisterType((*ResponseSetBlockResultsRetainHeight)(nil), "tendermint.rpc.grpc.ResponseSetBlockResultsRetainHeight")
+	proto.RegisterType((*ResponseGetBlockResultsRetainHeight)(nil), "tendermint.rpc.grpc.ResponseGetBlockResultsRetainHeight")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/rpc/grpc/privileged.proto", fileDescriptor_8772847082e5ed4c)
+}
+
+var fileDescriptor_8772847082e5ed4c = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x4e, 0xfa, 0x40,
+	0x14, 0xc5, 0x99, 0xff, 0xdf, 0xb0, 0x98, 0x85, 0xc6, 0x6a, 0x8c, 0x01, 0x69, 0xcc, 0x80, 0x89,
+	0x71, 0x31, 0xf8, 0x11, 0xa3, 0x89, 0x6e, 0x64, 0x53, 0x96, 0xa6, 0xec, 0xdc, 0x10, 0x18, 0xae,
+	0xed, 0x44, 0xe8, 0x8c, 0xd3, 0x29, 0x1b, 0x9f, 0xc1, 0x84, 0x47, 0xf1, 0x31, 0x5c, 0xb2, 0x74,
+	0x69, 0xe0, 0x45, 0x4c, 0x2b, 0x4a, 0xea, 0x94, 0xcf, 0x4d, 0x73, 0xdb, 0x9e, 0x73, 0xcf, 0xaf,
+	0xb7, 0x37, 0x83, 0x2b, 0x1a, 0x82, 0x0e, 0xa8, 0x1e, 0x0f, 0x74, 0x55, 0x49, 0x56, 0xf5, 0xe2,
+	0x8b, 0x54, 0xbc, 0xcf, 0xbb, 0xe0, 0x41, 0x87, 0x4a, 0x25, 0xb4, 0xb0, 0x76, 0xa6, 0x2a, 0xaa,
+	0x24, 0xa3, 0xb1, 0x8a, 0x5c, 0xe2, 0xa2, 0x0b, 0xcf, 0x11, 0x84, 0xba, 0x01, 0xba, 0xd6, 0x15,
+	0xec, 0xc9, 0x05, 0xdd, 0xe2, 0x41, 0x1d, 0xb8, 0xe7, 0x6b, 0x6b, 0x0f, 0xe7, 0xfd, 0xa4, 0xda,
+	0x47, 0x87, 0xe8, 0xf8, 0xbf, 0x3b, 0xb9, 0x23, 0xa5, 0x5f, 0x9b, 0x93, 0x61, 0x23, 0xb7, 0x98,
+	0x18, 0x5d, 0xc3, 0xa8, 0xab, 0xc3, 0xa5, 0x9a, 0x57, 0x30, 0x31, 0x9a, 0x1b, 0x6e, 0x62, 0xe3,
+	0x03, 0x17, 0x42, 0x29, 0x82, 0x10, 0xb2, 0xd0, 0xc9, 0x2b, 0x9a, 0x0a, 0xb2, 0x20, 0xad, 0x13,
+	0xbc, 0xdd, 0x92, 0xb2, 0xa9, 0x92, 0x67, 0xcd, 0x14, 0xc9, 0x56, 0x4b, 0xca, 0x94, 0xf6, 0x0e,
+	0x97, 0xa4, 0x8a, 0x02, 0x1e, 0x78, 0xcd, 0x10, 0x54, 0x9f, 0x33, 0xf8, 0xe3, 0xfb, 0x97, 0xf8,
+	0x0a, 0x13, 0x51, 0xe3, 0x5b, 0x93, 0xe2, 0x39, 0xc2, 0x65, 0x93, 0xd7, 0xfc, 0x2c, 0x1f, 0x97,
+	0x4d, 0x6a, 0x73, 0x76, 0x0b, 0x81, 0xd0, 0x22, 0xa0, 0xf3, 0xb7, 0x0d, 0xbc, 0x79, 0x9f, 0x7a,
+	0x6d, 0xbd, 0xe0, 0xdd, 0xcc, 0x35, 0x38, 0xa5, 0x19, 0xbb, 0x43, 0xe7, 0x2c, 0x4e, 0xe1, 0x6c,
+	0x86, 0x63, 0xf6, 0x0f, 0x8b, 0xc3, 0x9d, 0x95, 0xc3, 0x9d, 0xd5, 0xc3, 0x33, 0x43, 0x06, 0x08,
+	0x17, 0xe7, 0xed, 0xea, 0xd5, 0x72, 0x13, 0x30, 0x8c, 0x85, 0xeb, 0x25, 0x07, 0x61, 0x46, 0xc6,
+	0x48, 0xce, 0xba, 0x48, 0xce, 0xda, 0x48, 0x73, 0x9c, 0xb5, 0xfa, 0xfb, 0xc8, 0x46, 0xc3, 0x91,
+	0x8d, 0x3e, 0x47, 0x36, 0x1a, 0x8c, 0xed, 0xdc, 0x70, 0x6c, 0xe7, 0x3e, 0xc6, 0x76, 0xee, 0x81,
+	0x7a, 0x5c, 0xfb, 0x51, 0x9b, 0x32, 0xd1, 0xab, 0x32, 0xd1, 0x03, 0xdd, 0x7e, 0xd4, 0xd3, 0xe2,
+	0xe7, 0x50, 0xba, 0x61, 0x42, 0x41, 0x5c, 0xb4, 0xf3, 0xc9, 0x99, 0x74, 0xf1, 0x35, 0x00, 0x9d,
+	0x97, 0x4a, 0x7a, 0xbb, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PruningServiceClient is the client API for PruningService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PruningServiceClient interface {
+	// SetBlockRetainHeight sets the height below which blocks may be pruned.
+	// Blocks are pruned up to the minimum of this height and the one requested
+	// by the application.
+	SetBlockRetainHeight(ctx context.Context, in *RequestSetBlockRetainHeight, opts ...grpc.CallOption) (*ResponseSetBlockRetainHeight, error)
+	GetBlockRetainHeight(ctx context.Context, in *RequestGetBlockRetainHeight, opts ...grpc.CallOption) (*ResponseGetBlockRetainHeight, error)
+	// SetBlockResultsRetainHeight sets the height below which block results may
+	// be pruned.
+	SetBlockResultsRetainHeight(ctx context.Context, in *RequestSetBlockResultsRetainHeight, opts ...grpc.CallOption) (*ResponseSetBlockResultsRetainHeight, error)
+	GetBlockResultsRetainHeight(ctx context.Context, in *RequestGetBlockResultsRetainHeight, opts ...grpc.CallOption) (*ResponseGetBlockResultsRetainHeight, error)
+}
+
+type pruningServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewPruningServiceClient(cc grpc1.ClientConn) PruningServiceClient {
+	return &pruningServiceClient{cc}
+}
+
+func (c *pruningServiceClient) SetBlockRetainHeight(ctx context.Context, in *RequestSetBlockRetainHeight, opts ...grpc.CallOption) (*ResponseSetBlockRetainHeight, error) {
+	out := new(ResponseSetBlockRetainHeight)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.PruningService/SetBlockRetainHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pruningServiceClient) GetBlockRetainHeight(ctx context.Context, in *RequestGetBlockRetainHeight, opts ...grpc.CallOption) (*ResponseGetBlockRetainHeight, error) {
+	out := new(ResponseGetBlockRetainHeight)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.PruningService/GetBlockRetainHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pruningServiceClient) SetBlockResultsRetainHeight(ctx context.Context, in *RequestSetBlockResultsRetainHeight, opts ...grpc.CallOption) (*ResponseSetBlockResultsRetainHeight, error) {
+	out := new(ResponseSetBlockResultsRetainHeight)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.PruningService/SetBlockResultsRetainHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pruningServiceClient) GetBlockResultsRetainHeight(ctx context.Context, in *RequestGetBlockResultsRetainHeight, opts ...grpc.CallOption) (*ResponseGetBlockResultsRetainHeight, error) {
+	out := new(ResponseGetBlockResultsRetainHeight)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.PruningService/GetBlockResultsRetainHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PruningServiceServer is the server API for PruningService service.
+type PruningServiceServer interface {
+	// SetBlockRetainHeight sets the height below which blocks may be pruned.
+	// Blocks are pruned up to the minimum of this height and the one requested
+	// by the application.
+	SetBlockRetainHeight(context.Context, *RequestSetBlockRetainHeight) (*ResponseSetBlockRetainHeight, error)
+	GetBlockRetainHeight(context.Context, *RequestGetBlockRetainHeight) (*ResponseGetBlockRetainHeight, error)
+	// SetBlockResultsRetainHeight sets the height below which block results may
+	// be pruned.
+	SetBlockResultsRetainHeight(context.Context, *RequestSetBlockResultsRetainHeight) (*ResponseSetBlockResultsRetainHeight, error)
+	GetBlockResultsRetainHeight(context.Context, *RequestGetBlockResultsRetainHeight) (*ResponseGetBlockResultsRetainHeight, error)
+}
+
+// UnimplementedPruningServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPruningServiceServer struct {
+}
+
+func (*UnimplementedPruningServiceServer) SetBlockRetainHeight(ctx context.Context, req *RequestSetBlockRetainHeight) (*ResponseSetBlockRetainHeight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockRetainHeight not implemented")
+}
+func (*UnimplementedPruningServiceServer) GetBlockRetainHeight(ctx context.Context, req *RequestGetBlockRetainHeight) (*ResponseGetBlockRetainHeight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRetainHeight not implemented")
+}
+func (*UnimplementedPruningServiceServer) SetBlockResultsRetainHeight(ctx context.Context, req *RequestSetBlockResultsRetainHeight) (*ResponseSetBlockResultsRetainHeight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockResultsRetainHeight not implemented")
+}
+func (*UnimplementedPruningServiceServer) GetBlockResultsRetainHeight(ctx context.Context, req *RequestGetBlockResultsRetainHeight) (*ResponseGetBlockResultsRetainHeight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockResultsRetainHeight not implemented")
+}
+
+func RegisterPruningServiceServer(s grpc1.Server, srv PruningServiceServer) {
+	s.RegisterService(&_PruningService_serviceDesc, srv)
+}
+
+func _PruningService_SetBlockRetainHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSetBlockRetainHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).SetBlockRetainHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.PruningService/SetBlockRetainHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).SetBlockRetainHeight(ctx, req.(*RequestSetBlockRetainHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PruningService_GetBlockRetainHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGetBlockRetainHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).GetBlockRetainHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.PruningService/GetBlockRetainHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).GetBlockRetainHeight(ctx, req.(*RequestGetBlockRetainHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PruningService_SetBlockResultsRetainHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSetBlockResultsRetainHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).SetBlockResultsRetainHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.PruningService/SetBlockResultsRetainHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).SetBlockResultsRetainHeight(ctx, req.(*RequestSetBlockResultsRetainHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PruningService_GetBlockResultsRetainHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGetBlockResultsRetainHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).GetBlockResultsRetainHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.PruningService/GetBlockResultsRetainHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).GetBlockResultsRetainHeight(ctx, req.(*RequestGetBlockResultsRetainHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PruningService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.rpc.grpc.PruningService",
+	HandlerType: (*PruningServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetBlockRetainHeight",
+			Handler:    _PruningService_SetBlockRetainHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockRetainHeight",
+			Handler:    _PruningService_GetBlockRetainHeight_Handler,
+		},
+		{
+			MethodName: "SetBlockResultsRetainHeight",
+			Handler:    _PruningService_SetBlockResultsRetainHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockResultsRetainHeight",
+			Handler:    _PruningService_GetBlockResultsRetainHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/rpc/grpc/privileged.proto",
+}
+
+func (m *RequestSetBlockRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSetBlockRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestSetBlockRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPrivileged(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestGetBlockRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestGetBlockRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestGetBlockRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestSetBlockResultsRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSetBlockResultsRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestSetBlockResultsRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPrivileged(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestGetBlockResultsRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestGetBlockResultsRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestGetBlockResultsRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseSetBlockRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseSetBlockRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseSetBlockRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseGetBlockRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseGetBlockRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseGetBlockRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PruningServiceRetainHeight != 0 {
+		i = encodeVarintPrivileged(dAtA, i, uint64(m.PruningServiceRetainHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppRetainHeight != 0 {
+		i = encodeVarintPrivileged(dAtA, i, uint64(m.AppRetainHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseSetBlockResultsRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseSetBlockResultsRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseSetBlockResultsRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseGetBlockResultsRetainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseGetBlockResultsRetainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseGetBlockResultsRetainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PruningServiceRetainHeight != 0 {
+		i = encodeVarintPrivileged(dAtA, i, uint64(m.PruningServiceRetainHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrivileged(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrivileged(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RequestSetBlockRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPrivileged(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestGetBlockRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestSetBlockResultsRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPrivileged(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestGetBlockResultsRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResponseSetBlockRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResponseGetBlockRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppRetainHeight != 0 {
+		n += 1 + sovPrivileged(uint64(m.AppRetainHeight))
+	}
+	if m.PruningServiceRetainHeight != 0 {
+		n += 1 + sovPrivileged(uint64(m.PruningServiceRetainHeight))
+	}
+	return n
+}
+
+func (m *ResponseSetBlockResultsRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResponseGetBlockResultsRetainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruningServiceRetainHeight != 0 {
+		n += 1 + sovPrivileged(uint64(m.PruningServiceRetainHeight))
+	}
+	return n
+}
+
+func sovPrivileged(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrivileged(x uint64) (n int) {
+	return sovPrivileged(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RequestSetBlockRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestSetBlockRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestSetBlockRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestGetBlockRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestGetBlockRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestGetBlockRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestSetBlockResultsRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestSetBlockResultsRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestSetBlockResultsRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestGetBlockResultsRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestGetBlockResultsRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestGetBlockResultsRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseSetBlockRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseSetBlockRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseSetBlockRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseGetBlockRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseGetBlockRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseGetBlockRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppRetainHeight", wireType)
+			}
+			m.AppRetainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppRetainHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningServiceRetainHeight", wireType)
+			}
+			m.PruningServiceRetainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningServiceRetainHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseSetBlockResultsRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseSetBlockResultsRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseSetBlockResultsRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseGetBlockResultsRetainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseGetBlockResultsRetainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseGetBlockResultsRetainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningServiceRetainHeight", wireType)
+			}
+			m.PruningServiceRetainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningServiceRetainHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivileged(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivileged
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrivileged(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrivileged
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrivileged
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrivileged
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrivileged
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrivileged
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrivileged        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrivileged          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrivileged = fmt.Errorf("proto: unexpected end of group")
+)
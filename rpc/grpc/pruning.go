@@ -0,0 +1,63 @@
+package coregrpc
+
+import (
+	"context"
+
+	sm "github.com/cometbft/cometbft/state"
+)
+
+// pruningService implements PruningServiceServer on top of the node's pruner.
+type pruningService struct {
+	pruner *sm.Pruner
+}
+
+var _ PruningServiceServer = (*pruningService)(nil)
+
+func (s *pruningService) SetBlockRetainHeight(
+	_ context.Context,
+	req *RequestSetBlockRetainHeight,
+) (*ResponseSetBlockRetainHeight, error) {
+	if err := s.pruner.SetCompanionBlockRetainHeight(req.Height); err != nil {
+		return nil, err
+	}
+	return &ResponseSetBlockRetainHeight{}, nil
+}
+
+func (s *pruningService) GetBlockRetainHeight(
+	context.Context,
+	*RequestGetBlockRetainHeight,
+) (*ResponseGetBlockRetainHeight, error) {
+	appRetainHeight, err := s.pruner.GetApplicationRetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	svcRetainHeight, err := s.pruner.GetCompanionBlockRetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseGetBlockRetainHeight{
+		AppRetainHeight:            appRetainHeight,
+		PruningServiceRetainHeight: svcRetainHeight,
+	}, nil
+}
+
+func (s *pruningService) SetBlockResultsRetainHeight(
+	_ context.Context,
+	req *RequestSetBlockResultsRetainHeight,
+) (*ResponseSetBlockResultsRetainHeight, error) {
+	if err := s.pruner.SetABCIResRetainHeight(req.Height); err != nil {
+		return nil, err
+	}
+	return &ResponseSetBlockResultsRetainHeight{}, nil
+}
+
+func (s *pruningService) GetBlockResultsRetainHeight(
+	context.Context,
+	*RequestGetBlockResultsRetainHeight,
+) (*ResponseGetBlockResultsRetainHeight, error) {
+	svcRetainHeight, err := s.pruner.GetABCIResRetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseGetBlockResultsRetainHeight{PruningServiceRetainHeight: svcRetainHeight}, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	coregrpc "github.com/cometbft/cometbft/rpc/grpc"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 )
 
@@ -27,6 +28,7 @@ import (
 type Options struct {
 	suppressStdout bool
 	recreateConfig bool
+	dataCompanion  bool
 }
 
 var (
@@ -75,8 +77,10 @@ func randPort() int {
 	return port
 }
 
-func makeAddrs() (string, string) {
+func makeAddrs() (string, string, string, string) {
 	return fmt.Sprintf("tcp://127.0.0.1:%d", randPort()),
+		fmt.Sprintf("tcp://127.0.0.1:%d", randPort()),
+		fmt.Sprintf("tcp://127.0.0.1:%d", randPort()),
 		fmt.Sprintf("tcp://127.0.0.1:%d", randPort())
 }
 
@@ -85,9 +89,11 @@ func createConfig() *cfg.Config {
 	c := test.ResetTestRoot(pathname)
 
 	// and we use random ports to run in parallel
-	tm, rpc := makeAddrs()
+	tm, rpc, grpc, privilegedGRPC := makeAddrs()
 	c.P2P.ListenAddress = tm
 	c.RPC.ListenAddress = rpc
+	c.RPC.GRPCListenAddress = grpc
+	c.RPC.GRPCPrivilegedListenAddress = privilegedGRPC
 	c.RPC.CORSAllowedOrigins = []string{"https://cometbft.com/"}
 	return c
 }
@@ -100,6 +106,27 @@ func GetConfig(forceCreate ...bool) *cfg.Config {
 	return globalConfig
 }
 
+// GetGRPCClient returns a gRPC client connected to the test node.
+func GetGRPCClient() coregrpc.RPCServiceClient {
+	grpcAddr := globalConfig.RPC.GRPCListenAddress
+	client, err := coregrpc.StartGRPCClient(grpcAddr)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// GetPrivilegedGRPCClient returns a client of the pruning service of the
+// privileged gRPC server of the test node.
+func GetPrivilegedGRPCClient() coregrpc.PruningServiceClient {
+	grpcAddr := globalConfig.RPC.GRPCPrivilegedListenAddress
+	client, err := coregrpc.StartPrivilegedGRPCClient(grpcAddr)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
 // StartTendermint starts a test CometBFT server in a go routine and returns when it is initialized
 func StartTendermint(app abci.Application, opts ...func(*Options)) *nm.Node {
 	nodeOpts := defaultOptions
@@ -136,6 +163,7 @@ func StopTendermint(node *nm.Node) {
 func NewTendermint(app abci.Application, opts *Options) *nm.Node {
 	// Create & start node
 	config := GetConfig(opts.recreateConfig)
+	config.Storage.Pruning.DataCompanion.Enabled = opts.dataCompanion
 	var logger log.Logger
 	if opts.suppressStdout {
 		logger = log.NewNopLogger()
@@ -168,6 +196,12 @@ func SuppressStdout(o *Options) {
 	o.suppressStdout = true
 }
 
+// EnableDataCompanion enables the data companion in the pruning config, for
+// the tests of the pruning service.
+func EnableDataCompanion(o *Options) {
+	o.dataCompanion = true
+}
+
 // RecreateConfig instructs the RPC test to recreate the configuration each
 // time, instead of treating it as a global singleton.
 func RecreateConfig(o *Options) {
@@ -0,0 +1,193 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// StoreIssue is an inconsistency between the block store and the state store
+// found by CheckStores.
+type StoreIssue struct {
+	Height      int64
+	Description string
+	// Repaired is true if CheckStores repaired the issue.
+	Repaired bool
+}
+
+func (i StoreIssue) String() string {
+	if i.Repaired {
+		return fmt.Sprintf("height %d: %s (repaired)", i.Height, i.Description)
+	}
+	return fmt.Sprintf("height %d: %s", i.Height, i.Description)
+}
+
+// CheckStores verifies that the blocks stored by bs between the heights from
+// and to (inclusive) are linked to each other, and to the validator sets,
+// consensus params and finalize block responses stored by ss, and returns the
+// inconsistencies found. A height of 0 stands for the base of the block store
+// for from, and for its latest height for to.
+//
+// If repair is true, the inconsistencies which can be trivially recovered
+// from are repaired: the blocks stored beyond the block following the last
+// state, and an incomplete block following the last state, are deleted, since
+// they can be fetched from peers again.
+//
+// The node must not be running while the stores are checked.
+func CheckStores(bs BlockStore, ss Store, from, to int64, repair bool) ([]StoreIssue, error) {
+	state, err := ss.Load()
+	if err != nil {
+		return nil, err
+	}
+	if state.IsEmpty() {
+		return nil, errors.New("no state found")
+	}
+
+	var issues []StoreIssue
+	addIssue := func(height int64, format string, args ...any) {
+		issues = append(issues, StoreIssue{Height: height, Description: fmt.Sprintf(format, args...)})
+	}
+
+	// NOTE: persistence of state and blocks don't happen atomically, the block
+	// following the last state is replayed on startup, but the blocks beyond it
+	// make the handshake fail.
+	stateHeight := state.LastBlockHeight
+	for height := bs.Height(); height > stateHeight; height-- {
+		incomplete := isIncomplete(bs, height)
+		if height == stateHeight+1 && !incomplete {
+			break
+		}
+		issue := StoreIssue{Height: height, Description: "block is beyond the block following the last state"}
+		if incomplete {
+			issue.Description = "block following the last state is incomplete"
+		}
+		if repair {
+			if err := bs.DeleteLatestBlock(); err != nil {
+				return issues, fmt.Errorf("failed to delete block %d: %w", height, err)
+			}
+			issue.Repaired = true
+		}
+		issues = append(issues, issue)
+	}
+	// NOTE: the block store is empty until the first block is saved after
+	// state sync.
+	if bs.Height() > 0 && bs.Height() < stateHeight {
+		addIssue(stateHeight, "last state is beyond the block store height %d", bs.Height())
+	} else if meta := bs.LoadBlockMeta(stateHeight); meta != nil && !meta.BlockID.Equals(state.LastBlockID) {
+		addIssue(stateHeight, "last block ID of the state %v does not match the block ID %v",
+			state.LastBlockID, meta.BlockID)
+	}
+
+	if from <= 0 || from < bs.Base() {
+		from = bs.Base()
+	}
+	if to <= 0 || to > bs.Height() {
+		to = bs.Height()
+	}
+	abciResRetainHeight, err := ss.GetABCIResRetainHeight()
+	if err != nil {
+		return issues, err
+	}
+
+	var prevMeta *types.BlockMeta
+	if from > bs.Base() {
+		prevMeta = bs.LoadBlockMeta(from - 1)
+	}
+	for height := from; height > 0 && height <= to; height++ {
+		meta := bs.LoadBlockMeta(height)
+		if meta == nil {
+			addIssue(height, "block meta is missing")
+			prevMeta = nil
+			continue
+		}
+		block := bs.LoadBlock(height)
+		if block == nil {
+			addIssue(height, "block parts are missing")
+			prevMeta = meta
+			continue
+		}
+		header := &block.Header
+		if !bytes.Equal(block.Hash(), meta.BlockID.Hash) {
+			addIssue(height, "block hash %X does not match the block meta hash %X", block.Hash(), meta.BlockID.Hash)
+		}
+		if bs.LoadSeenCommit(height) == nil && bs.LoadBlockCommit(height) == nil {
+			addIssue(height, "commit is missing")
+		}
+
+		if prevMeta != nil {
+			if !header.LastBlockID.Equals(prevMeta.BlockID) {
+				addIssue(height, "last block ID %v does not match the block ID %v of the previous block",
+					header.LastBlockID, prevMeta.BlockID)
+			}
+			if !block.LastCommit.BlockID.Equals(prevMeta.BlockID) {
+				addIssue(height, "last commit block ID %v does not match the block ID %v of the previous block",
+					block.LastCommit.BlockID, prevMeta.BlockID)
+			}
+		}
+		prevMeta = meta
+
+		// The validators and consensus params of the block following the last
+		// state are already stored.
+		if height > stateHeight+1 {
+			continue
+		}
+		if vals, err := ss.LoadValidators(height); err != nil {
+			addIssue(height, "failed to load validators: %v", err)
+		} else if !bytes.Equal(vals.Hash(), header.ValidatorsHash) {
+			addIssue(height, "validators hash %X does not match the stored validators %X",
+				header.ValidatorsHash, vals.Hash())
+		}
+		if vals, err := ss.LoadValidators(height + 1); err != nil {
+			addIssue(height, "failed to load next validators: %v", err)
+		} else if !bytes.Equal(vals.Hash(), header.NextValidatorsHash) {
+			addIssue(height, "next validators hash %X does not match the stored validators %X",
+				header.NextValidatorsHash, vals.Hash())
+		}
+		if params, err := ss.LoadConsensusParams(height); err != nil {
+			addIssue(height, "failed to load consensus params: %v", err)
+		} else if !bytes.Equal(params.Hash(), header.ConsensusHash) {
+			addIssue(height, "consensus hash %X does not match the stored consensus params %X",
+				header.ConsensusHash, params.Hash())
+		}
+
+		// The results of the previous block are in the header. They are not
+		// stored for the height a node state synced to.
+		if height == state.InitialHeight || height == bs.Base() || height-1 < abciResRetainHeight {
+			continue
+		}
+		resp, err := ss.LoadFinalizeBlockResponse(height - 1)
+		if errors.Is(err, ErrFinalizeBlockResponsesNotPersisted) {
+			continue
+		}
+		if err != nil {
+			addIssue(height-1, "failed to load finalize block response: %v", err)
+			continue
+		}
+		if resultsHash := TxResultsHash(resp.TxResults); !bytes.Equal(resultsHash, header.LastResultsHash) {
+			addIssue(height, "last results hash %X does not match the stored finalize block response %X",
+				header.LastResultsHash, resultsHash)
+		}
+		if !bytes.Equal(resp.AppHash, header.AppHash) {
+			addIssue(height, "app hash %X does not match the stored finalize block response %X",
+				header.AppHash, resp.AppHash)
+		}
+	}
+	return issues, nil
+}
+
+// isIncomplete returns true if the block meta or a part of the block at the
+// given height is missing.
+func isIncomplete(bs BlockStore, height int64) bool {
+	meta := bs.LoadBlockMeta(height)
+	if meta == nil {
+		return true
+	}
+	for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+		if bs.LoadBlockPart(height, i) == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestCheckStores(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(), mp, sm.EmptyEvidencePool{}, blockStore)
+
+	// saves the block following the state, and applies it if apply is true
+	lastCommit := new(types.Commit)
+	lastBlockID := state.LastBlockID
+	addBlock := func(apply bool) {
+		height := blockStore.Height() + 1
+		s := state.Copy()
+		s.LastBlockID = lastBlockID
+		block := s.MakeBlock(height, nil, lastCommit, nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		extCommit, _, err := makeValidCommit(height, blockID, state.Validators, privVals)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, partSet, extCommit.ToCommit())
+		lastCommit, lastBlockID = extCommit.ToCommit(), blockID
+		if apply {
+			state, err = blockExec.ApplyBlock(state, blockID, block)
+			require.NoError(t, err)
+		}
+	}
+	for i := 0; i < 5; i++ {
+		addBlock(true)
+	}
+
+	issues, err := sm.CheckStores(blockStore, stateStore, 0, 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	// the block following the state is replayed on startup
+	addBlock(false)
+	issues, err = sm.CheckStores(blockStore, stateStore, 0, 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	// the blocks beyond it are deleted when repairing
+	addBlock(false)
+	addBlock(false)
+	issues, err = sm.CheckStores(blockStore, stateStore, 0, 0, false)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	assert.EqualValues(t, 8, issues[0].Height)
+	assert.EqualValues(t, 7, issues[1].Height)
+	assert.False(t, issues[0].Repaired)
+	assert.EqualValues(t, 8, blockStore.Height())
+
+	issues, err = sm.CheckStores(blockStore, stateStore, 0, 0, true)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	assert.True(t, issues[0].Repaired)
+	assert.True(t, issues[1].Repaired)
+	assert.EqualValues(t, 6, blockStore.Height())
+
+	issues, err = sm.CheckStores(blockStore, stateStore, 0, 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	// mismatches with the state store are reported
+	require.NoError(t, stateStore.SaveValidators(3, 3, genValSet(2)))
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(4, &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{Code: 1}},
+		AppHash:   []byte("app_hash"),
+	}))
+	issues, err = sm.CheckStores(blockStore, stateStore, 2, 5, true)
+	require.NoError(t, err)
+	heights := make([]int64, len(issues))
+	for i, issue := range issues {
+		heights[i] = issue.Height
+		assert.False(t, issue.Repaired)
+	}
+	// the next validators of 2, the validators of 3, the results and app
+	// hash of 4 in 5
+	assert.Equal(t, []int64{2, 3, 5, 5}, heights)
+
+	// the heights outside of the range are not checked
+	issues, err = sm.CheckStores(blockStore, stateStore, 6, 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
@@ -51,6 +51,11 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	ErrInvalidRetainHeight struct {
+		Height     int64
+		LastHeight int64
+	}
 )
 
 func (e ErrUnknownBlock) Error() string {
@@ -103,4 +108,10 @@ func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("could not find results for height #%d", e.Height)
 }
 
+func (e ErrInvalidRetainHeight) Error() string {
+	return fmt.Sprintf("retain height %d must be greater than 0 and not greater than the last height %d", e.Height, e.LastHeight)
+}
+
+var ErrPrunerCompanionNotEnabled = errors.New("the data companion is not enabled in the pruning configuration")
+
 var ErrFinalizeBlockResponsesNotPersisted = errors.New("node is not persisting finalize block responses")
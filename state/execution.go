@@ -40,6 +40,10 @@ type BlockExecutor struct {
 	mempool mempool.Mempool
 	evpool  EvidencePool
 
+	// prunes blocks in the background, up to the retain height requested
+	// by the app.
+	pruner *Pruner
+
 	logger log.Logger
 
 	metrics *Metrics
@@ -53,6 +57,15 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithPruner sets the pruner that is told about the retain
+// height requested by the app. Without a pruner, the blocks are pruned
+// synchronously, when the block is applied.
+func BlockExecutorWithPruner(pruner *Pruner) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.pruner = pruner
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -296,8 +309,13 @@ func (blockExec *BlockExecutor) ApplyBlock(
 
 	fail.Fail() // XXX
 
-	// Prune old heights, if requested by ABCI app.
-	if retainHeight > 0 {
+	// Prune old heights, if requested by ABCI app: in the background if there
+	// is a pruner, synchronously otherwise.
+	if retainHeight > 0 && blockExec.pruner != nil {
+		if err := blockExec.pruner.SetApplicationBlockRetainHeight(retainHeight); err != nil {
+			blockExec.logger.Error("failed to set application block retain height", "retain_height", retainHeight, "err", err)
+		}
+	} else if retainHeight > 0 {
 		pruned, err := blockExec.pruneBlocks(retainHeight, state)
 		if err != nil {
 			blockExec.logger.Error("failed to prune blocks", "retain_height", retainHeight, "err", err)
@@ -644,8 +662,9 @@ func fireEvents(
 	if len(block.Evidence.Evidence) != 0 {
 		for _, ev := range block.Evidence.Evidence {
 			if err := eventBus.PublishEventNewEvidence(types.EventDataNewEvidence{
-				Evidence: ev,
-				Height:   block.Height,
+				Evidence:           ev,
+				Height:             block.Height,
+				ValidatorAddresses: types.EvidenceValidatorAddresses(ev),
 			}); err != nil {
 				logger.Error("failed publishing new evidence", "err", err)
 			}
@@ -665,7 +684,10 @@ func fireEvents(
 
 	if len(validatorUpdates) > 0 {
 		if err := eventBus.PublishEventValidatorSetUpdates(
-			types.EventDataValidatorSetUpdates{ValidatorUpdates: validatorUpdates}); err != nil {
+			types.EventDataValidatorSetUpdates{
+				Height:           block.Height,
+				ValidatorUpdates: validatorUpdates,
+			}); err != nil {
 			logger.Error("failed publishing event", "err", err)
 		}
 	}
@@ -733,5 +755,10 @@ func (blockExec *BlockExecutor) pruneBlocks(retainHeight int64, state State) (ui
 	if err != nil {
 		return 0, fmt.Errorf("failed to prune state store: %w", err)
 	}
+
+	// Without a pruner, the ABCI responses are retained along with the blocks.
+	if _, err := blockExec.Store().PruneABCIResponses(retainHeight); err != nil {
+		return 0, fmt.Errorf("failed to prune ABCI responses: %w", err)
+	}
 	return amountPruned, nil
 }
@@ -41,6 +41,9 @@ func IndexerFromConfig(cfg *config.Config, dbProvider config.DBProvider, chainID
 		}
 		return es.TxIndexer(), es.BlockIndexer(), nil
 
+	case "sqlite":
+		return sqliteIndexers(cfg, chainID)
+
 	default:
 		return &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, nil
 	}
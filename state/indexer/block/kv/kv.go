@@ -91,6 +91,9 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// The heights matching each conjunction of the query are searched separately,
+// and their union is returned.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -100,8 +103,88 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions := q.Syntax()
+	conjunctions, err := q.Syntax().Conjunctions()
+	if err != nil {
+		return nil, err
+	}
+
+	filteredHeights := make(map[string][]byte)
+	for _, conj := range conjunctions {
+		heights, err := idx.searchConjunction(ctx, conj)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range heights {
+			filteredHeights[k] = v
+		}
+	}
 
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	resultMap := make(map[int64]struct{})
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			if _, ok := resultMap[h]; !ok {
+				resultMap[h] = struct{}{}
+				results = append(results, h)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// searchConjunction returns the heights of the blocks matching all the
+// conditions of conj and none of its negated conditions.
+func (idx *BlockerIndexer) searchConjunction(ctx context.Context, conj syntax.Conjunction) (map[string][]byte, error) {
+	if len(conj.Conditions) == 0 {
+		return nil, indexer.ErrNegatedConditionsOnly
+	}
+
+	filteredHeights, err := idx.searchConditions(ctx, conj.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range conj.Negated {
+		if len(filteredHeights) == 0 {
+			break
+		}
+		excludedHeights, err := idx.searchConditions(ctx, []syntax.Condition{c})
+		if err != nil {
+			return nil, err
+		}
+		excluded := make(map[int64]struct{}, len(excludedHeights))
+		for _, hBz := range excludedHeights {
+			excluded[int64FromBytes(hBz)] = struct{}{}
+		}
+		for k, hBz := range filteredHeights {
+			if _, ok := excluded[int64FromBytes(hBz)]; ok {
+				delete(filteredHeights, k)
+			}
+		}
+	}
+	return filteredHeights, nil
+}
+
+// searchConditions returns the heights of the blocks matching all the given
+// conditions.
+func (idx *BlockerIndexer) searchConditions(ctx context.Context, conditions []syntax.Condition) (map[string][]byte, error) {
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
 
@@ -130,10 +213,11 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 
 		if ok {
-			return []int64{heightInfo.height}, nil
+			hBz := int64ToBytes(heightInfo.height)
+			return map[string][]byte{string(hBz): hBz}, nil
 		}
 
-		return results, nil
+		return map[string][]byte{}, nil
 	}
 
 	var heightsInitialized bool
@@ -218,34 +302,7 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	resultMap := make(map[int64]struct{})
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
-
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			if _, ok := resultMap[h]; !ok {
-				resultMap[h] = struct{}{}
-				results = append(results, h)
-			}
-		}
-
-		select {
-		case <-ctx.Done():
-			break
-
-		default:
-		}
-	}
-
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	return results, nil
+	return filteredHeights, nil
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
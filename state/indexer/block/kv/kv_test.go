@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	stateindexer "github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/types"
 )
@@ -130,6 +131,26 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustCompile("end_event.foo CONTAINS '1'"),
 			results: []int64{1, 10},
 		},
+		"block.height = 3 OR block.height = 5": {
+			q:       query.MustCompile("block.height = 3 OR block.height = 5"),
+			results: []int64{3, 5},
+		},
+		"end_event.foo <= 5 OR end_event.foo >= 100": {
+			q:       query.MustCompile("end_event.foo <= 5 OR end_event.foo >= 100"),
+			results: []int64{1, 2, 4},
+		},
+		"begin_event.proposer = 'FCAA001' AND NOT end_event.foo EXISTS": {
+			q:       query.MustCompile("begin_event.proposer = 'FCAA001' AND NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"(block.height = 2 OR block.height = 3) AND NOT end_event.foo <= 2": {
+			q:       query.MustCompile("(block.height = 2 OR block.height = 3) AND NOT end_event.foo <= 2"),
+			results: []int64{3},
+		},
+		"block.height > 8 AND NOT (end_event.foo = 10 OR end_event.foo = 100)": {
+			q:       query.MustCompile("block.height > 8 AND NOT (end_event.foo = 10 OR end_event.foo = 100)"),
+			results: []int64{9, 11},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -140,6 +161,9 @@ func TestBlockIndexer(t *testing.T) {
 			require.Equal(t, tc.results, results)
 		})
 	}
+
+	_, err := indexer.Search(context.Background(), query.MustCompile("NOT end_event.foo EXISTS"))
+	require.ErrorIs(t, err, stateindexer.ErrNegatedConditionsOnly)
 }
 
 func TestBlockIndexerMulti(t *testing.T) {
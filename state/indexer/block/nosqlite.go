@@ -0,0 +1,18 @@
+//go:build !sqlite
+// +build !sqlite
+
+package block
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+// sqliteIndexers fails, as the SQLite event sink is only built with the sqlite
+// build tag.
+func sqliteIndexers(*config.Config, string) (txindex.TxIndexer, indexer.BlockIndexer, error) {
+	return nil, nil, errors.New("the sqlite indexer requires a binary built with the sqlite build tag")
+}
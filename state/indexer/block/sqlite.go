@@ -0,0 +1,24 @@
+//go:build sqlite
+// +build sqlite
+
+package block
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+// sqliteIndexers returns the indexers of the SQLite event sink in the data
+// directory of the node.
+func sqliteIndexers(cfg *config.Config, chainID string) (txindex.TxIndexer, indexer.BlockIndexer, error) {
+	es, err := sqlite.NewEventSink(filepath.Join(cfg.DBDir(), sqlite.FileName), chainID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating sqlite indexer: %w", err)
+	}
+	return es.TxIndexer(), es.BlockIndexer(), nil
+}
@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"errors"
 	"math/big"
 	"time"
 
@@ -8,6 +9,11 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
+// ErrNegatedConditionsOnly is returned when searching for a conjunction of
+// negated conditions only, as it cannot be done without a full scan of the
+// index.
+var ErrNegatedConditionsOnly = errors.New("cannot search for a conjunction of negated conditions only")
+
 // QueryRanges defines a mapping between a composite event key and a QueryRange.
 //
 // e.g.account.number => queryRange{lowerBound: 1, upperBound: 5}
@@ -0,0 +1,117 @@
+// Package sqlquery translates event queries into SQL conditions, for the
+// indexer sinks backed by a SQL database. The sinks share the layout of the
+// events and attributes tables, and differ in the placeholders of their
+// arguments and in how they compare an attribute value with a condition.
+package sqlquery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+const (
+	tableEvents     = "events"
+	tableAttributes = "attributes"
+)
+
+// Builder translates a query into a SQL condition selecting the blocks or
+// transactions whose events match it. The condition refers to the row being
+// selected through Owner, and its arguments are collected in Args.
+type Builder struct {
+	// Owner is the condition restricting the events to those of the selected
+	// row, e.g. "events.tx_id = tx_results.rowid".
+	Owner string
+	// Placeholder returns the placeholder of the n-th argument, starting from
+	// 1, e.g. "$1".
+	Placeholder func(n int) string
+	// Value returns the SQL condition on the attribute value column for the
+	// comparison of cond, prefixed with " AND ", or nothing for an existence
+	// check. It adds its arguments with Arg.
+	Value func(b *Builder, column string, cond syntax.Condition) (string, error)
+
+	Args []interface{}
+}
+
+// Where returns the SQL condition matching q. The arguments of the condition
+// are numbered after the ones already collected in b.Args.
+func (b *Builder) Where(q *query.Query) (string, error) {
+	expr := q.Syntax().Expr
+	if expr == nil {
+		return "TRUE", nil
+	}
+	return b.expr(expr)
+}
+
+// Arg adds an argument to the query, returning its placeholder.
+func (b *Builder) Arg(v interface{}) string {
+	b.Args = append(b.Args, v)
+	return b.Placeholder(len(b.Args))
+}
+
+func (b *Builder) expr(expr syntax.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case syntax.Condition:
+		return b.condition(expr)
+	case syntax.Not:
+		sub, err := b.expr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + sub, nil
+	case syntax.And:
+		return b.exprs(expr, " AND ")
+	case syntax.Or:
+		return b.exprs(expr, " OR ")
+	default:
+		return "", fmt.Errorf("unexpected expression %T", expr)
+	}
+}
+
+func (b *Builder) exprs(exprs []syntax.Expr, sep string) (string, error) {
+	subs := make([]string, len(exprs))
+	for i, expr := range exprs {
+		sub, err := b.expr(expr)
+		if err != nil {
+			return "", err
+		}
+		subs[i] = sub
+	}
+	return "(" + strings.Join(subs, sep) + ")", nil
+}
+
+// condition returns the SQL condition matching cond. Like the query package,
+// it matches an event having an attribute with the composite key of the tag
+// whose value satisfies the comparison, or an event whose type is the tag if
+// an empty value satisfies it.
+func (b *Builder) condition(cond syntax.Condition) (string, error) {
+	tag := b.Arg(cond.Tag)
+	value, err := b.Value(b, tableAttributes+".value", cond)
+	if err != nil {
+		return "", fmt.Errorf("translate %s: %w", cond, err)
+	}
+	sql := `EXISTS (SELECT 1 FROM ` + tableEvents + ` JOIN ` + tableAttributes +
+		` ON (` + tableAttributes + `.event_id = ` + tableEvents + `.rowid) WHERE ` +
+		b.Owner + ` AND ` + tableAttributes + `.composite_key = ` + tag + value + `)`
+	if matchesEmpty(cond) {
+		sql = `(` + sql + ` OR EXISTS (SELECT 1 FROM ` + tableEvents + ` WHERE ` +
+			b.Owner + ` AND ` + tableEvents + `.type = ` + tag + `))`
+	}
+	return sql, nil
+}
+
+// matchesEmpty reports whether the comparison of cond is satisfied by an empty
+// value, with which the query package matches the events whose type is the
+// condition tag.
+func matchesEmpty(cond syntax.Condition) bool {
+	switch cond.Op {
+	case syntax.TExists:
+		return true
+	case syntax.TEq, syntax.TContains:
+		return cond.Arg != nil && cond.Arg.Type == syntax.TString && cond.Arg.Value() == ""
+	default:
+		return false
+	}
+}
@@ -15,7 +15,6 @@ package psql
 
 import (
 	"context"
-	"errors"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -48,16 +47,16 @@ func (b BackportTxIndexer) Index(txr *abci.TxResult) error {
 	return b.psql.IndexTxEvents([]*abci.TxResult{txr})
 }
 
-// Get is implemented to satisfy the TxIndexer interface, but is not supported
-// by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Get([]byte) (*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Get method is not supported")
+// Get returns the transaction result with the given hash from Postgres, as
+// part of TxIndexer.
+func (b BackportTxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return b.psql.GetTxByHash(hash)
 }
 
-// Search is implemented to satisfy the TxIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Search(context.Context, *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Search method is not supported")
+// Search queries the transaction results matching q in Postgres, as part of
+// TxIndexer.
+func (b BackportTxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return b.psql.SearchTxEvents(ctx, q)
 }
 
 func (BackportTxIndexer) SetLogger(log.Logger) {}
@@ -72,10 +71,10 @@ func (es *EventSink) BlockIndexer() BackportBlockIndexer {
 // delegating indexing operations to an underlying PostgreSQL event sink.
 type BackportBlockIndexer struct{ psql *EventSink }
 
-// Has is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Has(_ int64) (bool, error) {
-	return false, errors.New("the BlockIndexer.Has method is not supported")
+// Has reports whether the block at the given height has been indexed in
+// Postgres. It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Has(height int64) (bool, error) {
+	return b.psql.HasBlock(height)
 }
 
 // Index indexes block begin and end events for the specified block.  It is
@@ -84,10 +83,10 @@ func (b BackportBlockIndexer) Index(block types.EventDataNewBlockEvents) error {
 	return b.psql.IndexBlockEvents(block)
 }
 
-// Search is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Search(context.Context, *query.Query) ([]int64, error) {
-	return nil, errors.New("the BlockIndexer.Search method is not supported")
+// Search queries the heights of the blocks matching q in Postgres. It is part
+// of the BlockIndexer interface.
+func (b BackportBlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.psql.SearchBlockEvents(ctx, q)
 }
 
 func (BackportBlockIndexer) SetLogger(log.Logger) {}
@@ -4,7 +4,6 @@ package psql
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -13,6 +12,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -226,24 +226,112 @@ INSERT INTO `+tableTxResults+` (block_id, index, created_at, tx_hash, tx_result)
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+// SearchBlockEvents returns the heights of the blocks whose events match q, in
+// ascending order. It is part of the indexer.EventSink interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	b := newQueryBuilder(tableEvents + `.block_id = ` + tableBlocks + `.rowid AND ` +
+		tableEvents + `.tx_id IS NULL`)
+	chainID := b.Arg(es.chainID)
+	where, err := b.Where(q)
+	if err != nil {
+		return nil, fmt.Errorf("translating query: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT height FROM `+tableBlocks+`
+  WHERE chain_id = `+chainID+` AND `+where+`
+  ORDER BY height;
+`, b.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("searching blocks: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchTxEvents(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+// SearchTxEvents returns the results of the transactions whose events match
+// q, ordered by height and index. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	b := newQueryBuilder(tableEvents + `.tx_id = ` + tableTxResults + `.rowid`)
+	chainID := b.Arg(es.chainID)
+	where, err := b.Where(q)
+	if err != nil {
+		return nil, fmt.Errorf("translating query: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE chain_id = `+chainID+` AND `+where+`
+  ORDER BY height, index;
+`, b.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var txrs []*abci.TxResult
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, fmt.Errorf("searching transactions: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		txrs = append(txrs, txr)
+	}
+	return txrs, rows.Err()
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) GetTxByHash(_ []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed. It is part of the indexer.EventSink interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var resultData []byte
+	err := es.store.QueryRow(`
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE tx_hash = $1 AND chain_id = $2
+  ORDER BY height DESC LIMIT 1;
+`, fmt.Sprintf("%X", hash), es.chainID).Scan(&resultData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("finding tx_result: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) HasBlock(_ int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+// HasBlock reports whether the block at the given height has been indexed. It
+// is part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var found bool
+	if err := es.store.QueryRow(`
+SELECT EXISTS (SELECT 1 FROM `+tableBlocks+` WHERE height = $1 AND chain_id = $2);
+`, height, es.chainID).Scan(&found); err != nil {
+		return false, fmt.Errorf("finding block: %w", err)
+	}
+	return found, nil
 }
 
 // Stop closes the underlying PostgreSQL database.
@@ -20,6 +20,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 
@@ -146,13 +147,16 @@ func TestIndexing(t *testing.T) {
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
+		has, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, has)
+		has, err = indexer.HasBlock(3)
+		require.NoError(t, err)
+		assert.False(t, has)
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile("end_event.foo = 100"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -185,14 +189,18 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txr, err = indexer.GetTxByHash(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		txrs, err := indexer.SearchTxEvents(context.Background(), query.MustCompile("account.owner = 'Ivan'"))
+		require.NoError(t, err)
+		require.Len(t, txrs, 1)
+		assert.Equal(t, txResult, txrs[0])
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -250,6 +258,88 @@ func TestIndexing(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	// Use a separate chain, so that the records of the other tests don't match.
+	indexer := &EventSink{store: testDB(), chainID: "search-chainID"}
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockEvents{
+			Height: height,
+			Events: []abci.Event{
+				makeIndexedEvent("reward.amount", fmt.Sprintf("%dstake", 10*height)),
+				makeIndexedEvent("epoch.start", fmt.Sprintf("2023-01-0%d", height)),
+			},
+		}))
+	}
+	txResults := []*abci.TxResult{
+		{Height: 1, Index: 0, Tx: types.Tx("tx1"), Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("transfer.sender", "alice"),
+			makeIndexedEvent("transfer.amount", "5"),
+		}}},
+		{Height: 2, Index: 0, Tx: types.Tx("tx2"), Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("transfer.sender", "bob"),
+			makeIndexedEvent("transfer.amount", "50"),
+			makeIndexedEvent("transfer.time", "2023-01-02T10:00:00+02:00"),
+		}}},
+		{Height: 2, Index: 1, Tx: types.Tx("tx3"), Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("transfer.sender", "alice-bob"),
+			makeIndexedEvent("transfer.time", "2023-01-02T10:00:00Z"),
+			{Type: "burn"},
+		}}},
+	}
+	require.NoError(t, indexer.IndexTxEvents(txResults))
+
+	t.Run("SearchTxEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []*abci.TxResult
+		}{
+			{"tx.height >= 1", txResults},
+			{"tx.height = 2", txResults[1:]},
+			{"tx.hash = '" + fmt.Sprintf("%X", types.Tx("tx1").Hash()) + "'", txResults[:1]},
+			{"transfer.sender = 'alice'", txResults[:1]},
+			{"transfer.sender CONTAINS 'bob'", txResults[1:]},
+			{"transfer.amount > 5", txResults[1:2]},
+			{"transfer.amount <= 5 OR transfer.sender = 'bob'", txResults[:2]},
+			{"tx.height = 2 AND NOT transfer.amount EXISTS", txResults[2:]},
+			{"transfer.time > TIME 2023-01-02T09:00:00Z", txResults[2:]},
+			{"burn EXISTS", txResults[2:]},
+			{"transfer.sender = 'carol'", nil},
+		}
+		for _, tc := range testCases {
+			txrs, err := indexer.SearchTxEvents(context.Background(), query.MustCompile(tc.query))
+			require.NoError(t, err, tc.query)
+			require.Len(t, txrs, len(tc.want), tc.query)
+			for i := range tc.want {
+				assert.Equal(t, tc.want[i].Tx, txrs[i].Tx, tc.query)
+			}
+		}
+
+		// A nil query matches all the transactions.
+		txrs, err := indexer.SearchTxEvents(context.Background(), query.All)
+		require.NoError(t, err)
+		assert.Len(t, txrs, len(txResults))
+	})
+
+	t.Run("SearchBlockEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []int64
+		}{
+			{"block.height = 2", []int64{2}},
+			{"reward.amount >= 20", []int64{2, 3}},
+			{"reward.amount < 20 OR block.height = 3", []int64{1, 3}},
+			{"epoch.start > DATE 2023-01-01", []int64{2, 3}},
+			{"reward.amount > 100", nil},
+		}
+		for _, tc := range testCases {
+			heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile(tc.query))
+			require.NoError(t, err, tc.query)
+			assert.Equal(t, tc.want, heights, tc.query)
+		}
+	})
+}
+
 func TestStop(t *testing.T) {
 	indexer := &EventSink{store: testDB()}
 	require.NoError(t, indexer.Stop())
@@ -358,20 +448,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.NotNil(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
@@ -0,0 +1,82 @@
+package psql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer/sink/internal/sqlquery"
+)
+
+const (
+	// numberPattern extracts the leading number of an attribute value, as the
+	// query package does to match values such as "8atom".
+	numberPattern = `^\d+(?:\.\d+)?`
+	// datePattern and timePattern match the attribute values which can be
+	// compared with DATE and TIME arguments.
+	datePattern = `^\d{4}-\d{2}-\d{2}$`
+	timePattern = `^\d{4}-(0[1-9]|1[0-2])-(0[1-9]|[12]\d|3[01])T([01]\d|2[0-3]):[0-5]\d:[0-5]\d(\.\d+)?(Z|[+-]\d{2}:\d{2})$`
+)
+
+// sqlOps maps the comparison operators of the query language to SQL.
+var sqlOps = map[syntax.Token]string{
+	syntax.TEq:  "=",
+	syntax.TLt:  "<",
+	syntax.TLeq: "<=",
+	syntax.TGt:  ">",
+	syntax.TGeq: ">=",
+}
+
+// newQueryBuilder returns a builder of the SQL condition selecting the rows
+// whose events match a query, the events of a row being those matching owner.
+func newQueryBuilder(owner string) *sqlquery.Builder {
+	return &sqlquery.Builder{Owner: owner, Placeholder: placeholder, Value: matchValue}
+}
+
+// placeholder returns the placeholder of the n-th argument of a query.
+func placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+// matchValue returns the SQL condition on the attribute value for the comparison of
+// cond, prefixed with " AND ", or nothing for an existence check.
+func matchValue(b *sqlquery.Builder, value string, cond syntax.Condition) (string, error) {
+	if cond.Op == syntax.TExists {
+		return "", nil
+	}
+	if cond.Arg == nil {
+		return "", fmt.Errorf("missing argument for %v", cond.Op)
+	}
+	if cond.Op == syntax.TContains {
+		if cond.Arg.Type != syntax.TString {
+			return "", fmt.Errorf("invalid op/arg combination (%v, %v)", cond.Op, cond.Arg.Type)
+		}
+		return ` AND strpos(` + value + `, ` + b.Arg(cond.Arg.Value()) + `) > 0`, nil
+	}
+	op, ok := sqlOps[cond.Op]
+	if !ok {
+		return "", fmt.Errorf("unknown operator %v", cond.Op)
+	}
+
+	switch cond.Arg.Type {
+	case syntax.TString:
+		if cond.Op != syntax.TEq {
+			return "", fmt.Errorf("invalid op/arg combination (%v, %v)", cond.Op, cond.Arg.Type)
+		}
+		return ` AND ` + value + ` = ` + b.Arg(cond.Arg.Value()), nil
+	case syntax.TNumber:
+		return ` AND substring(` + value + ` FROM '` + numberPattern + `')::numeric ` +
+			op + ` ` + b.Arg(cond.Arg.Value()) + `::numeric`, nil
+	case syntax.TDate:
+		// Dates in this format compare as strings.
+		return ` AND ` + value + ` ~ '` + datePattern + `' AND ` + value + ` COLLATE "C" ` + op + ` ` +
+			b.Arg(cond.Arg.Value()), nil
+	case syntax.TTime:
+		// The pattern guards the cast, which fails on malformed timestamps.
+		return ` AND (CASE WHEN ` + value + ` ~ '` + timePattern + `' THEN ` + value +
+			`::timestamptz END) ` + op + ` ` + b.Arg(cond.Arg.Time().Format(time.RFC3339Nano)) +
+			`::timestamptz`, nil
+	default:
+		return "", fmt.Errorf("unknown argument type %v", cond.Arg.Type)
+	}
+}
@@ -0,0 +1,7 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database.
+//
+// The sink is built with the sqlite build tag, which requires cgo
+// (CGO_ENABLED=1 go build -tags sqlite), so that the default build does not
+// link the SQLite library.
+package sqlite
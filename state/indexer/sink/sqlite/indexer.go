@@ -0,0 +1,76 @@
+//go:build sqlite
+// +build sqlite
+
+package sqlite
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxIndexer returns the transaction indexer backed by es.
+func (es *EventSink) TxIndexer() TxIndex {
+	return TxIndex{sink: es}
+}
+
+// TxIndex implements the txindex.TxIndexer interface by delegating to an
+// underlying SQLite event sink.
+type TxIndex struct{ sink *EventSink }
+
+// AddBatch indexes a batch of transactions in SQLite, as part of TxIndexer.
+func (ti TxIndex) AddBatch(batch *txindex.Batch) error {
+	return ti.sink.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in SQLite, as part of TxIndexer.
+func (ti TxIndex) Index(txr *abci.TxResult) error {
+	return ti.sink.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get returns the transaction result with the given hash from SQLite, as part
+// of TxIndexer.
+func (ti TxIndex) Get(hash []byte) (*abci.TxResult, error) {
+	return ti.sink.GetTxByHash(hash)
+}
+
+// Search queries the transaction results matching q in SQLite, as part of
+// TxIndexer.
+func (ti TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return ti.sink.SearchTxEvents(ctx, q)
+}
+
+func (TxIndex) SetLogger(log.Logger) {}
+
+// BlockIndexer returns the block indexer backed by es.
+func (es *EventSink) BlockIndexer() BlockIndex {
+	return BlockIndex{sink: es}
+}
+
+// BlockIndex implements the indexer.BlockIndexer interface by delegating to
+// an underlying SQLite event sink.
+type BlockIndex struct{ sink *EventSink }
+
+// Has reports whether the block at the given height has been indexed in
+// SQLite. It is part of the BlockIndexer interface.
+func (bi BlockIndex) Has(height int64) (bool, error) {
+	return bi.sink.HasBlock(height)
+}
+
+// Index indexes the events of the specified block in SQLite. It is part of
+// the BlockIndexer interface.
+func (bi BlockIndex) Index(block types.EventDataNewBlockEvents) error {
+	return bi.sink.IndexBlockEvents(block)
+}
+
+// Search queries the heights of the blocks matching q in SQLite. It is part of
+// the BlockIndexer interface.
+func (bi BlockIndex) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return bi.sink.SearchBlockEvents(ctx, q)
+}
+
+func (BlockIndex) SetLogger(log.Logger) {}
@@ -0,0 +1,14 @@
+//go:build sqlite
+// +build sqlite
+
+package sqlite
+
+import (
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+var (
+	_ indexer.BlockIndexer = BlockIndex{}
+	_ txindex.TxIndexer    = TxIndex{}
+)
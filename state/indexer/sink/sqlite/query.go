@@ -0,0 +1,120 @@
+//go:build sqlite
+// +build sqlite
+
+package sqlite
+
+import (
+	"container/list"
+	"database/sql"
+	"fmt"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/state/indexer/sink/internal/sqlquery"
+)
+
+const (
+	// driverName is the name of the SQLite driver providing the functions
+	// used by the queries of the sink.
+	driverName = "sqlite3_cometbft"
+	// matchFunc is the SQL function matching an attribute value against a
+	// query condition.
+	matchFunc = "match_condition"
+)
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc(matchFunc, matchCondition, true)
+		},
+	})
+}
+
+// maxConditions is the number of compiled conditions cached by matchCondition.
+const maxConditions = 1024
+
+// conditions caches the queries compiled by matchCondition, which are reused
+// for every row of a search.
+var conditions = newConditionCache(maxConditions)
+
+// matchCondition reports whether value matches the condition cond, with the
+// semantics of the query package. The condition is a query consisting of a
+// single comparison, e.g. "transfer.amount > 5".
+func matchCondition(cond, value string) (bool, error) {
+	q, err := conditions.get(cond)
+	if err != nil {
+		return false, err
+	}
+	tag := q.Syntax().Expr.(syntax.Condition).Tag
+	return q.Matches(map[string][]string{tag: {value}})
+}
+
+// conditionCache is a thread-safe LRU cache of compiled conditions.
+type conditionCache struct {
+	mtx      cmtsync.Mutex
+	size     int
+	cacheMap map[string]*list.Element
+	list     *list.List
+}
+
+type cachedCondition struct {
+	cond  string
+	query *query.Query
+}
+
+func newConditionCache(size int) *conditionCache {
+	return &conditionCache{
+		size:     size,
+		cacheMap: make(map[string]*list.Element, size),
+		list:     list.New(),
+	}
+}
+
+// get returns the compiled condition cond, compiling it if it is not cached,
+// in which case the least recently used condition is evicted if the cache is
+// full.
+func (c *conditionCache) get(cond string) (*query.Query, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.cacheMap[cond]; ok {
+		c.list.MoveToFront(e)
+		return e.Value.(*cachedCondition).query, nil
+	}
+	q, err := query.New(cond)
+	if err != nil {
+		return nil, err
+	}
+	if c.list.Len() >= c.size {
+		if e := c.list.Back(); e != nil {
+			delete(c.cacheMap, e.Value.(*cachedCondition).cond)
+			c.list.Remove(e)
+		}
+	}
+	c.cacheMap[cond] = c.list.PushFront(&cachedCondition{cond: cond, query: q})
+	return q, nil
+}
+
+// newQueryBuilder returns a builder of the SQL condition selecting the rows
+// whose events match a query, the events of a row being those matching owner.
+func newQueryBuilder(owner string) *sqlquery.Builder {
+	return &sqlquery.Builder{Owner: owner, Placeholder: placeholder, Value: matchValue}
+}
+
+// placeholder returns the placeholder of the n-th argument of a query.
+func placeholder(n int) string {
+	return fmt.Sprintf("?%d", n)
+}
+
+// matchValue returns the SQL condition on the attribute value for the
+// comparison of cond, prefixed with " AND ", or nothing for an existence
+// check. The comparison is made by matchCondition.
+func matchValue(b *sqlquery.Builder, value string, cond syntax.Condition) (string, error) {
+	if cond.Op == syntax.TExists {
+		return "", nil
+	}
+	return ` AND ` + matchFunc + `(` + b.Arg(cond.String()) + `, coalesce(` + value + `, ''))`, nil
+}
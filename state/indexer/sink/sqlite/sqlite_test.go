@@ -0,0 +1,241 @@
+//go:build sqlite && cgo
+// +build sqlite,cgo
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+const chainID = "test-chainID"
+
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+	sink, err := NewEventSink(filepath.Join(t.TempDir(), FileName), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, sink.Stop()) })
+	return sink
+}
+
+func TestIndexing(t *testing.T) {
+	sink := newTestSink(t)
+
+	t.Run("IndexBlockEvents", func(t *testing.T) {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockEvents{
+			Height: 1,
+			Events: []abci.Event{
+				makeIndexedEvent("begin_event.proposer", "FCAA001"),
+				makeIndexedEvent("end_event.foo", "100"),
+			},
+		}))
+
+		has, err := sink.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, has)
+		has, err = sink.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		var count int
+		require.NoError(t, sink.DB().QueryRow(`
+SELECT count(*) FROM block_events WHERE height = 1 AND chain_id = ?;
+`, chainID).Scan(&count))
+		assert.Equal(t, 3, count)
+
+		// Attempting to reindex the same events should gracefully succeed.
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockEvents{Height: 1}))
+	})
+
+	t.Run("IndexTxEvents", func(t *testing.T) {
+		txResult := &abci.TxResult{
+			Height: 1,
+			Index:  0,
+			Tx:     types.Tx("HELLO WORLD"),
+			Result: abci.ExecTxResult{
+				Data: []byte{0},
+				Code: abci.CodeTypeOK,
+				Events: []abci.Event{
+					makeIndexedEvent("account.number", "1"),
+					makeIndexedEvent("account.owner", "Ivan"),
+					{Type: "", Attributes: []abci.EventAttribute{
+						{Key: "not_allowed", Value: "Vlad", Index: true},
+					}},
+				},
+			},
+		}
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		txr, err := sink.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txr, err = sink.GetTxByHash(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		_, err = sink.GetTxByHash(nil)
+		assert.ErrorIs(t, err, txindex.ErrorEmptyHash)
+
+		var count int
+		require.NoError(t, sink.DB().QueryRow(`
+SELECT count(*) FROM tx_events WHERE height = 1 AND chain_id = ?;
+`, chainID).Scan(&count))
+		assert.Equal(t, 4, count)
+
+		// Attempting to reindex the same transaction should gracefully succeed.
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		// The block of a transaction must be indexed first.
+		require.Error(t, sink.IndexTxEvents([]*abci.TxResult{{Height: 2, Tx: types.Tx("foo")}}))
+	})
+
+	t.Run("IndexerService", func(t *testing.T) {
+		eventBus := types.NewEventBus()
+		require.NoError(t, eventBus.Start())
+		t.Cleanup(func() {
+			if err := eventBus.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		service := txindex.NewIndexerService(sink.TxIndexer(), sink.BlockIndexer(), eventBus, true)
+		service.SetLogger(log.TestingLogger())
+		require.NoError(t, service.Start())
+		t.Cleanup(func() {
+			if err := service.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		require.NoError(t, eventBus.PublishEventNewBlockEvents(types.EventDataNewBlockEvents{
+			Height: 2,
+			NumTxs: 1,
+		}))
+		require.NoError(t, eventBus.PublishEventTx(types.EventDataTx{TxResult: abci.TxResult{
+			Height: 2,
+			Index:  0,
+			Tx:     types.Tx("foo"),
+		}}))
+
+		require.Eventually(t, func() bool {
+			txr, err := sink.TxIndexer().Get(types.Tx("foo").Hash())
+			return err == nil && txr != nil
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	sink := newTestSink(t)
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockEvents{
+			Height: height,
+			Events: []abci.Event{
+				makeIndexedEvent("reward.amount", fmt.Sprintf("%dstake", 10*height)),
+				makeIndexedEvent("epoch.start", fmt.Sprintf("2023-01-0%d", height)),
+			},
+		}))
+	}
+	txResults := []*abci.TxResult{
+		{Height: 1, Index: 0, Tx: types.Tx("tx1"), Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("transfer.sender", "alice"),
+			makeIndexedEvent("transfer.amount", "5"),
+		}}},
+		{Height: 2, Index: 0, Tx: types.Tx("tx2"), Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("transfer.sender", "bob"),
+			makeIndexedEvent("transfer.amount", "50"),
+			makeIndexedEvent("transfer.time", "2023-01-02T10:00:00+02:00"),
+		}}},
+		{Height: 2, Index: 1, Tx: types.Tx("tx3"), Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("transfer.sender", "alice-bob"),
+			makeIndexedEvent("transfer.time", "2023-01-02T10:00:00Z"),
+			{Type: "burn"},
+		}}},
+	}
+	require.NoError(t, sink.IndexTxEvents(txResults))
+
+	// The records of other chains don't match.
+	other := &EventSink{store: sink.store, chainID: "other-chainID"}
+	require.NoError(t, other.IndexBlockEvents(types.EventDataNewBlockEvents{Height: 1}))
+	require.NoError(t, other.IndexTxEvents([]*abci.TxResult{{Height: 1, Tx: types.Tx("tx4")}}))
+
+	t.Run("SearchTxEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []*abci.TxResult
+		}{
+			{"tx.height >= 1", txResults},
+			{"tx.height = 2", txResults[1:]},
+			{"tx.hash = '" + fmt.Sprintf("%X", types.Tx("tx1").Hash()) + "'", txResults[:1]},
+			{"transfer.sender = 'alice'", txResults[:1]},
+			{"transfer.sender CONTAINS 'bob'", txResults[1:]},
+			{"transfer.amount > 5", txResults[1:2]},
+			{"transfer.amount <= 5 OR transfer.sender = 'bob'", txResults[:2]},
+			{"tx.height = 2 AND NOT transfer.amount EXISTS", txResults[2:]},
+			{"transfer.time > TIME 2023-01-02T09:00:00Z", txResults[2:]},
+			{"burn EXISTS", txResults[2:]},
+			{"transfer.sender = 'carol'", nil},
+		}
+		for _, tc := range testCases {
+			txrs, err := sink.SearchTxEvents(context.Background(), query.MustCompile(tc.query))
+			require.NoError(t, err, tc.query)
+			require.Len(t, txrs, len(tc.want), tc.query)
+			for i := range tc.want {
+				assert.Equal(t, tc.want[i].Tx, txrs[i].Tx, tc.query)
+			}
+		}
+
+		// A nil query matches all the transactions.
+		txrs, err := sink.SearchTxEvents(context.Background(), query.All)
+		require.NoError(t, err)
+		assert.Len(t, txrs, len(txResults))
+	})
+
+	t.Run("SearchBlockEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []int64
+		}{
+			{"block.height = 2", []int64{2}},
+			{"reward.amount >= 20", []int64{2, 3}},
+			{"reward.amount < 20 OR block.height = 3", []int64{1, 3}},
+			{"epoch.start > DATE 2023-01-01", []int64{2, 3}},
+			{"reward.amount > 100", nil},
+		}
+		for _, tc := range testCases {
+			heights, err := sink.SearchBlockEvents(context.Background(), query.MustCompile(tc.query))
+			require.NoError(t, err, tc.query)
+			assert.Equal(t, tc.want, heights, tc.query)
+		}
+	})
+}
+
+func TestConditionCache(t *testing.T) {
+	cache := newConditionCache(2)
+
+	for _, cond := range []string{"a.x = 1", "b.x = 2", "a.x = 1", "c.x = 3"} {
+		_, err := cache.get(cond)
+		require.NoError(t, err)
+	}
+	_, err := cache.get("a.x =")
+	require.Error(t, err)
+
+	// The least recently used condition is evicted.
+	assert.Equal(t, 2, cache.list.Len())
+	assert.Len(t, cache.cacheMap, 2)
+	assert.Contains(t, cache.cacheMap, "a.x = 1")
+	assert.Contains(t, cache.cacheMap, "c.x = 3")
+}
@@ -0,0 +1,220 @@
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+const defaultPruningInterval = 10 * time.Second
+
+// Pruner is a service that prunes blocks, states and ABCI responses in the
+// background.
+//
+// The blocks are pruned up to the retain height requested by the application
+// in ResponseCommit. If the data companion is enabled, they are pruned up to
+// the minimum of the retain heights of the application and of the data
+// companion, so that the companion can export the data before it is removed.
+// The ABCI responses are then pruned up to the retain height set by the data
+// companion, or along with the blocks otherwise.
+type Pruner struct {
+	service.BaseService
+
+	// mtx guards the check and the update of the retain heights
+	mtx cmtsync.Mutex
+
+	stateStore Store
+	blockStore BlockStore
+
+	interval  time.Duration
+	dcEnabled bool
+}
+
+type PrunerOption func(*Pruner)
+
+// WithPrunerInterval sets how often the pruner looks for data to prune.
+func WithPrunerInterval(interval time.Duration) PrunerOption {
+	return func(p *Pruner) {
+		p.interval = interval
+	}
+}
+
+// WithPrunerCompanionEnabled takes the retain heights of the data companion
+// into account when pruning.
+func WithPrunerCompanionEnabled() PrunerOption {
+	return func(p *Pruner) {
+		p.dcEnabled = true
+	}
+}
+
+// NewPruner returns a new Pruner of the given stores.
+func NewPruner(stateStore Store, blockStore BlockStore, logger log.Logger, options ...PrunerOption) *Pruner {
+	p := &Pruner{
+		stateStore: stateStore,
+		blockStore: blockStore,
+		interval:   defaultPruningInterval,
+	}
+	for _, option := range options {
+		option(p)
+	}
+	p.BaseService = *service.NewBaseService(logger, "Pruner", p)
+	return p
+}
+
+// OnStart implements service.Service.
+func (p *Pruner) OnStart() error {
+	go p.pruningRoutine()
+	return nil
+}
+
+// SetApplicationBlockRetainHeight sets the block retain height requested by
+// the application. A height lower than the previous one is ignored.
+func (p *Pruner) SetApplicationBlockRetainHeight(height int64) error {
+	return p.setRetainHeight(height, p.stateStore.GetApplicationRetainHeight, p.stateStore.SaveApplicationRetainHeight)
+}
+
+// SetCompanionBlockRetainHeight sets the block retain height of the data
+// companion. A height lower than the previous one is ignored.
+func (p *Pruner) SetCompanionBlockRetainHeight(height int64) error {
+	if !p.dcEnabled {
+		return ErrPrunerCompanionNotEnabled
+	}
+	return p.setRetainHeight(height, p.stateStore.GetCompanionBlockRetainHeight, p.stateStore.SaveCompanionBlockRetainHeight)
+}
+
+// SetABCIResRetainHeight sets the retain height of the ABCI responses set by
+// the data companion. A height lower than the previous one is ignored.
+func (p *Pruner) SetABCIResRetainHeight(height int64) error {
+	if !p.dcEnabled {
+		return ErrPrunerCompanionNotEnabled
+	}
+	return p.setRetainHeight(height, p.stateStore.GetABCIResRetainHeight, p.stateStore.SaveABCIResRetainHeight)
+}
+
+// GetApplicationRetainHeight returns the block retain height requested by the
+// application, or 0 if it never requested one.
+func (p *Pruner) GetApplicationRetainHeight() (int64, error) {
+	return p.stateStore.GetApplicationRetainHeight()
+}
+
+// GetCompanionBlockRetainHeight returns the block retain height of the data
+// companion, or 0 if it was never set.
+func (p *Pruner) GetCompanionBlockRetainHeight() (int64, error) {
+	return p.stateStore.GetCompanionBlockRetainHeight()
+}
+
+// GetABCIResRetainHeight returns the retain height of the ABCI responses set
+// by the data companion, or 0 if it was never set.
+func (p *Pruner) GetABCIResRetainHeight() (int64, error) {
+	return p.stateStore.GetABCIResRetainHeight()
+}
+
+func (p *Pruner) setRetainHeight(height int64, get func() (int64, error), save func(int64) error) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if lastHeight := p.blockStore.Height(); height <= 0 || height > lastHeight {
+		return ErrInvalidRetainHeight{Height: height, LastHeight: lastHeight}
+	}
+	currentHeight, err := get()
+	if err != nil {
+		return err
+	}
+	// The application may return the same or a lower retain height for every
+	// block, which is ignored.
+	if height <= currentHeight {
+		p.Logger.Debug("Ignoring retain height not greater than the current one",
+			"height", height, "current", currentHeight)
+		return nil
+	}
+	return save(height)
+}
+
+func (p *Pruner) pruningRoutine() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// The ABCI responses are only pruned once the blocks were, so
+			// that they are never pruned beyond the block retain height.
+			blockRetainHeight, err := p.pruneBlocks()
+			if err != nil {
+				p.Logger.Error("failed to prune blocks", "retain_height", blockRetainHeight, "err", err)
+				continue
+			}
+			p.pruneABCIResponses(blockRetainHeight)
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// blockRetainHeight returns the height up to which blocks can be pruned, or 0
+// if they must all be kept.
+func (p *Pruner) blockRetainHeight() (int64, error) {
+	appRetainHeight, err := p.stateStore.GetApplicationRetainHeight()
+	if err != nil || !p.dcEnabled {
+		return appRetainHeight, err
+	}
+	dcRetainHeight, err := p.stateStore.GetCompanionBlockRetainHeight()
+	if err != nil {
+		return 0, err
+	}
+	return min(appRetainHeight, dcRetainHeight), nil
+}
+
+// pruneBlocks prunes the blocks and states, and returns the block retain
+// height. On failure, it returns the height actually reached, that is the base
+// of the block store, or 0 if the retain height is unknown.
+func (p *Pruner) pruneBlocks() (int64, error) {
+	retainHeight, err := p.blockRetainHeight()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block retain height: %w", err)
+	}
+	base := p.blockStore.Base()
+	if retainHeight <= base {
+		return retainHeight, nil
+	}
+
+	state, err := p.stateStore.Load()
+	if err != nil {
+		return base, fmt.Errorf("failed to load state: %w", err)
+	}
+	pruned, evRetainHeight, err := p.blockStore.PruneBlocks(retainHeight, state)
+	if err != nil {
+		return base, fmt.Errorf("failed to prune block store: %w", err)
+	}
+	if err := p.stateStore.PruneStates(base, retainHeight, evRetainHeight); err != nil {
+		return base, fmt.Errorf("failed to prune state store: %w", err)
+	}
+	p.Logger.Debug("pruned blocks", "pruned", pruned, "retain_height", retainHeight)
+	return retainHeight, nil
+}
+
+func (p *Pruner) pruneABCIResponses(blockRetainHeight int64) {
+	retainHeight := blockRetainHeight
+	if p.dcEnabled {
+		var err error
+		if retainHeight, err = p.stateStore.GetABCIResRetainHeight(); err != nil {
+			p.Logger.Error("failed to get ABCI responses retain height", "err", err)
+			return
+		}
+	}
+	if retainHeight <= 0 {
+		return
+	}
+
+	pruned, err := p.stateStore.PruneABCIResponses(retainHeight)
+	if err != nil {
+		p.Logger.Error("failed to prune ABCI responses", "retain_height", retainHeight, "err", err)
+		return
+	}
+	if pruned > 0 {
+		p.Logger.Debug("pruned ABCI responses", "pruned", pruned, "retain_height", retainHeight)
+	}
+}
@@ -0,0 +1,146 @@
+package state_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+)
+
+// newPrunerTestStores returns a state store with states and ABCI responses up
+// to height 10, and a mock block store of the same height that records the
+// pruned heights.
+func newPrunerTestStores(t *testing.T) (sm.Store, *mocks.BlockStore, *atomic.Int64) {
+	t.Helper()
+
+	_, stateDB, _ := makeState(1, 10)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	for h := int64(1); h <= 10; h++ {
+		err := stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Data: []byte{byte(h)}}},
+		})
+		require.NoError(t, err)
+	}
+
+	base := &atomic.Int64{}
+	base.Store(1)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Height").Return(int64(10))
+	blockStore.On("Base").Return(func() int64 { return base.Load() })
+	blockStore.On("PruneBlocks", mock.Anything, mock.Anything).Return(
+		func(height int64, _ sm.State) uint64 { return uint64(height - base.Swap(height)) },
+		func(height int64, _ sm.State) int64 { return height },
+		nil,
+	)
+	return stateStore, blockStore, base
+}
+
+func startPruner(t *testing.T, pruner *sm.Pruner) {
+	t.Helper()
+
+	require.NoError(t, pruner.Start())
+	t.Cleanup(func() {
+		if err := pruner.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestPrunerRetainHeights(t *testing.T) {
+	stateStore, blockStore, _ := newPrunerTestStores(t)
+	pruner := sm.NewPruner(stateStore, blockStore, log.TestingLogger())
+
+	require.Equal(t, sm.ErrInvalidRetainHeight{Height: 0, LastHeight: 10}, pruner.SetApplicationBlockRetainHeight(0))
+	require.Equal(t, sm.ErrInvalidRetainHeight{Height: 11, LastHeight: 10}, pruner.SetApplicationBlockRetainHeight(11))
+	require.NoError(t, pruner.SetApplicationBlockRetainHeight(5))
+	// A lower retain height is ignored.
+	require.NoError(t, pruner.SetApplicationBlockRetainHeight(4))
+
+	height, err := pruner.GetApplicationRetainHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, height)
+
+	require.Equal(t, sm.ErrPrunerCompanionNotEnabled, pruner.SetCompanionBlockRetainHeight(5))
+	require.Equal(t, sm.ErrPrunerCompanionNotEnabled, pruner.SetABCIResRetainHeight(5))
+}
+
+func TestPrunerApplicationRetainHeight(t *testing.T) {
+	stateStore, blockStore, base := newPrunerTestStores(t)
+	pruner := sm.NewPruner(stateStore, blockStore, log.TestingLogger(), sm.WithPrunerInterval(10*time.Millisecond))
+	startPruner(t, pruner)
+
+	require.NoError(t, pruner.SetApplicationBlockRetainHeight(5))
+	require.Eventually(t, func() bool { return base.Load() == 5 }, time.Second, 10*time.Millisecond)
+
+	// Without a data companion, ABCI responses are pruned along with the
+	// blocks.
+	require.Eventually(t, func() bool {
+		_, err := stateStore.LoadFinalizeBlockResponse(4)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+	_, err := stateStore.LoadFinalizeBlockResponse(5)
+	require.NoError(t, err)
+}
+
+func TestPrunerCompanionRetainHeight(t *testing.T) {
+	stateStore, blockStore, base := newPrunerTestStores(t)
+	pruner := sm.NewPruner(stateStore, blockStore, log.TestingLogger(),
+		sm.WithPrunerInterval(10*time.Millisecond),
+		sm.WithPrunerCompanionEnabled(),
+	)
+	startPruner(t, pruner)
+
+	// Nothing is pruned until the companion sets a retain height.
+	require.NoError(t, pruner.SetApplicationBlockRetainHeight(8))
+	time.Sleep(50 * time.Millisecond)
+	assert.EqualValues(t, 1, base.Load())
+
+	// Blocks are pruned up to the lowest retain height.
+	require.NoError(t, pruner.SetCompanionBlockRetainHeight(5))
+	require.Eventually(t, func() bool { return base.Load() == 5 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, pruner.SetCompanionBlockRetainHeight(9))
+	require.Eventually(t, func() bool { return base.Load() == 8 }, time.Second, 10*time.Millisecond)
+
+	// ABCI responses are pruned up to the retain height of the companion only.
+	_, err := stateStore.LoadFinalizeBlockResponse(1)
+	require.NoError(t, err)
+	require.NoError(t, pruner.SetABCIResRetainHeight(3))
+	require.Eventually(t, func() bool {
+		_, err := stateStore.LoadFinalizeBlockResponse(2)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+	_, err = stateStore.LoadFinalizeBlockResponse(3)
+	require.NoError(t, err)
+}
+
+func TestPrunerBlockPruningFailure(t *testing.T) {
+	stateStore, _, _ := newPrunerTestStores(t)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Height").Return(int64(10))
+	blockStore.On("Base").Return(int64(1))
+	attempts := &atomic.Int64{}
+	blockStore.On("PruneBlocks", int64(5), mock.Anything).
+		Run(func(mock.Arguments) { attempts.Add(1) }).
+		Return(uint64(0), int64(0), errors.New("disk failure"))
+
+	pruner := sm.NewPruner(stateStore, blockStore, log.TestingLogger(), sm.WithPrunerInterval(10*time.Millisecond))
+	startPruner(t, pruner)
+
+	require.NoError(t, pruner.SetApplicationBlockRetainHeight(5))
+	require.Eventually(t, func() bool { return attempts.Load() > 2 }, time.Second, 10*time.Millisecond)
+
+	// The ABCI responses are kept as long as the blocks can't be pruned.
+	_, err := stateStore.LoadFinalizeBlockResponse(1)
+	require.NoError(t, err)
+}
@@ -244,9 +244,15 @@ func (state State) MakeBlock(
 
 	// Set time.
 	var timestamp time.Time
-	if height == state.InitialHeight {
+	switch {
+	case state.ConsensusParams.Feature.PbtsEnabled(height):
+		// With proposer-based timestamps, the time is taken from the
+		// proposer's clock. Consensus makes sure that it is after the last
+		// block time before proposing.
+		timestamp = cmttime.Now()
+	case height == state.InitialHeight:
 		timestamp = state.LastBlockTime // genesis time
-	} else {
+	default:
 		timestamp = MedianTime(lastCommit, state.LastValidators)
 	}
 
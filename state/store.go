@@ -1,8 +1,10 @@
 package state
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -40,7 +42,15 @@ func calcABCIResponsesKey(height int64) []byte {
 
 //----------------------
 
-var lastABCIResponseKey = []byte("lastABCIResponseKey")
+var (
+	lastABCIResponseKey = []byte("lastABCIResponseKey")
+
+	// retain heights used by the Pruner
+	appBlockRetainHeightKey       = []byte("appBlockRetainHeightKey")
+	companionBlockRetainHeightKey = []byte("companionBlockRetainHeightKey")
+	abciResRetainHeightKey        = []byte("abciResRetainHeightKey")
+	abciResponsesPrunedHeightKey  = []byte("abciResponsesPrunedHeightKey")
+)
 
 //go:generate ../scripts/mockery_generate.sh Store
 
@@ -71,8 +81,26 @@ type Store interface {
 	SaveFinalizeBlockResponse(int64, *abci.ResponseFinalizeBlock) error
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(State) error
+	// SaveValidators saves the validator set at a given height, given the last height it changed at
+	SaveValidators(int64, int64, *types.ValidatorSet) error
+	// SaveConsensusParams saves the consensus params at a given height, given the last height they changed at
+	SaveConsensusParams(int64, int64, types.ConsensusParams) error
 	// PruneStates takes the height from which to start pruning and which height stop at
 	PruneStates(int64, int64, int64) error
+	// PruneABCIResponses prunes the ABCI responses below the given height
+	PruneABCIResponses(int64) (uint64, error)
+	// SaveApplicationRetainHeight saves the retain height requested by the application
+	SaveApplicationRetainHeight(int64) error
+	// GetApplicationRetainHeight returns the retain height requested by the application
+	GetApplicationRetainHeight() (int64, error)
+	// SaveCompanionBlockRetainHeight saves the block retain height set by the data companion
+	SaveCompanionBlockRetainHeight(int64) error
+	// GetCompanionBlockRetainHeight returns the block retain height set by the data companion
+	GetCompanionBlockRetainHeight() (int64, error)
+	// SaveABCIResRetainHeight saves the retain height of the ABCI responses
+	SaveABCIResRetainHeight(int64) error
+	// GetABCIResRetainHeight returns the retain height of the ABCI responses
+	GetABCIResRetainHeight() (int64, error)
 	// Close closes the connection with the database
 	Close() error
 }
@@ -230,6 +258,21 @@ func (store dbStore) Bootstrap(state State) error {
 	return store.db.SetSync(stateKey, state.Bytes())
 }
 
+// SaveValidators persists the validator set at the given height, e.g. when
+// importing historical data. lastHeightChanged is the height at which the
+// validator set last changed; the set is only persisted if it changed at
+// height.
+func (store dbStore) SaveValidators(height, lastHeightChanged int64, valSet *types.ValidatorSet) error {
+	return store.saveValidatorsInfo(height, lastHeightChanged, valSet)
+}
+
+// SaveConsensusParams persists the consensus params at the given height, e.g.
+// when importing historical data. lastHeightChanged is the height at which the
+// params last changed; they are only persisted if they changed at height.
+func (store dbStore) SaveConsensusParams(height, lastHeightChanged int64, params types.ConsensusParams) error {
+	return store.saveConsensusParamsInfo(height, lastHeightChanged, params)
+}
+
 // PruneStates deletes states between the given heights (including from, excluding to). It is not
 // guaranteed to delete all states, since the last checkpointed state and states being pointed to by
 // e.g. `LastHeightChanged` must remain. The state at to must also exist.
@@ -266,7 +309,7 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 	}
 
 	batch := store.db.NewBatch()
-	defer batch.Close()
+	defer func() { batch.Close() }()
 	pruned := uint64(0)
 
 	// We have to delete in reverse order, to avoid deleting previous heights that have validator
@@ -340,10 +383,6 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 			}
 		}
 
-		err = batch.Delete(calcABCIResponsesKey(h))
-		if err != nil {
-			return err
-		}
 		pruned++
 
 		// avoid batches growing too large by flushing to database regularly
@@ -354,7 +393,6 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 			}
 			batch.Close()
 			batch = store.db.NewBatch()
-			defer batch.Close()
 		}
 	}
 
@@ -366,6 +404,148 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 	return nil
 }
 
+// PruneABCIResponses deletes the ABCI responses below the given height
+// (excluding retainHeight), starting from the height the previous call pruned
+// up to, or from the lowest height stored on the first call. It returns the
+// number of heights pruned.
+func (store dbStore) PruneABCIResponses(retainHeight int64) (uint64, error) {
+	if retainHeight <= 0 {
+		return 0, fmt.Errorf("height %v must be greater than 0", retainHeight)
+	}
+	from, err := store.getInt64(abciResponsesPrunedHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if from == 0 {
+		if from, err = store.lowestABCIResponsesHeight(); err != nil {
+			return 0, err
+		}
+		if from == 0 {
+			from = retainHeight
+		}
+	}
+	if retainHeight <= from {
+		return 0, nil
+	}
+
+	batch := store.db.NewBatch()
+	defer func() { batch.Close() }()
+	flush := func(batch dbm.Batch, height int64) error {
+		// Record the height pruned up to along with the deletions, so that the
+		// next call resumes from there even if this one fails.
+		if err := batch.Set(abciResponsesPrunedHeightKey, int64ToBytes(height)); err != nil {
+			return err
+		}
+		if err := batch.WriteSync(); err != nil {
+			return fmt.Errorf("failed to prune ABCI responses up to height %v: %w", height, err)
+		}
+		batch.Close()
+		return nil
+	}
+
+	pruned := uint64(0)
+	for h := from; h < retainHeight; h++ {
+		if err := batch.Delete(calcABCIResponsesKey(h)); err != nil {
+			return 0, err
+		}
+		pruned++
+
+		// flush every 1000 heights to avoid batches becoming too large
+		if pruned%1000 == 0 {
+			if err := flush(batch, h+1); err != nil {
+				return 0, err
+			}
+			batch = store.db.NewBatch()
+		}
+	}
+	if err := flush(batch, retainHeight); err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+// lowestABCIResponsesHeight returns the lowest height with ABCI responses
+// stored, or 0 if there are none. The keys are not ordered by height, so all
+// of them are scanned.
+func (store dbStore) lowestABCIResponsesHeight() (int64, error) {
+	prefix := []byte("abciResponsesKey:")
+	end := append([]byte("abciResponsesKey"), ':'+1)
+	iter, err := store.db.Iterator(prefix, end)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	lowest := int64(0)
+	for ; iter.Valid(); iter.Next() {
+		h, err := strconv.ParseInt(string(iter.Key()[len(prefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
+		if lowest == 0 || h < lowest {
+			lowest = h
+		}
+	}
+	return lowest, iter.Error()
+}
+
+// SaveApplicationRetainHeight implements Store.
+func (store dbStore) SaveApplicationRetainHeight(height int64) error {
+	return store.setInt64(appBlockRetainHeightKey, height)
+}
+
+// GetApplicationRetainHeight implements Store. It returns 0 if the
+// application never requested a retain height.
+func (store dbStore) GetApplicationRetainHeight() (int64, error) {
+	return store.getInt64(appBlockRetainHeightKey)
+}
+
+// SaveCompanionBlockRetainHeight implements Store.
+func (store dbStore) SaveCompanionBlockRetainHeight(height int64) error {
+	return store.setInt64(companionBlockRetainHeightKey, height)
+}
+
+// GetCompanionBlockRetainHeight implements Store. It returns 0 if the data
+// companion never set a retain height.
+func (store dbStore) GetCompanionBlockRetainHeight() (int64, error) {
+	return store.getInt64(companionBlockRetainHeightKey)
+}
+
+// SaveABCIResRetainHeight implements Store.
+func (store dbStore) SaveABCIResRetainHeight(height int64) error {
+	return store.setInt64(abciResRetainHeightKey, height)
+}
+
+// GetABCIResRetainHeight implements Store. It returns 0 if no retain height
+// was set.
+func (store dbStore) GetABCIResRetainHeight() (int64, error) {
+	return store.getInt64(abciResRetainHeightKey)
+}
+
+func (store dbStore) setInt64(key []byte, value int64) error {
+	return store.db.SetSync(key, int64ToBytes(value))
+}
+
+func (store dbStore) getInt64(key []byte) (int64, error) {
+	bz, err := store.db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid value of %q: expected 8 bytes, got %d", key, len(bz))
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func int64ToBytes(i int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(i))
+	return bz
+}
+
 //------------------------------------------------------------------------
 
 // TxResultsHash returns the root hash of a Merkle tree of
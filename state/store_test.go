@@ -106,7 +106,7 @@ func TestPruneStates(t *testing.T) {
 			10, 2, 8, 8, false,
 			[]int64{1, 3, 8, 9, 10},
 			[]int64{1, 5, 8, 9, 10},
-			[]int64{1, 8, 9, 10},
+			[]int64{8, 9, 10},
 		},
 		"prune across checkpoint": {
 			100001, 1, 100001, 100001, false,
@@ -180,6 +180,11 @@ func TestPruneStates(t *testing.T) {
 			}
 			require.NoError(t, err)
 
+			// ABCI responses are pruned separately, up to the same height as
+			// the blocks when there is no data companion.
+			_, err = stateStore.PruneABCIResponses(tc.pruneTo)
+			require.NoError(t, err)
+
 			expectVals := sliceToMap(tc.expectVals)
 			expectParams := sliceToMap(tc.expectParams)
 			expectABCI := sliceToMap(tc.expectABCI)
@@ -216,6 +221,90 @@ func TestPruneStates(t *testing.T) {
 	}
 }
 
+func TestPruneABCIResponses(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	for h := int64(1); h <= 10; h++ {
+		err := stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Data: []byte{byte(h)}}},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := stateStore.PruneABCIResponses(0)
+	require.Error(t, err)
+
+	pruned, err := stateStore.PruneABCIResponses(4)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	// Pruning resumes from the previous retain height.
+	pruned, err = stateStore.PruneABCIResponses(8)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, pruned)
+	pruned, err = stateStore.PruneABCIResponses(6)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	for h := int64(1); h <= 10; h++ {
+		_, err := stateStore.LoadFinalizeBlockResponse(h)
+		if h < 8 {
+			require.Equal(t, sm.ErrNoABCIResponsesForHeight{Height: h}, err)
+		} else {
+			require.NoError(t, err, "abci height %v", h)
+		}
+	}
+}
+
+func TestPruneABCIResponsesFromLowestHeight(t *testing.T) {
+	// The node was state synced at height 5000, so it has no ABCI responses
+	// below.
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	for h := int64(5001); h <= 7500; h++ {
+		err := stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Data: []byte{byte(h)}}},
+		})
+		require.NoError(t, err)
+	}
+
+	pruned, err := stateStore.PruneABCIResponses(4000)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	pruned, err = stateStore.PruneABCIResponses(7001)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2000, pruned)
+	_, err = stateStore.LoadFinalizeBlockResponse(7000)
+	require.Equal(t, sm.ErrNoABCIResponsesForHeight{Height: 7000}, err)
+	_, err = stateStore.LoadFinalizeBlockResponse(7001)
+	require.NoError(t, err)
+}
+
+func TestRetainHeights(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+
+	for _, tc := range []struct {
+		save func(int64) error
+		get  func() (int64, error)
+	}{
+		{stateStore.SaveApplicationRetainHeight, stateStore.GetApplicationRetainHeight},
+		{stateStore.SaveCompanionBlockRetainHeight, stateStore.GetCompanionBlockRetainHeight},
+		{stateStore.SaveABCIResRetainHeight, stateStore.GetABCIResRetainHeight},
+	} {
+		height, err := tc.get()
+		require.NoError(t, err)
+		assert.Zero(t, height)
+
+		require.NoError(t, tc.save(42))
+		height, err = tc.get()
+		require.NoError(t, err)
+		assert.EqualValues(t, 42, height)
+	}
+}
+
 func TestTxResultsHash(t *testing.T) {
 	txResults := []*abci.ExecTxResult{
 		{Code: 32, Data: []byte("Hello"), Log: "Huh?"},
@@ -198,12 +198,16 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 
 // Search performs a search using the given query.
 //
-// It breaks the query into conditions (like "tx.height > 5"). For each
-// condition, it queries the DB index. One special use cases here: (1) if
-// "tx.hash" is found, it returns tx result for it (2) for range queries it is
-// better for the client to provide both lower and upper bounds, so we are not
-// performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// It breaks the query into conjunctions of conditions (like "tx.height > 5"),
+// such that a transaction matches the query if it matches any of them. For
+// each condition of a conjunction, it queries the DB index. One special use
+// cases here: (1) if "tx.hash" is found, it returns tx result for it (2) for
+// range queries it is better for the client to provide both lower and upper
+// bounds, so we are not performing a full scan. Results from querying indexes
+// are then intersected for each conjunction, from which the transactions
+// matching its negated conditions are removed. The results of all the
+// conjunctions are finally merged and returned to the caller, in no particular
+// order.
 //
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
@@ -215,11 +219,85 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
+	conjunctions, err := q.Syntax().Conjunctions()
+	if err != nil {
+		return nil, err
+	}
+
 	filteredHashes := make(map[string][]byte)
+	for _, conj := range conjunctions {
+		hashes, err := txi.searchConjunction(ctx, conj)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range hashes {
+			filteredHashes[k] = v
+		}
+	}
+
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	resultMap := make(map[string]struct{})
+RESULTS_LOOP:
+	for _, h := range filteredHashes {
+
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		hashString := string(h)
+		if _, ok := resultMap[hashString]; !ok {
+			resultMap[hashString] = struct{}{}
+			results = append(results, res)
+		}
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break RESULTS_LOOP
+		default:
+		}
+	}
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions := q.Syntax()
+	return results, nil
+}
+
+// searchConjunction returns the hashes of the transactions matching all the
+// conditions of conj and none of its negated conditions.
+func (txi *TxIndex) searchConjunction(ctx context.Context, conj syntax.Conjunction) (map[string][]byte, error) {
+	if len(conj.Conditions) == 0 {
+		return nil, indexer.ErrNegatedConditionsOnly
+	}
+
+	filteredHashes, err := txi.searchConditions(ctx, conj.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range conj.Negated {
+		if len(filteredHashes) == 0 {
+			break
+		}
+		excludedHashes, err := txi.searchConditions(ctx, []syntax.Condition{c})
+		if err != nil {
+			return nil, err
+		}
+		excluded := make(map[string]struct{}, len(excludedHashes))
+		for _, h := range excludedHashes {
+			excluded[string(h)] = struct{}{}
+		}
+		for k, h := range filteredHashes {
+			if _, ok := excluded[string(h)]; ok {
+				delete(filteredHashes, k)
+			}
+		}
+	}
+	return filteredHashes, nil
+}
+
+// searchConditions returns the hashes of the transactions matching all the
+// given conditions.
+func (txi *TxIndex) searchConditions(ctx context.Context, conditions []syntax.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
 
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
@@ -227,14 +305,13 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
 	} else if ok {
 		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving the result: %w", err)
 		}
+		if res != nil {
+			filteredHashes[string(hash)] = hash
+		}
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -303,29 +380,7 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	resultMap := make(map[string]struct{})
-RESULTS_LOOP:
-	for _, h := range filteredHashes {
-
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		hashString := string(h)
-		if _, ok := resultMap[hashString]; !ok {
-			resultMap[hashString] = struct{}{}
-			results = append(results, res)
-		}
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break RESULTS_LOOP
-		default:
-		}
-	}
-
-	return results, nil
+	return filteredHashes, nil
 }
 
 func lookForHash(conditions []syntax.Condition) (hash []byte, ok bool, err error) {
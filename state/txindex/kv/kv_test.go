@@ -15,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -579,6 +580,60 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchDisjunctionsAndNegations(t *testing.T) {
+	txIndexer := NewTxIndex(db.NewMemDB())
+
+	transfers := []struct{ sender, recipient string }{
+		{"alice", "bob"},
+		{"bob", "carol"},
+		{"carol", "alice"},
+	}
+	txResults := make([]*abci.TxResult, len(transfers))
+	for i, transfer := range transfers {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: transfer.sender, Index: true},
+				{Key: "recipient", Value: transfer.recipient, Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("transfer %d", i))
+		txResult.Height = int64(i + 1)
+		require.NoError(t, txIndexer.Index(txResult))
+		txResults[i] = txResult
+	}
+
+	testCases := []struct {
+		q       string
+		results []int
+	}{
+		{"transfer.sender = 'alice' OR transfer.recipient = 'alice'", []int{0, 2}},
+		{"transfer.sender = 'bob' OR transfer.sender = 'bob'", []int{1}},
+		{"transfer.sender = 'dave' OR transfer.recipient = 'dave'", nil},
+		{"transfer.sender EXISTS AND NOT transfer.recipient = 'alice'", []int{0, 1}},
+		{"tx.height >= 1 AND NOT (transfer.sender = 'alice' OR transfer.sender = 'carol')", []int{1}},
+		{"(transfer.sender = 'alice' OR transfer.sender = 'bob') AND tx.height > 1", []int{1}},
+		{"tx.height = 2 OR tx.height = 3", []int{1, 2}},
+		{"tx.hash = '" + fmt.Sprintf("%X", types.Tx("transfer 0").Hash()) + "' OR transfer.sender = 'carol'", []int{0, 2}},
+	}
+
+	ctx := context.Background()
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := txIndexer.Search(ctx, query.MustCompile(tc.q))
+			require.NoError(t, err)
+
+			expected := make([]*abci.TxResult, len(tc.results))
+			for i, idx := range tc.results {
+				expected[i] = txResults[idx]
+			}
+			assert.ElementsMatch(t, expected, results)
+		})
+	}
+
+	_, err := txIndexer.Search(ctx, query.MustCompile("NOT transfer.sender = 'alice'"))
+	require.ErrorIs(t, err, indexer.ErrNegatedConditionsOnly)
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
@@ -119,6 +119,11 @@ func validateBlock(state State, block *types.Block) error {
 				state.LastBlockTime,
 			)
 		}
+		// With proposer-based timestamps, the timeliness of the block time
+		// is checked by consensus, when receiving the proposal.
+		if state.ConsensusParams.Feature.PbtsEnabled(block.Height) {
+			break
+		}
 		medianTime := MedianTime(block.LastCommit, state.LastValidators)
 		if !block.Time.Equal(medianTime) {
 			return fmt.Errorf("invalid block time. Expected %v, got %v",
@@ -129,6 +134,15 @@ func validateBlock(state State, block *types.Block) error {
 
 	case block.Height == state.InitialHeight:
 		genesisTime := state.LastBlockTime
+		if state.ConsensusParams.Feature.PbtsEnabled(block.Height) {
+			if block.Time.Before(genesisTime) {
+				return fmt.Errorf("block time %v is before genesis time %v",
+					block.Time,
+					genesisTime,
+				)
+			}
+			break
+		}
 		if !block.Time.Equal(genesisTime) {
 			return fmt.Errorf("block time %v is not equal to genesis time %v",
 				block.Time,
@@ -6,10 +6,23 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/libs/tempfile"
 	"github.com/cometbft/cometbft/p2p"
+	ssproto "github.com/cometbft/cometbft/proto/tendermint/statesync"
+)
+
+const (
+	// restoreDirName is the directory within the state sync temp dir in which the
+	// restore in progress is kept, so that it can be resumed after a restart.
+	restoreDirName = "statesync-restore"
+
+	restoreSnapshotFile = "snapshot"
 )
 
 // errDone is returned by chunkQueue.Next() when all chunks have been returned.
@@ -31,6 +44,7 @@ type chunkQueue struct {
 	cmtsync.Mutex
 	snapshot       *snapshot                  // if this is nil, the queue has been closed
 	dir            string                     // temp dir for on-disk chunk storage
+	persistent     bool                       // keep dir on Close(), to resume the restore
 	chunkFiles     map[uint32]string          // path to temporary chunk file
 	chunkSenders   map[uint32]p2p.ID          // the peer who sent the given chunk
 	chunkAllocated map[uint32]bool            // chunks that have been allocated via Allocate()
@@ -59,6 +73,91 @@ func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
 	}, nil
 }
 
+// openChunkQueue opens a chunk queue for a snapshot persisted in the given dir, which outlives
+// the process. The chunks fetched by a previous restore of the same snapshot are reused, while
+// those of any other snapshot are removed. Callers must call Close() when done, which keeps the
+// chunks on disk, or Remove() once the snapshot is restored or abandoned.
+func openChunkQueue(snapshot *snapshot, dir string) (*chunkQueue, error) {
+	if snapshot.Chunks == 0 {
+		return nil, errors.New("snapshot has no chunks")
+	}
+	restoring, err := loadRestore(dir)
+	if err != nil {
+		return nil, err
+	}
+	if restoring == nil || restoring.Key() != snapshot.Key() {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf("failed to clean up state sync dir %v: %w", dir, err)
+		}
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create state sync dir %v: %w", dir, err)
+	}
+	bz, err := proto.Marshal(&ssproto.SnapshotsResponse{
+		Height:   snapshot.Height,
+		Format:   snapshot.Format,
+		Chunks:   snapshot.Chunks,
+		Hash:     snapshot.Hash,
+		Metadata: snapshot.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tempfile.WriteFileAtomic(filepath.Join(dir, restoreSnapshotFile), bz, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to save snapshot being restored: %w", err)
+	}
+
+	q := &chunkQueue{
+		snapshot:       snapshot,
+		dir:            dir,
+		persistent:     true,
+		chunkFiles:     make(map[uint32]string, snapshot.Chunks),
+		chunkSenders:   make(map[uint32]p2p.ID, snapshot.Chunks),
+		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
+		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
+		waiters:        make(map[uint32][]chan<- uint32),
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state sync dir %v: %w", dir, err)
+	}
+	for _, entry := range entries {
+		name, sender, ok := strings.Cut(entry.Name(), "-")
+		if !ok {
+			continue
+		}
+		index, err := strconv.ParseUint(name, 10, 32)
+		if err != nil || uint32(index) >= snapshot.Chunks {
+			continue
+		}
+		q.chunkFiles[uint32(index)] = filepath.Join(dir, entry.Name())
+		q.chunkSenders[uint32(index)] = p2p.ID(sender)
+		q.chunkAllocated[uint32(index)] = true
+	}
+	return q, nil
+}
+
+// loadRestore loads the snapshot being restored in the given dir, or nil if there is none.
+func loadRestore(dir string) (*snapshot, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, restoreSnapshotFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot being restored: %w", err)
+	}
+	var pb ssproto.SnapshotsResponse
+	if err := proto.Unmarshal(bz, &pb); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot being restored: %w", err)
+	}
+	return &snapshot{
+		Height:   pb.Height,
+		Format:   pb.Format,
+		Chunks:   pb.Chunks,
+		Hash:     pb.Hash,
+		Metadata: pb.Metadata,
+	}, nil
+}
+
 // Add adds a chunk to the queue. It ignores chunks that already exist, returning false.
 func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 	if chunk == nil || chunk.Chunk == nil {
@@ -82,8 +181,11 @@ func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 		return false, nil
 	}
 
-	path := filepath.Join(q.dir, strconv.FormatUint(uint64(chunk.Index), 10))
-	err := os.WriteFile(path, chunk.Chunk, 0600)
+	// The sender is kept in the file name, so that the chunks reused after a restart can still be
+	// discarded along with the other chunks of their sender. The file is written atomically, so
+	// that a restart never leaves a partial chunk behind.
+	path := filepath.Join(q.dir, fmt.Sprintf("%d-%s", chunk.Index, chunk.Sender))
+	err := tempfile.WriteFileAtomic(path, chunk.Chunk, 0o600)
 	if err != nil {
 		return false, fmt.Errorf("failed to save chunk %v to file %v: %w", chunk.Index, path, err)
 	}
@@ -120,10 +222,46 @@ func (q *chunkQueue) Allocate() (uint32, error) {
 	return 0, errDone
 }
 
-// Close closes the chunk queue, cleaning up all temporary files.
+// ChunkSize returns the size of the chunk with the given index, or 0 if not found.
+func (q *chunkQueue) ChunkSize(index uint32) int {
+	q.Lock()
+	defer q.Unlock()
+	path := q.chunkFiles[index]
+	if path == "" {
+		return 0
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return int(info.Size())
+}
+
+// Close closes the chunk queue, cleaning up all temporary files. The files of a queue opened with
+// openChunkQueue() are kept, so that the restore can be resumed.
 func (q *chunkQueue) Close() error {
 	q.Lock()
 	defer q.Unlock()
+	return q.close(!q.persistent)
+}
+
+// Remove closes the chunk queue and removes all its files, including those of a queue opened with
+// openChunkQueue().
+func (q *chunkQueue) Remove() error {
+	q.Lock()
+	defer q.Unlock()
+	if err := q.close(false); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(q.dir); err != nil {
+		return fmt.Errorf("failed to clean up state sync tempdir %v: %w", q.dir, err)
+	}
+	return nil
+}
+
+// close closes the chunk queue, removing its files if remove is set. The caller must hold the
+// mutex lock.
+func (q *chunkQueue) close(remove bool) error {
 	if q.snapshot == nil {
 		return nil
 	}
@@ -134,8 +272,10 @@ func (q *chunkQueue) Close() error {
 	}
 	q.waiters = nil
 	q.snapshot = nil
-	err := os.RemoveAll(q.dir)
-	if err != nil {
+	if !remove {
+		return nil
+	}
+	if err := os.RemoveAll(q.dir); err != nil {
 		return fmt.Errorf("failed to clean up state sync tempdir %v: %w", q.dir, err)
 	}
 	return nil
@@ -2,6 +2,7 @@ package statesync
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,6 +54,68 @@ func TestNewChunkQueue_TempDir(t *testing.T) {
 	assert.Len(t, files, 0)
 }
 
+func TestOpenChunkQueue(t *testing.T) {
+	s := &snapshot{Height: 3, Format: 1, Chunks: 5, Hash: []byte{7}, Metadata: []byte{1}}
+	dir := filepath.Join(t.TempDir(), restoreDirName)
+
+	restoring, err := loadRestore(dir)
+	require.NoError(t, err)
+	assert.Nil(t, restoring)
+
+	queue, err := openChunkQueue(s, dir)
+	require.NoError(t, err)
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 0, Chunk: []byte{3, 1, 0}, Sender: "a"})
+	require.NoError(t, err)
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 2, Chunk: []byte{3, 1, 2}, Sender: "b"})
+	require.NoError(t, err)
+
+	// The queue isn't closed, as if the process had stopped in the middle of the restore.
+	restoring, err = loadRestore(dir)
+	require.NoError(t, err)
+	assert.Equal(t, s, restoring)
+
+	// Reopening the queue for the same snapshot reuses the fetched chunks, with their senders.
+	queue, err = openChunkQueue(restoring, dir)
+	require.NoError(t, err)
+	assert.True(t, queue.Has(0))
+	assert.False(t, queue.Has(1))
+	assert.True(t, queue.Has(2))
+	assert.EqualValues(t, "a", queue.GetSender(0))
+	assert.EqualValues(t, "b", queue.GetSender(2))
+	index, err := queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, index)
+	c, err := queue.Next()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3, 1, 0}, c.Chunk)
+	assert.EqualValues(t, "a", c.Sender)
+
+	// The reused chunks are discarded along with the other chunks of their sender.
+	require.NoError(t, queue.DiscardSender("b"))
+	assert.False(t, queue.Has(2))
+	index, err = queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, index)
+
+	// Opening it for another snapshot discards them.
+	other := &snapshot{Height: 4, Format: 1, Chunks: 5, Hash: []byte{8}}
+	queue, err = openChunkQueue(other, dir)
+	require.NoError(t, err)
+	assert.False(t, queue.Has(0))
+	restoring, err = loadRestore(dir)
+	require.NoError(t, err)
+	assert.Equal(t, other, restoring)
+
+	// Closing the queue keeps the restore, which is only removed once done.
+	require.NoError(t, queue.Close())
+	restoring, err = loadRestore(dir)
+	require.NoError(t, err)
+	assert.Equal(t, other, restoring)
+	require.NoError(t, queue.Remove())
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestChunkQueue(t *testing.T) {
 	queue, teardown := setupChunkQueue(t)
 	defer teardown()
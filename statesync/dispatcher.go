@@ -0,0 +1,206 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/p2p"
+	ssproto "github.com/cometbft/cometbft/proto/tendermint/statesync"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// lightBlockResponseTimeout is how long the dispatcher waits for a peer to
+// respond to a request.
+var lightBlockResponseTimeout = 10 * time.Second
+
+// lightBlockSource is the source of the light blocks and consensus params
+// served to peers.
+type lightBlockSource interface {
+	// LoadLightBlock returns the light block at the given height, or the
+	// latest light block if the height is 0. It returns nil if the light block
+	// isn't available.
+	LoadLightBlock(height int64) (*types.LightBlock, error)
+	// LoadConsensusParams returns the consensus params of the given height, or
+	// nil if they aren't available.
+	LoadConsensusParams(height int64) (*types.ConsensusParams, error)
+}
+
+// storeLightBlockSource serves the light blocks and consensus params of the
+// block and state stores of a node.
+type storeLightBlockSource struct {
+	stateStore sm.Store
+	blockStore sm.BlockStore
+}
+
+var _ lightBlockSource = storeLightBlockSource{}
+
+// LoadLightBlock implements lightBlockSource.
+func (s storeLightBlockSource) LoadLightBlock(height int64) (*types.LightBlock, error) {
+	if height == 0 {
+		height = s.blockStore.Height()
+	}
+	return loadLightBlock(s.blockStore, s.stateStore, height)
+}
+
+// LoadConsensusParams implements lightBlockSource.
+func (s storeLightBlockSource) LoadConsensusParams(height int64) (*types.ConsensusParams, error) {
+	// NOTE: the consensus params of the heights beyond the next height aren't
+	// stored yet.
+	if height > s.blockStore.Height()+1 {
+		return nil, nil
+	}
+	params, err := s.stateStore.LoadConsensusParams(height)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+// callKey identifies a request sent to a peer, to which a single response is
+// expected.
+type callKey struct {
+	peer    p2p.ID
+	channel byte
+	height  uint64
+}
+
+// dispatcher sends requests to peers over the light block and params channels,
+// and routes their responses back to the callers.
+type dispatcher struct {
+	mtx   cmtsync.Mutex
+	calls map[callKey]chan proto.Message
+}
+
+func newDispatcher() *dispatcher {
+	return &dispatcher{calls: make(map[callKey]chan proto.Message)}
+}
+
+// call sends a request for the given height to the peer, and waits for its
+// response. It returns lightprovider.ErrNoResponse if the peer can't be
+// reached or doesn't respond in time.
+func (d *dispatcher) call(
+	ctx context.Context, peer p2p.Peer, channel byte, height uint64, req proto.Message,
+) (proto.Message, error) {
+	key := callKey{peer: peer.ID(), channel: channel, height: height}
+	respCh := make(chan proto.Message, 1)
+	d.mtx.Lock()
+	if _, ok := d.calls[key]; ok {
+		d.mtx.Unlock()
+		return nil, fmt.Errorf("a request for height %d is already pending with peer %v", height, peer.ID())
+	}
+	d.calls[key] = respCh
+	d.mtx.Unlock()
+	defer func() {
+		d.mtx.Lock()
+		delete(d.calls, key)
+		d.mtx.Unlock()
+	}()
+
+	if !peer.Send(p2p.Envelope{ChannelID: channel, Message: req}) {
+		return nil, lightprovider.ErrNoResponse
+	}
+	timer := time.NewTimer(lightBlockResponseTimeout)
+	defer timer.Stop()
+	select {
+	case resp := <-respCh:
+		return resp, nil
+	case <-timer.C:
+		return nil, lightprovider.ErrNoResponse
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// respond delivers the response of a peer to the pending request it answers.
+// It returns false if no such request is pending.
+func (d *dispatcher) respond(peer p2p.ID, channel byte, height uint64, resp proto.Message) bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	respCh, ok := d.calls[callKey{peer: peer, channel: channel, height: height}]
+	if !ok {
+		return false
+	}
+	select {
+	case respCh <- resp:
+		return true
+	default:
+		// a response was already delivered
+		return false
+	}
+}
+
+// p2pProvider is a light client provider fetching light blocks from a peer.
+type p2pProvider struct {
+	chainID    string
+	peer       p2p.Peer
+	dispatcher *dispatcher
+}
+
+var _ lightprovider.Provider = (*p2pProvider)(nil)
+
+// ChainID implements lightprovider.Provider.
+func (p *p2pProvider) ChainID() string {
+	return p.chainID
+}
+
+// LightBlock implements lightprovider.Provider.
+func (p *p2pProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("negative height %d", height)
+	}
+	resp, err := p.dispatcher.call(ctx, p.peer, LightBlockChannel, uint64(height),
+		&ssproto.LightBlockRequest{Height: uint64(height)})
+	if err != nil {
+		return nil, err
+	}
+	pb := resp.(*ssproto.LightBlockResponse).LightBlock
+	if pb == nil {
+		return nil, lightprovider.ErrLightBlockNotFound
+	}
+	lb, err := types.LightBlockFromProto(pb)
+	if err != nil {
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	if height != 0 && lb.Height != height {
+		return nil, lightprovider.ErrBadLightBlock{
+			Reason: fmt.Errorf("expected height %d, got %d", height, lb.Height),
+		}
+	}
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	return lb, nil
+}
+
+// ReportEvidence implements lightprovider.Provider. Peers are not sent
+// evidence.
+func (p *p2pProvider) ReportEvidence(context.Context, types.Evidence) error {
+	return errors.New("evidence can't be reported to peers")
+}
+
+// consensusParams fetches the consensus params of the given height from the
+// peer, or returns nil if the peer doesn't have them.
+func (p *p2pProvider) consensusParams(ctx context.Context, height int64) (*types.ConsensusParams, error) {
+	resp, err := p.dispatcher.call(ctx, p.peer, ParamsChannel, uint64(height),
+		&ssproto.ParamsRequest{Height: uint64(height)})
+	if err != nil {
+		return nil, err
+	}
+	pb := resp.(*ssproto.ParamsResponse).ConsensusParams
+	if pb == nil {
+		return nil, nil
+	}
+	params := types.ConsensusParamsFromProto(*pb)
+	return &params, nil
+}
+
+func (p *p2pProvider) String() string {
+	return fmt.Sprintf("p2p{%v}", p.peer.ID())
+}
@@ -0,0 +1,121 @@
+package statesync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/p2p"
+	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+// connectReactors returns the mock peers through which the reactors send
+// messages to each other, after a wire roundtrip.
+func connectReactors(t *testing.T, r1, r2 *Reactor) (peer1, peer2 *p2pmocks.Peer) {
+	t.Helper()
+	peer1, peer2 = &p2pmocks.Peer{}, &p2pmocks.Peer{}
+	peer1.On("ID").Return(p2p.ID("peer1"))
+	peer2.On("ID").Return(p2p.ID("peer2"))
+	forward := func(to *Reactor, src p2p.Peer) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			e := args[0].(p2p.Envelope)
+			bz, err := proto.Marshal(e.Message)
+			require.NoError(t, err)
+			require.NoError(t, proto.Unmarshal(bz, e.Message))
+			go to.Receive(p2p.Envelope{ChannelID: e.ChannelID, Src: src, Message: e.Message})
+		}
+	}
+	peer1.On("Send", mock.Anything).Run(forward(r1, peer2)).Return(true)
+	peer2.On("Send", mock.Anything).Run(forward(r2, peer1)).Return(true)
+	return peer1, peer2
+}
+
+func startReactor(t *testing.T, options ...ReactorOption) *Reactor {
+	t.Helper()
+	r := NewReactor(*config.DefaultStateSyncConfig(), nil, nil, NopMetrics(), options...)
+	require.NoError(t, r.Start())
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return r
+}
+
+func TestP2PProvider(t *testing.T) {
+	dir, err := NewSnapshotDir(t.TempDir())
+	require.NoError(t, err)
+	lb := makeTestLightBlock(t, 3)
+	require.NoError(t, dir.SaveLightBlock(lb))
+	require.NoError(t, dir.SaveConsensusParams(3, *types.DefaultConsensusParams()))
+
+	server := startReactor(t, WithSnapshotDir(dir))
+	client := startReactor(t)
+	serverPeer, _ := connectReactors(t, server, client)
+	p := &p2pProvider{chainID: lb.ChainID, peer: serverPeer, dispatcher: client.dispatcher}
+	ctx := context.Background()
+
+	loaded, err := p.LightBlock(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, lb.Hash(), loaded.Hash())
+
+	// the latest light block
+	loaded, err = p.LightBlock(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, lb.Hash(), loaded.Hash())
+
+	_, err = p.LightBlock(ctx, 4)
+	assert.Equal(t, lightprovider.ErrLightBlockNotFound, err)
+
+	params, err := p.consensusParams(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultConsensusParams(), params)
+	params, err = p.consensusParams(ctx, 4)
+	require.NoError(t, err)
+	assert.Nil(t, params)
+
+	// the light blocks of other chains are rejected
+	p.chainID = "other-chain"
+	_, err = p.LightBlock(ctx, 3)
+	assert.IsType(t, lightprovider.ErrBadLightBlock{}, err)
+}
+
+func TestP2PProviderNoResponse(t *testing.T) {
+	timeout := lightBlockResponseTimeout
+	lightBlockResponseTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { lightBlockResponseTimeout = timeout })
+
+	d := newDispatcher()
+	ctx := context.Background()
+
+	silentPeer := &p2pmocks.Peer{}
+	silentPeer.On("ID").Return(p2p.ID("silent"))
+	silentPeer.On("Send", mock.Anything).Return(true)
+	p := &p2pProvider{chainID: "test-chain", peer: silentPeer, dispatcher: d}
+	_, err := p.LightBlock(ctx, 1)
+	assert.Equal(t, lightprovider.ErrNoResponse, err)
+
+	unreachablePeer := &p2pmocks.Peer{}
+	unreachablePeer.On("ID").Return(p2p.ID("unreachable"))
+	unreachablePeer.On("Send", mock.Anything).Return(false)
+	p = &p2pProvider{chainID: "test-chain", peer: unreachablePeer, dispatcher: d}
+	_, err = p.LightBlock(ctx, 1)
+	assert.Equal(t, lightprovider.ErrNoResponse, err)
+
+	// the responses which were not requested are dropped
+	assert.False(t, d.respond("silent", LightBlockChannel, 1, nil))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	p = &p2pProvider{chainID: "test-chain", peer: silentPeer, dispatcher: d}
+	_, err = p.LightBlock(canceled, 1)
+	assert.Equal(t, context.Canceled, err)
+}
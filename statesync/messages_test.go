@@ -70,6 +70,16 @@ func TestValidateMsg(t *testing.T) {
 		"SnapshotsResponse no hash": {
 			&ssproto.SnapshotsResponse{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}},
 			false},
+
+		"LightBlockRequest valid":    {&ssproto.LightBlockRequest{Height: 1}, true},
+		"LightBlockRequest 0 height": {&ssproto.LightBlockRequest{Height: 0}, true},
+		"LightBlockResponse valid":   {&ssproto.LightBlockResponse{Height: 1, LightBlock: &cmtproto.LightBlock{}}, true},
+		"LightBlockResponse missing": {&ssproto.LightBlockResponse{Height: 1}, true},
+
+		"ParamsRequest valid":     {&ssproto.ParamsRequest{Height: 1}, true},
+		"ParamsRequest 0 height":  {&ssproto.ParamsRequest{Height: 0}, false},
+		"ParamsResponse valid":    {&ssproto.ParamsResponse{Height: 1, ConsensusParams: &cmtproto.ConsensusParams{}}, true},
+		"ParamsResponse 0 height": {&ssproto.ParamsResponse{Height: 0}, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -9,7 +9,9 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/light"
 	"github.com/cometbft/cometbft/p2p"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	ssproto "github.com/cometbft/cometbft/proto/tendermint/statesync"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
@@ -21,6 +23,10 @@ const (
 	SnapshotChannel = byte(0x60)
 	// ChunkChannel exchanges chunk contents
 	ChunkChannel = byte(0x61)
+	// LightBlockChannel exchanges light blocks
+	LightBlockChannel = byte(0x62)
+	// ParamsChannel exchanges consensus params
+	ParamsChannel = byte(0x63)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
 )
@@ -33,33 +39,67 @@ type Reactor struct {
 	cfg       config.StateSyncConfig
 	conn      proxy.AppConnSnapshot
 	connQuery proxy.AppConnQuery
+	snapshots snapshotSource
 	tempDir   string
 	metrics   *Metrics
 
+	// lightBlocks is the source of the light blocks and consensus params
+	// served to peers, nil if they aren't served.
+	lightBlocks lightBlockSource
+	dispatcher  *dispatcher
+
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
 	mtx    cmtsync.RWMutex
 	syncer *syncer
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
 // NewReactor creates a new state sync reactor.
 func NewReactor(
 	cfg config.StateSyncConfig,
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
 	metrics *Metrics,
+	options ...ReactorOption,
 ) *Reactor {
 	r := &Reactor{
-		cfg:       cfg,
-		conn:      conn,
-		connQuery: connQuery,
-		metrics:   metrics,
+		cfg:        cfg,
+		conn:       conn,
+		connQuery:  connQuery,
+		snapshots:  conn,
+		tempDir:    cfg.TempDir,
+		metrics:    metrics,
+		dispatcher: newDispatcher(),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r)
 
+	for _, option := range options {
+		option(r)
+	}
 	return r
 }
 
+// WithSnapshotDir makes the reactor serve the snapshots of the given
+// directory instead of the snapshots of the application. It is used by
+// snapshot servers, which don't run an application, and thus can't sync.
+func WithSnapshotDir(dir *SnapshotDir) ReactorOption {
+	return func(r *Reactor) {
+		r.snapshots = dir
+		r.lightBlocks = dir
+	}
+}
+
+// WithStores makes the reactor serve the light blocks and consensus params of
+// the given stores to the peers state syncing over P2P.
+func WithStores(stateStore sm.Store, blockStore sm.BlockStore) ReactorOption {
+	return func(r *Reactor) {
+		r.lightBlocks = storeLightBlockSource{stateStore: stateStore, blockStore: blockStore}
+	}
+}
+
 // GetChannels implements p2p.Reactor.
 func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	return []*p2p.ChannelDescriptor{
@@ -77,6 +117,20 @@ func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvMessageCapacity: chunkMsgSize,
 			MessageType:         &ssproto.Message{},
 		},
+		{
+			ID:                  LightBlockChannel,
+			Priority:            5,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: lightBlockMsgSize,
+			MessageType:         &ssproto.Message{},
+		},
+		{
+			ID:                  ParamsChannel,
+			Priority:            2,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: paramsMsgSize,
+			MessageType:         &ssproto.Message{},
+		},
 	}
 }
 
@@ -171,7 +225,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 		case *ssproto.ChunkRequest:
 			r.Logger.Debug("Received chunk request", "height", msg.Height, "format", msg.Format,
 				"chunk", msg.Index, "peer", e.Src.ID())
-			resp, err := r.conn.LoadSnapshotChunk(context.TODO(), &abci.RequestLoadSnapshotChunk{
+			resp, err := r.snapshots.LoadSnapshotChunk(context.TODO(), &abci.RequestLoadSnapshotChunk{
 				Height: msg.Height,
 				Format: msg.Format,
 				Chunk:  msg.Index,
@@ -220,6 +274,58 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 			r.Logger.Error("Received unknown message %T", msg)
 		}
 
+	case LightBlockChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.LightBlockRequest:
+			r.Logger.Debug("Received light block request", "height", msg.Height, "peer", e.Src.ID())
+			resp := &ssproto.LightBlockResponse{Height: msg.Height}
+			if r.lightBlocks != nil {
+				lb, err := r.lightBlocks.LoadLightBlock(int64(msg.Height))
+				if err != nil {
+					r.Logger.Error("Failed to load light block", "height", msg.Height, "err", err)
+				} else if lb != nil {
+					resp.LightBlock, err = lb.ToProto()
+					if err != nil {
+						r.Logger.Error("Failed to convert light block to proto", "height", msg.Height, "err", err)
+					}
+				}
+			}
+			e.Src.Send(p2p.Envelope{ChannelID: LightBlockChannel, Message: resp})
+
+		case *ssproto.LightBlockResponse:
+			if !r.dispatcher.respond(e.Src.ID(), LightBlockChannel, msg.Height, msg) {
+				r.Logger.Debug("Received unexpected light block", "height", msg.Height, "peer", e.Src.ID())
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
+	case ParamsChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.ParamsRequest:
+			r.Logger.Debug("Received consensus params request", "height", msg.Height, "peer", e.Src.ID())
+			resp := &ssproto.ParamsResponse{Height: msg.Height}
+			if r.lightBlocks != nil {
+				params, err := r.lightBlocks.LoadConsensusParams(int64(msg.Height))
+				if err != nil {
+					r.Logger.Error("Failed to load consensus params", "height", msg.Height, "err", err)
+				} else if params != nil {
+					pb := params.ToProto()
+					resp.ConsensusParams = &pb
+				}
+			}
+			e.Src.Send(p2p.Envelope{ChannelID: ParamsChannel, Message: resp})
+
+		case *ssproto.ParamsResponse:
+			if !r.dispatcher.respond(e.Src.ID(), ParamsChannel, msg.Height, msg) {
+				r.Logger.Debug("Received unexpected consensus params", "height", msg.Height, "peer", e.Src.ID())
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
 	default:
 		r.Logger.Error("Received message on invalid channel %x", e.ChannelID)
 	}
@@ -227,7 +333,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 
 // recentSnapshots fetches the n most recent snapshots from the app
 func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
-	resp, err := r.conn.ListSnapshots(context.TODO(), &abci.RequestListSnapshots{})
+	resp, err := r.snapshots.ListSnapshots(context.TODO(), &abci.RequestListSnapshots{})
 	if err != nil {
 		return nil, err
 	}
@@ -259,9 +365,49 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	return snapshots, nil
 }
 
+// NewP2PStateProvider creates a new StateProvider using a light client, with
+// the light blocks and consensus params fetched from the connected peers over
+// the light block and params channels, instead of RPC servers. It waits until
+// at least 2 peers serving them are connected.
+func (r *Reactor) NewP2PStateProvider(
+	ctx context.Context,
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+) (StateProvider, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	lastCount := -1
+	for {
+		var peers []p2p.Peer
+		for _, peer := range r.Switch.Peers().List() {
+			if ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok && ni.HasChannel(LightBlockChannel) {
+				peers = append(peers, peer)
+			}
+		}
+		if len(peers) >= 2 {
+			return newP2PStateProvider(ctx, chainID, version, initialHeight, peers, r.dispatcher,
+				trustOptions, r.Logger.With("module", "light"))
+		}
+		if len(peers) != lastCount {
+			r.Logger.Info("Waiting for peers serving light blocks", "peers", len(peers))
+			lastCount = len(peers)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
 // Sync runs a state sync, returning the new state and last commit at the snapshot height.
 // The caller must store the state and commit in the state database and block store.
 func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration) (sm.State, *types.Commit, error) {
+	if r.conn == nil {
+		return sm.State{}, nil, errors.New("a snapshot server can't state sync")
+	}
 	r.mtx.Lock()
 	if r.syncer != nil {
 		r.mtx.Unlock()
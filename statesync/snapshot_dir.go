@@ -0,0 +1,328 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+/*
+SnapshotDir is a directory of snapshots exported from an application, along
+with the light blocks needed to verify them, which a snapshot server serves to
+the nodes state syncing instead of the application. Its layout is:
+
+	snapshots/<height>-<format>/snapshot  the snapshot metadata
+	snapshots/<height>-<format>/<index>   the snapshot chunks
+	light_blocks/<height>                 the light blocks
+	params/<height>                       the consensus params
+
+The snapshot metadata is saved last, the snapshots without metadata are
+ignored, so that incomplete exports are never served.
+*/
+type SnapshotDir struct {
+	dir string
+}
+
+// snapshotSource is the source of the snapshots served to peers.
+type snapshotSource interface {
+	ListSnapshots(context.Context, *abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error)
+	LoadSnapshotChunk(context.Context, *abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error)
+}
+
+var (
+	_ snapshotSource   = (*SnapshotDir)(nil)
+	_ lightBlockSource = (*SnapshotDir)(nil)
+)
+
+// NewSnapshotDir returns the SnapshotDir in the given directory, creating it
+// if it doesn't exist.
+func NewSnapshotDir(dir string) (*SnapshotDir, error) {
+	for _, sub := range []string{"snapshots", "light_blocks", "params"} {
+		if err := cmtos.EnsureDir(filepath.Join(dir, sub), 0o700); err != nil {
+			return nil, err
+		}
+	}
+	return &SnapshotDir{dir: dir}, nil
+}
+
+func (d *SnapshotDir) snapshotDir(height uint64, format uint32) string {
+	return filepath.Join(d.dir, "snapshots", fmt.Sprintf("%d-%d", height, format))
+}
+
+// SaveChunk saves a chunk of a snapshot.
+func (d *SnapshotDir) SaveChunk(height uint64, format, index uint32, chunk []byte) error {
+	dir := d.snapshotDir(height, format)
+	if err := cmtos.EnsureDir(dir, 0o700); err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filepath.Join(dir, strconv.FormatUint(uint64(index), 10)), chunk, 0o600)
+}
+
+// SaveSnapshot saves the metadata of a snapshot, which makes it available to
+// peers. All its chunks must have been saved.
+func (d *SnapshotDir) SaveSnapshot(snapshot *abci.Snapshot) error {
+	dir := d.snapshotDir(snapshot.Height, snapshot.Format)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if !cmtos.FileExists(filepath.Join(dir, strconv.FormatUint(uint64(i), 10))) {
+			return fmt.Errorf("chunk %d of snapshot %d-%d is missing", i, snapshot.Height, snapshot.Format)
+		}
+	}
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filepath.Join(dir, "snapshot"), bz, 0o600)
+}
+
+// SaveLightBlock saves a light block.
+func (d *SnapshotDir) SaveLightBlock(lb *types.LightBlock) error {
+	pb, err := lb.ToProto()
+	if err != nil {
+		return err
+	}
+	bz, err := proto.Marshal(pb)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(d.lightBlockPath(lb.Height), bz, 0o600)
+}
+
+// LoadLightBlock loads the light block at the given height, or the latest
+// light block if the height is 0. It returns nil if the light block isn't
+// stored.
+func (d *SnapshotDir) LoadLightBlock(height int64) (*types.LightBlock, error) {
+	if height == 0 {
+		latest, err := d.latestLightBlockHeight()
+		if err != nil || latest == 0 {
+			return nil, err
+		}
+		height = latest
+	}
+	bz, err := os.ReadFile(d.lightBlockPath(height))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pb := new(cmtproto.LightBlock)
+	if err := proto.Unmarshal(bz, pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal light block %d: %w", height, err)
+	}
+	return types.LightBlockFromProto(pb)
+}
+
+func (d *SnapshotDir) latestLightBlockHeight() (int64, error) {
+	entries, err := os.ReadDir(filepath.Join(d.dir, "light_blocks"))
+	if err != nil {
+		return 0, err
+	}
+	var latest int64
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, height)
+	}
+	return latest, nil
+}
+
+func (d *SnapshotDir) lightBlockPath(height int64) string {
+	return filepath.Join(d.dir, "light_blocks", strconv.FormatInt(height, 10))
+}
+
+// SaveConsensusParams saves the consensus params of a height.
+func (d *SnapshotDir) SaveConsensusParams(height int64, params types.ConsensusParams) error {
+	pb := params.ToProto()
+	bz, err := proto.Marshal(&pb)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(d.paramsPath(height), bz, 0o600)
+}
+
+// LoadConsensusParams loads the consensus params of the given height, or
+// returns nil if they aren't stored.
+func (d *SnapshotDir) LoadConsensusParams(height int64) (*types.ConsensusParams, error) {
+	bz, err := os.ReadFile(d.paramsPath(height))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pb := new(cmtproto.ConsensusParams)
+	if err := proto.Unmarshal(bz, pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal consensus params %d: %w", height, err)
+	}
+	params := types.ConsensusParamsFromProto(*pb)
+	return &params, nil
+}
+
+func (d *SnapshotDir) paramsPath(height int64) string {
+	return filepath.Join(d.dir, "params", strconv.FormatInt(height, 10))
+}
+
+// ListSnapshots returns the snapshots whose metadata has been saved. It has
+// the signature of the ABCI method, so that snapshot servers can serve the
+// directory in lieu of an application.
+func (d *SnapshotDir) ListSnapshots(
+	context.Context, *abci.RequestListSnapshots,
+) (*abci.ResponseListSnapshots, error) {
+	entries, err := os.ReadDir(filepath.Join(d.dir, "snapshots"))
+	if err != nil {
+		return nil, err
+	}
+	resp := &abci.ResponseListSnapshots{}
+	for _, entry := range entries {
+		bz, err := os.ReadFile(filepath.Join(d.dir, "snapshots", entry.Name(), "snapshot"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		snapshot := new(abci.Snapshot)
+		if err := proto.Unmarshal(bz, snapshot); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal snapshot %s: %w", entry.Name(), err)
+		}
+		resp.Snapshots = append(resp.Snapshots, snapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunk loads a chunk of a snapshot, or returns a nil chunk if it
+// isn't stored. It has the signature of the ABCI method, so that snapshot
+// servers can serve the directory in lieu of an application.
+func (d *SnapshotDir) LoadSnapshotChunk(
+	_ context.Context, req *abci.RequestLoadSnapshotChunk,
+) (*abci.ResponseLoadSnapshotChunk, error) {
+	chunk, err := os.ReadFile(filepath.Join(d.snapshotDir(req.Height, req.Format), strconv.FormatUint(uint64(req.Chunk), 10)))
+	if errors.Is(err, os.ErrNotExist) {
+		return &abci.ResponseLoadSnapshotChunk{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &abci.ResponseLoadSnapshotChunk{Chunk: chunk}, nil
+}
+
+// ExportSnapshots copies the snapshots of the application to the directory,
+// along with the light blocks and consensus params at their height and at the
+// two following heights, which the nodes state syncing verify. It exports the snapshot of
+// the given height only, or all the snapshots if it is 0, and returns the
+// exported snapshots. The snapshots whose light blocks are not in the stores
+// yet are skipped.
+func ExportSnapshots(
+	ctx context.Context,
+	conn proxy.AppConnSnapshot,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	dir *SnapshotDir,
+	height uint64,
+) ([]*abci.Snapshot, error) {
+	resp, err := conn.ListSnapshots(ctx, &abci.RequestListSnapshots{})
+	if err != nil {
+		return nil, err
+	}
+
+	var exported []*abci.Snapshot
+	for _, snapshot := range resp.Snapshots {
+		if height != 0 && snapshot.Height != height {
+			continue
+		}
+		lightBlocks := make([]*types.LightBlock, 0, 3)
+		for h := int64(snapshot.Height); h <= int64(snapshot.Height)+2; h++ {
+			lb, err := loadLightBlock(blockStore, stateStore, h)
+			if err != nil {
+				return exported, err
+			}
+			if lb == nil {
+				break
+			}
+			lightBlocks = append(lightBlocks, lb)
+		}
+		if len(lightBlocks) < 3 {
+			continue
+		}
+
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			resp, err := conn.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{
+				Height: snapshot.Height,
+				Format: snapshot.Format,
+				Chunk:  i,
+			})
+			if err != nil {
+				return exported, err
+			}
+			if resp.Chunk == nil {
+				return exported, fmt.Errorf("chunk %d of snapshot %d-%d is missing", i, snapshot.Height, snapshot.Format)
+			}
+			if err := dir.SaveChunk(snapshot.Height, snapshot.Format, i, resp.Chunk); err != nil {
+				return exported, err
+			}
+		}
+		for _, lb := range lightBlocks {
+			if err := dir.SaveLightBlock(lb); err != nil {
+				return exported, err
+			}
+			params, err := stateStore.LoadConsensusParams(lb.Height)
+			if err != nil {
+				return exported, err
+			}
+			if err := dir.SaveConsensusParams(lb.Height, params); err != nil {
+				return exported, err
+			}
+		}
+		if err := dir.SaveSnapshot(snapshot); err != nil {
+			return exported, err
+		}
+		exported = append(exported, snapshot)
+	}
+	return exported, nil
+}
+
+// loadLightBlock builds the light block at the given height from the stores,
+// or returns nil if its header, commit or validators are not stored.
+func loadLightBlock(blockStore sm.BlockStore, stateStore sm.Store, height int64) (*types.LightBlock, error) {
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, nil
+	}
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, nil
+	}
+	vals, err := stateStore.LoadValidators(height)
+	if errors.As(err, &sm.ErrNoValSetForHeight{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &meta.Header, Commit: commit},
+		ValidatorSet: vals,
+	}
+	if err := lb.ValidateBasic(meta.Header.ChainID); err != nil {
+		return nil, fmt.Errorf("invalid light block %d: %w", height, err)
+	}
+	return lb, nil
+}
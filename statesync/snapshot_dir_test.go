@@ -0,0 +1,149 @@
+package statesync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	sm "github.com/cometbft/cometbft/state"
+	smmocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSnapshotDir(t *testing.T) {
+	dir, err := NewSnapshotDir(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	snapshot := &abci.Snapshot{Height: 3, Format: 1, Chunks: 2, Hash: []byte{1}}
+	require.NoError(t, dir.SaveChunk(3, 1, 0, []byte{1, 2}))
+	require.Error(t, dir.SaveSnapshot(snapshot))
+
+	// incomplete snapshots are not listed
+	resp, err := dir.ListSnapshots(ctx, &abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Snapshots)
+
+	require.NoError(t, dir.SaveChunk(3, 1, 1, []byte{3}))
+	require.NoError(t, dir.SaveSnapshot(snapshot))
+	resp, err = dir.ListSnapshots(ctx, &abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	assert.Equal(t, []*abci.Snapshot{snapshot}, resp.Snapshots)
+
+	chunk, err := dir.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{Height: 3, Format: 1, Chunk: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3}, chunk.Chunk)
+	chunk, err = dir.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{Height: 3, Format: 1, Chunk: 2})
+	require.NoError(t, err)
+	assert.Nil(t, chunk.Chunk)
+
+	lb := makeTestLightBlock(t, 3)
+	require.NoError(t, dir.SaveLightBlock(lb))
+	loaded, err := dir.LoadLightBlock(3)
+	require.NoError(t, err)
+	assert.Equal(t, lb.Hash(), loaded.Hash())
+	loaded, err = dir.LoadLightBlock(4)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+
+	// the latest light block
+	require.NoError(t, dir.SaveLightBlock(makeTestLightBlock(t, 2)))
+	loaded, err = dir.LoadLightBlock(0)
+	require.NoError(t, err)
+	assert.Equal(t, lb.Hash(), loaded.Hash())
+
+	params := types.DefaultConsensusParams()
+	params.Block.MaxBytes = 1024
+	require.NoError(t, dir.SaveConsensusParams(3, *params))
+	loadedParams, err := dir.LoadConsensusParams(3)
+	require.NoError(t, err)
+	assert.Equal(t, params, loadedParams)
+	loadedParams, err = dir.LoadConsensusParams(4)
+	require.NoError(t, err)
+	assert.Nil(t, loadedParams)
+}
+
+func TestExportSnapshots(t *testing.T) {
+	ctx := context.Background()
+	dir, err := NewSnapshotDir(t.TempDir())
+	require.NoError(t, err)
+
+	// the light blocks are stored up to height 4, so that the snapshot at
+	// height 3 can't be verified yet
+	blockStore := &smmocks.BlockStore{}
+	stateStore := &smmocks.Store{}
+	lightBlocks := make(map[int64]*types.LightBlock)
+	for h := int64(1); h <= 4; h++ {
+		lb := makeTestLightBlock(t, h)
+		lightBlocks[h] = lb
+		blockStore.On("LoadBlockMeta", h).Return(&types.BlockMeta{Header: *lb.Header})
+		blockStore.On("LoadBlockCommit", h).Return(nil)
+		blockStore.On("LoadSeenCommit", h).Return(lb.Commit)
+		stateStore.On("LoadValidators", h).Return(lb.ValidatorSet, nil)
+		stateStore.On("LoadConsensusParams", h).Return(*types.DefaultConsensusParams(), nil)
+	}
+	blockStore.On("LoadBlockMeta", int64(5)).Return(nil)
+
+	snapshots := []*abci.Snapshot{
+		{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}},
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}},
+		{Height: 3, Format: 1, Chunks: 1, Hash: []byte{3}},
+	}
+	conn := &proxymocks.AppConnSnapshot{}
+	conn.On("ListSnapshots", mock.Anything, &abci.RequestListSnapshots{}).
+		Return(&abci.ResponseListSnapshots{Snapshots: snapshots}, nil)
+	conn.On("LoadSnapshotChunk", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *abci.RequestLoadSnapshotChunk) *abci.ResponseLoadSnapshotChunk {
+			return &abci.ResponseLoadSnapshotChunk{Chunk: []byte{byte(req.Height), byte(req.Chunk)}}
+		}, nil)
+
+	exported, err := ExportSnapshots(ctx, conn, blockStore, stateStore, dir, 2)
+	require.NoError(t, err)
+	assert.Equal(t, snapshots[1:2], exported)
+
+	exported, err = ExportSnapshots(ctx, conn, blockStore, stateStore, dir, 0)
+	require.NoError(t, err)
+	assert.Equal(t, snapshots[:2], exported)
+
+	resp, err := dir.ListSnapshots(ctx, &abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	assert.Equal(t, snapshots[:2], resp.Snapshots)
+	chunk, err := dir.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{Height: 1, Format: 1, Chunk: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 1}, chunk.Chunk)
+	for h := int64(1); h <= 4; h++ {
+		lb, err := dir.LoadLightBlock(h)
+		require.NoError(t, err)
+		require.NotNil(t, lb, h)
+		assert.Equal(t, lightBlocks[h].Hash(), lb.Hash())
+		params, err := dir.LoadConsensusParams(h)
+		require.NoError(t, err)
+		assert.Equal(t, types.DefaultConsensusParams(), params)
+	}
+
+	// the light blocks whose validators are pruned are not available
+	stateStore = &smmocks.Store{}
+	stateStore.On("LoadValidators", mock.Anything).Return(nil, sm.ErrNoValSetForHeight{Height: 1})
+	exported, err = ExportSnapshots(ctx, conn, blockStore, stateStore, dir, 0)
+	require.NoError(t, err)
+	assert.Empty(t, exported)
+}
+
+func makeTestLightBlock(t *testing.T, height int64) *types.LightBlock {
+	t.Helper()
+	vals, privVals := types.RandValidatorSet(2, 10)
+	header := test.MakeHeader(t, &types.Header{Height: height, ValidatorsHash: vals.Hash()})
+	blockID := test.MakeBlockIDWithHash(header.Hash())
+	commit, err := test.MakeCommit(blockID, height, 0, vals, privVals, header.ChainID, test.DefaultTestTime)
+	require.NoError(t, err)
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: vals,
+	}
+}
@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"time"
 
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
 )
 
+// throughputWeight is the weight of the latest sample in the moving average of a peer's chunk
+// throughput.
+const throughputWeight = 0.3
+
 // snapshotKey is a snapshot key used for lookups.
 type snapshotKey [sha256.Size]byte
 
@@ -53,6 +58,9 @@ type snapshotPool struct {
 	formatBlacklist   map[uint32]bool
 	peerBlacklist     map[p2p.ID]bool
 	snapshotBlacklist map[snapshotKey]bool
+
+	// moving average of the chunk throughput of peers, in bytes per second
+	throughput map[p2p.ID]float64
 }
 
 // newSnapshotPool creates a new snapshot pool. The state source is used for
@@ -66,6 +74,7 @@ func newSnapshotPool() *snapshotPool {
 		formatBlacklist:   make(map[uint32]bool),
 		peerBlacklist:     make(map[p2p.ID]bool),
 		snapshotBlacklist: make(map[snapshotKey]bool),
+		throughput:        make(map[p2p.ID]float64),
 	}
 }
 
@@ -126,13 +135,68 @@ func (p *snapshotPool) Best() *snapshot {
 	return ranked[0]
 }
 
-// GetPeer returns a random peer for a snapshot, if any.
+// GetPeer returns a random peer for a snapshot, if any. Peers are picked in proportion to their
+// chunk throughput, so that slow peers are deprioritised. Peers without any chunk delivered yet
+// are weighted as the fastest known peer, to try them out.
 func (p *snapshotPool) GetPeer(snapshot *snapshot) p2p.Peer {
 	peers := p.GetPeers(snapshot)
 	if len(peers) == 0 {
 		return nil
 	}
-	return peers[rand.Intn(len(peers))] //nolint:gosec // G404: Use of weak random number generator
+
+	p.Lock()
+	fastest := 1.0
+	for _, peer := range peers {
+		fastest = max(fastest, p.throughput[peer.ID()])
+	}
+	weights := make([]float64, len(peers))
+	total := 0.0
+	for i, peer := range peers {
+		throughput, ok := p.throughput[peer.ID()]
+		if !ok {
+			throughput = fastest
+		}
+		// Keep slow peers eligible, in case no other peer delivers.
+		weights[i] = max(throughput, fastest/1000)
+		total += weights[i]
+	}
+	p.Unlock()
+
+	r := rand.Float64() * total //nolint:gosec // G404: Use of weak random number generator
+	for i, weight := range weights {
+		if r < weight {
+			return peers[i]
+		}
+		r -= weight
+	}
+	return peers[len(peers)-1]
+}
+
+// ObserveChunk records that a peer delivered a chunk of the given size in the given time.
+func (p *snapshotPool) ObserveChunk(peerID p2p.ID, size int, elapsed time.Duration) {
+	elapsed = max(elapsed, time.Millisecond)
+	sample := float64(size) / elapsed.Seconds()
+	p.Lock()
+	defer p.Unlock()
+	p.observe(peerID, sample)
+}
+
+// ObserveTimeout records that a peer failed to deliver a chunk in time.
+func (p *snapshotPool) ObserveTimeout(peerID p2p.ID) {
+	p.Lock()
+	defer p.Unlock()
+	p.observe(peerID, 0)
+}
+
+// observe adds a throughput sample to the moving average of a peer. The caller must hold the
+// mutex lock.
+func (p *snapshotPool) observe(peerID p2p.ID, sample float64) {
+	throughput, ok := p.throughput[peerID]
+	if !ok {
+		p.throughput[peerID] = sample
+		return
+	}
+	p.throughput[peerID] = throughputWeight*sample + (1-throughputWeight)*throughput
 }
 
 // GetPeers returns the peers for a snapshot.
@@ -236,6 +300,7 @@ func (p *snapshotPool) removePeer(peerID p2p.ID) {
 		}
 	}
 	delete(p.peerIndex, peerID)
+	delete(p.throughput, peerID)
 }
 
 // removeSnapshot removes a snapshot. The caller must hold the mutex lock.
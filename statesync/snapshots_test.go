@@ -2,6 +2,7 @@ package statesync
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -104,6 +105,43 @@ func TestSnapshotPool_GetPeer(t *testing.T) {
 	assert.Nil(t, peer)
 }
 
+func TestSnapshotPool_GetPeer_Throughput(t *testing.T) {
+	pool := newSnapshotPool()
+
+	s := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	peerA := &p2pmocks.Peer{}
+	peerA.On("ID").Return(p2p.ID("a"))
+	peerB := &p2pmocks.Peer{}
+	peerB.On("ID").Return(p2p.ID("b"))
+	for _, peer := range []p2p.Peer{peerA, peerB} {
+		_, err := pool.Add(peer, s)
+		require.NoError(t, err)
+	}
+
+	// Peer b times out, so it should be picked much less often than peer a.
+	pool.ObserveChunk("a", 1e6, time.Second)
+	pool.ObserveTimeout("b")
+	seenB := 0
+	for i := 0; i < 1000; i++ {
+		if pool.GetPeer(s).ID() == "b" {
+			seenB++
+		}
+	}
+	assert.Less(t, seenB, 50)
+
+	// The average follows the latest samples.
+	for i := 0; i < 20; i++ {
+		pool.ObserveChunk("b", 1e6, 100*time.Millisecond)
+	}
+	seenB = 0
+	for i := 0; i < 1000; i++ {
+		if pool.GetPeer(s).ID() == "b" {
+			seenB++
+		}
+	}
+	assert.Greater(t, seenB, 700)
+}
+
 func TestSnapshotPool_GetPeers(t *testing.T) {
 	pool := newSnapshotPool()
 
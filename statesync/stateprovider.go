@@ -1,7 +1,9 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -15,6 +17,7 @@ import (
 	lighthttp "github.com/cometbft/cometbft/light/provider/http"
 	lightrpc "github.com/cometbft/cometbft/light/rpc"
 	lightdb "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/p2p"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	sm "github.com/cometbft/cometbft/state"
@@ -42,6 +45,9 @@ type lightClientStateProvider struct {
 	version       cmtstate.Version
 	initialHeight int64
 	providers     map[lightprovider.Provider]string
+
+	// consensusParams fetches the consensus params of a verified light block.
+	consensusParams func(ctx context.Context, lb *types.LightBlock) (types.ConsensusParams, error)
 }
 
 // NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
@@ -77,12 +83,49 @@ func NewLightClientStateProvider(
 	if err != nil {
 		return nil, err
 	}
-	return &lightClientStateProvider{
+	s := &lightClientStateProvider{
 		lc:            lc,
 		version:       version,
 		initialHeight: initialHeight,
 		providers:     providerRemotes,
-	}, nil
+	}
+	s.consensusParams = s.rpcConsensusParams
+	return s, nil
+}
+
+// newP2PStateProvider creates a new StateProvider using a light client, with
+// the light blocks and consensus params fetched from the given peers.
+func newP2PStateProvider(
+	ctx context.Context,
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	peers []p2p.Peer,
+	dispatcher *dispatcher,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+) (StateProvider, error) {
+	if len(peers) < 2 {
+		return nil, fmt.Errorf("at least 2 peers are required, got %v", len(peers))
+	}
+
+	providers := make([]lightprovider.Provider, 0, len(peers))
+	for _, peer := range peers {
+		providers = append(providers, &p2pProvider{chainID: chainID, peer: peer, dispatcher: dispatcher})
+	}
+
+	lc, err := light.NewClient(ctx, chainID, trustOptions, providers[0], providers[1:],
+		lightdb.New(dbm.NewMemDB(), ""), light.Logger(logger), light.MaxRetryAttempts(5))
+	if err != nil {
+		return nil, err
+	}
+	s := &lightClientStateProvider{
+		lc:            lc,
+		version:       version,
+		initialHeight: initialHeight,
+	}
+	s.consensusParams = s.p2pConsensusParams
+	return s, nil
 }
 
 // AppHash implements StateProvider.
@@ -170,25 +213,63 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	state.NextValidators = nextLightBlock.ValidatorSet
 	state.LastHeightValidatorsChanged = nextLightBlock.Height
 
-	// We'll also need to fetch consensus params via RPC, using light client verification.
+	// We'll also need to fetch consensus params, using light client verification.
+	params, err := s.consensusParams(ctx, currentLightBlock)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
+			currentLightBlock.Height, err)
+	}
+	state.ConsensusParams = params
+	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+
+	return state, nil
+}
+
+// rpcConsensusParams fetches the consensus params from the RPC server of the
+// primary light client provider.
+func (s *lightClientStateProvider) rpcConsensusParams(
+	ctx context.Context, lb *types.LightBlock,
+) (types.ConsensusParams, error) {
 	primaryURL, ok := s.providers[s.lc.Primary()]
 	if !ok || primaryURL == "" {
-		return sm.State{}, fmt.Errorf("could not find address for primary light client provider")
+		return types.ConsensusParams{}, fmt.Errorf("could not find address for primary light client provider")
 	}
 	primaryRPC, err := rpcClient(primaryURL)
 	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
+		return types.ConsensusParams{}, fmt.Errorf("unable to create RPC client: %w", err)
 	}
 	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
-	result, err := rpcclient.ConsensusParams(ctx, &currentLightBlock.Height)
+	result, err := rpcclient.ConsensusParams(ctx, &lb.Height)
 	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
-			nextLightBlock.Height, err)
+		return types.ConsensusParams{}, err
 	}
-	state.ConsensusParams = result.ConsensusParams
-	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+	return result.ConsensusParams, nil
+}
 
-	return state, nil
+// p2pConsensusParams fetches the consensus params from the peers of the light
+// client providers, starting with the primary, and verifies them against the
+// consensus hash of the light block.
+func (s *lightClientStateProvider) p2pConsensusParams(
+	ctx context.Context, lb *types.LightBlock,
+) (types.ConsensusParams, error) {
+	for _, provider := range append([]lightprovider.Provider{s.lc.Primary()}, s.lc.Witnesses()...) {
+		p, ok := provider.(*p2pProvider)
+		if !ok {
+			continue
+		}
+		params, err := p.consensusParams(ctx, lb.Height)
+		if ctx.Err() != nil {
+			return types.ConsensusParams{}, ctx.Err()
+		}
+		if err != nil || params == nil {
+			continue
+		}
+		if !bytes.Equal(params.Hash(), lb.ConsensusHash) {
+			continue
+		}
+		return *params, nil
+	}
+	return types.ConsensusParams{}, errors.New("no peer provided valid consensus params")
 }
 
 // rpcClient sets up a new RPC client
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -159,6 +160,22 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		chunks   *chunkQueue
 		err      error
 	)
+
+	// A restore interrupted by a restart is resumed first, reusing the chunks it had fetched.
+	// The app restarts the restore once the snapshot is offered again, so all chunks are
+	// applied again, including those applied before the restart.
+	if s.tempDir != "" {
+		snapshot, err = loadRestore(filepath.Join(s.tempDir, restoreDirName))
+		if err != nil {
+			return sm.State{}, nil, err
+		}
+		if snapshot != nil {
+			s.logger.Info("Resuming interrupted snapshot restore", "height", snapshot.Height,
+				"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash),
+				"chunks", snapshot.Chunks)
+		}
+	}
+
 	for {
 		// If not nil, we're going to retry restoration of the same snapshot.
 		if snapshot == nil {
@@ -175,16 +192,25 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 			continue
 		}
 		if chunks == nil {
-			chunks, err = newChunkQueue(snapshot, s.tempDir)
+			if s.tempDir != "" {
+				chunks, err = openChunkQueue(snapshot, filepath.Join(s.tempDir, restoreDirName))
+			} else {
+				chunks, err = newChunkQueue(snapshot, s.tempDir)
+			}
 			if err != nil {
 				return sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
 			}
-			defer chunks.Close() // in case we forget to close it elsewhere
+			// In case we forget to close it elsewhere. The chunks of a persisted restore are kept
+			// on errors, so that it can be resumed.
+			defer chunks.Close()
 		}
 
 		newState, commit, err := s.Sync(snapshot, chunks)
 		switch {
 		case err == nil:
+			if err := chunks.Remove(); err != nil {
+				s.logger.Error("Failed to clean up chunk queue", "err", err)
+			}
 			return newState, commit, nil
 
 		case errors.Is(err, errAbort):
@@ -227,7 +253,7 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		}
 
 		// Discard snapshot and chunks for next iteration
-		err = chunks.Close()
+		err = chunks.Remove()
 		if err != nil {
 			s.logger.Error("Failed to clean up chunk queue", "err", err)
 		}
@@ -444,14 +470,21 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 		ticker := time.NewTicker(s.retryTimeout)
 		defer ticker.Stop()
 
-		s.requestChunk(snapshot, index)
+		requested := time.Now()
+		peer := s.requestChunk(snapshot, index)
 
 		select {
 		case <-chunks.WaitFor(index):
 			next = true
+			if peer != nil && chunks.GetSender(index) == peer.ID() {
+				s.snapshots.ObserveChunk(peer.ID(), chunks.ChunkSize(index), time.Since(requested))
+			}
 
 		case <-ticker.C:
 			next = false
+			if peer != nil {
+				s.snapshots.ObserveTimeout(peer.ID())
+			}
 
 		case <-ctx.Done():
 			return
@@ -461,13 +494,13 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 	}
 }
 
-// requestChunk requests a chunk from a peer.
-func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
+// requestChunk requests a chunk from a peer, returning the peer or nil if there is none.
+func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) p2p.Peer {
 	peer := s.snapshots.GetPeer(snapshot)
 	if peer == nil {
 		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
 			"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash))
-		return
+		return nil
 	}
 	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
 		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
@@ -479,6 +512,7 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 			Index:  chunk,
 		},
 	})
+	return peer
 }
 
 // verifyApp verifies the sync, checking the app hash, last block height and app version
@@ -2,6 +2,7 @@ package statesync
 
 import (
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -231,6 +232,90 @@ func TestSyncer_SyncAny(t *testing.T) {
 	peerB.AssertExpectations(t)
 }
 
+func TestSyncer_SyncAny_resume(t *testing.T) {
+	state := sm.State{
+		ChainID:         "chain",
+		Version:         cmtstate.Version{Consensus: cmtversion.Consensus{App: testAppVersion}},
+		LastBlockHeight: 1,
+		AppHash:         []byte("app_hash"),
+	}
+	commit := &types.Commit{BlockID: types.BlockID{Hash: []byte("blockhash")}}
+	chunks := []*chunk{
+		{Height: 1, Format: 1, Index: 0, Chunk: []byte{1, 1, 0}},
+		{Height: 1, Format: 1, Index: 1, Chunk: []byte{1, 1, 1}},
+		{Height: 1, Format: 1, Index: 2, Chunk: []byte{1, 1, 2}},
+	}
+	s := &snapshot{Height: 1, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}}
+
+	// A previous run fetched chunks 0 and 2 and applied chunk 0 before stopping. The
+	// app restarts the restore, so all chunks are applied again.
+	tempDir := t.TempDir()
+	queue, err := openChunkQueue(s, filepath.Join(tempDir, restoreDirName))
+	require.NoError(t, err)
+	for _, i := range []uint32{0, 2} {
+		_, err = queue.Add(chunks[i])
+		require.NoError(t, err)
+	}
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, uint64(1)).Return(state.AppHash, nil)
+	stateProvider.On("Commit", mock.Anything, uint64(1)).Return(commit, nil)
+	stateProvider.On("State", mock.Anything, uint64(1)).Return(state, nil)
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	connQuery := &proxymocks.AppConnQuery{}
+
+	cfg := config.DefaultStateSyncConfig()
+	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, tempDir)
+
+	// Only the missing chunk is fetched.
+	peer := simplePeer("a")
+	_, err = syncer.AddSnapshot(peer, s)
+	require.NoError(t, err)
+	chunkRequests := make(map[uint32]int)
+	chunkRequestsMtx := cmtsync.Mutex{}
+	peer.On("Send", mock.MatchedBy(func(i interface{}) bool {
+		e, ok := i.(p2p.Envelope)
+		return ok && e.ChannelID == ChunkChannel
+	})).Run(func(args mock.Arguments) {
+		msg := args[0].(p2p.Envelope).Message.(*ssproto.ChunkRequest)
+		_, err := syncer.AddChunk(chunks[msg.Index])
+		require.NoError(t, err)
+		chunkRequestsMtx.Lock()
+		chunkRequests[msg.Index]++
+		chunkRequestsMtx.Unlock()
+	}).Return(true)
+
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.RequestOfferSnapshot{
+		Snapshot: &abci.Snapshot{Height: s.Height, Format: s.Format, Chunks: s.Chunks, Hash: s.Hash},
+		AppHash:  []byte("app_hash"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, nil)
+	for _, c := range chunks {
+		connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.RequestApplySnapshotChunk{
+			Index: c.Index, Chunk: c.Chunk,
+		}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+	}
+	connQuery.On("Info", mock.Anything, proxy.RequestInfo).Return(&abci.ResponseInfo{
+		AppVersion:       testAppVersion,
+		LastBlockHeight:  1,
+		LastBlockAppHash: []byte("app_hash"),
+	}, nil)
+
+	newState, lastCommit, err := syncer.SyncAny(0, func() {})
+	require.NoError(t, err)
+	assert.Equal(t, state, newState)
+	assert.Equal(t, commit, lastCommit)
+
+	chunkRequestsMtx.Lock()
+	assert.Equal(t, map[uint32]int{1: 1}, chunkRequests)
+	chunkRequestsMtx.Unlock()
+	connSnapshot.AssertExpectations(t)
+
+	// The restore is cleaned up once done.
+	restoring, err := loadRestore(filepath.Join(tempDir, restoreDirName))
+	require.NoError(t, err)
+	assert.Nil(t, restoring)
+}
+
 func TestSyncer_SyncAny_noSnapshots(t *testing.T) {
 	syncer, _ := setupOfferSyncer()
 	_, _, err := syncer.SyncAny(0, func() {})
@@ -364,6 +449,42 @@ func TestSyncer_SyncAny_abciError(t *testing.T) {
 	connSnapshot.AssertExpectations(t)
 }
 
+func TestSyncer_SyncAny_keepRestore(t *testing.T) {
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
+	tempDir := t.TempDir()
+	syncer := newSyncer(*config.DefaultStateSyncConfig(), log.NewNopLogger(), connSnapshot,
+		&proxymocks.AppConnQuery{}, stateProvider, tempDir)
+
+	s := &snapshot{Height: 1, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}}
+	_, err := syncer.AddSnapshot(simplePeer("id"), s)
+	require.NoError(t, err)
+
+	// The restore is kept on errors, to be resumed after a restart.
+	errBoom := errors.New("boom")
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.RequestOfferSnapshot{
+		Snapshot: toABCI(s), AppHash: []byte("app_hash"),
+	}).Once().Return(nil, errBoom)
+	_, _, err = syncer.SyncAny(0, func() {})
+	require.ErrorIs(t, err, errBoom)
+	restoring, err := loadRestore(filepath.Join(tempDir, restoreDirName))
+	require.NoError(t, err)
+	require.NotNil(t, restoring)
+	assert.Equal(t, s.Key(), restoring.Key())
+
+	// It's removed once the snapshot is rejected.
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.RequestOfferSnapshot{
+		Snapshot: toABCI(s), AppHash: []byte("app_hash"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}, nil)
+	_, _, err = syncer.SyncAny(0, func() {})
+	require.Equal(t, errNoSnapshots, err)
+	restoring, err = loadRestore(filepath.Join(tempDir, restoreDirName))
+	require.NoError(t, err)
+	assert.Nil(t, restoring)
+	connSnapshot.AssertExpectations(t)
+}
+
 func TestSyncer_offerSnapshot(t *testing.T) {
 	unknownErr := errors.New("unknown error")
 	boom := errors.New("boom")
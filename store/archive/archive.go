@@ -0,0 +1,506 @@
+// Package archive exports the blockchain data of a node into an archive, and
+// imports it into the stores of another node.
+//
+// An archive is a stream of length-delimited ArchiveMessage protobuf
+// messages: an ArchiveHeader, one ArchiveBlock per height of the exported
+// range and an ArchiveChecksum holding the SHA-256 checksum of all the bytes
+// preceding it.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Version is the version of the archive format written by Export.
+const Version = 1
+
+// maxMsgSize is the maximum size of a message of an archive. A block message
+// holds a block along with its commit and finalize block response.
+const maxMsgSize = 4 * types.MaxBlockSizeBytes
+
+// Export writes an archive of the blocks between the heights from and to
+// (inclusive) to w, along with their seen commits, finalize block responses,
+// validator sets and consensus params, and the state after the block at
+// height to was committed.
+func Export(w io.Writer, blockStore sm.BlockStore, stateStore sm.Store, from, to int64) error {
+	if from < blockStore.Base() || to > blockStore.Height() || from > to {
+		return fmt.Errorf("invalid height range [%d, %d], the block store has blocks [%d, %d]",
+			from, to, blockStore.Base(), blockStore.Height())
+	}
+
+	state, err := exportState(blockStore, stateStore, to)
+	if err != nil {
+		return err
+	}
+	pbState, err := state.ToProto()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	hasher := sha256.New()
+	pw := protoio.NewDelimitedWriter(io.MultiWriter(bw, hasher))
+
+	if _, err := pw.WriteMsg(&cmtstore.ArchiveMessage{Sum: &cmtstore.ArchiveMessage_Header{
+		Header: &cmtstore.ArchiveHeader{
+			Version:     Version,
+			ChainID:     state.ChainID,
+			StartHeight: from,
+			EndHeight:   to,
+			State:       pbState,
+		},
+	}}); err != nil {
+		return err
+	}
+
+	for height := from; height <= to; height++ {
+		ab, err := exportBlock(blockStore, stateStore, height)
+		if err != nil {
+			return err
+		}
+		if _, err := pw.WriteMsg(&cmtstore.ArchiveMessage{Sum: &cmtstore.ArchiveMessage_Block{Block: ab}}); err != nil {
+			return err
+		}
+	}
+
+	// The checksum itself is not part of the checksummed bytes.
+	if _, err := protoio.NewDelimitedWriter(bw).WriteMsg(&cmtstore.ArchiveMessage{Sum: &cmtstore.ArchiveMessage_Checksum{
+		Checksum: &cmtstore.ArchiveChecksum{Sha256: hasher.Sum(nil)},
+	}}); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// exportState returns the state after the block at the given height was
+// committed. If it is not the latest state, it is rebuilt from the stores.
+func exportState(blockStore sm.BlockStore, stateStore sm.Store, height int64) (sm.State, error) {
+	state, err := stateStore.Load()
+	if err != nil {
+		return sm.State{}, err
+	}
+	if state.IsEmpty() || state.LastBlockHeight < height {
+		return sm.State{}, fmt.Errorf("no state found for height %d", height)
+	}
+	if state.LastBlockHeight == height {
+		return state, nil
+	}
+
+	meta := blockStore.LoadBlockMeta(height)
+	nextMeta := blockStore.LoadBlockMeta(height + 1)
+	if meta == nil || nextMeta == nil {
+		return sm.State{}, fmt.Errorf("no block metas found for heights %d and %d", height, height+1)
+	}
+	lastVals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	vals, err := stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextVals, err := stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+	params, err := stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	// The header of the next block commits to the results of the block at
+	// height.
+	state.Version.Consensus = nextMeta.Header.Version
+	state.LastBlockHeight = height
+	state.LastBlockID = meta.BlockID
+	state.LastBlockTime = meta.Header.Time
+	state.NextValidators = nextVals
+	state.Validators = vals
+	state.LastValidators = lastVals
+	state.LastHeightValidatorsChanged = height + 1
+	state.ConsensusParams = params
+	state.LastHeightConsensusParamsChanged = height + 1
+	state.LastResultsHash = nextMeta.Header.LastResultsHash
+	state.AppHash = nextMeta.Header.AppHash
+	return state, nil
+}
+
+func exportBlock(blockStore sm.BlockStore, stateStore sm.Store, height int64) (*cmtstore.ArchiveBlock, error) {
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("no block found for height %d", height)
+	}
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	commit := blockStore.LoadSeenCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadBlockCommit(height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("no commit found for height %d", height)
+	}
+
+	var pbExtCommit *cmtproto.ExtendedCommit
+	if extCommit := blockStore.LoadBlockExtendedCommit(height); extCommit != nil {
+		pbExtCommit = extCommit.ToProto()
+	}
+
+	resp, err := stateStore.LoadFinalizeBlockResponse(height)
+	var errNoResp sm.ErrNoABCIResponsesForHeight
+	if errors.As(err, &errNoResp) || errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted) {
+		resp = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+	pbVals, err := vals.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := stateStore.LoadConsensusParams(height)
+	if err != nil {
+		return nil, err
+	}
+	pbParams := params.ToProto()
+
+	return &cmtstore.ArchiveBlock{
+		Block:                 pbBlock,
+		SeenCommit:            commit.ToProto(),
+		ExtendedCommit:        pbExtCommit,
+		FinalizeBlockResponse: resp,
+		Validators:            pbVals,
+		ConsensusParams:       &pbParams,
+	}, nil
+}
+
+// Trust holds what the first block of an archive is verified against. The
+// validator sets of an archive are only trusted once they are linked to one of
+// these; the following ones are linked through the next validators hash of each
+// block header.
+type Trust struct {
+	// GenesisDoc is the genesis of the chain. Its validators are trusted to
+	// sign the first block of an archive starting at the initial height.
+	GenesisDoc *types.GenesisDoc
+	// Hash is the trusted hash of the first block of the archive.
+	Hash []byte
+}
+
+// Import reads an archive written by Export from r and saves its content into
+// the given stores, after verifying the commit of each block against its
+// validator set. The blocks of the archive must directly follow the blocks of
+// blockStore, which is usually empty.
+//
+// The validator set of the first block is verified against the last block of
+// blockStore if it is not empty, against trust.Hash if it is set, or against
+// the validators of trust.GenesisDoc if the archive starts at the initial
+// height. The state of the archive is only saved once all the blocks were
+// imported and the checksum of the archive was verified. If Import fails, the
+// blocks it saved are deleted; the validator sets, params and finalize block
+// responses saved for their heights are overwritten by a later import.
+func Import(r io.Reader, blockStore sm.BlockStore, stateStore sm.Store, trust Trust) (sm.State, error) {
+	imp := &importer{
+		blockStore: blockStore,
+		stateStore: stateStore,
+	}
+	state, err := imp.importArchive(r, trust)
+	if err != nil {
+		for ; imp.saved > 0; imp.saved-- {
+			if delErr := blockStore.DeleteLatestBlock(); delErr != nil {
+				return sm.State{}, fmt.Errorf("%w (deleting imported blocks: %v)", err, delErr)
+			}
+		}
+		return sm.State{}, err
+	}
+	return state, nil
+}
+
+// importer verifies and saves the blocks of an archive.
+type importer struct {
+	chainID    string
+	blockStore sm.BlockStore
+	stateStore sm.Store
+
+	// trustedHash is the trusted hash of the next block, if any.
+	trustedHash []byte
+	// nextValsHash is the hash of the validator set of the next block, if it
+	// is trusted.
+	nextValsHash []byte
+	// saved is the number of blocks saved into blockStore.
+	saved int64
+
+	lastBlockID   *types.BlockID
+	lastResp      *abci.ResponseFinalizeBlock
+	lastVals      *types.ValidatorSet
+	valsChanged   int64
+	lastParams    *cmtproto.ConsensusParams
+	paramsChanged int64
+}
+
+func (imp *importer) importArchive(r io.Reader, trust Trust) (sm.State, error) {
+	hasher := sha256.New()
+	pr := protoio.NewDelimitedReader(io.TeeReader(bufio.NewReader(r), hasher), maxMsgSize)
+
+	var msg cmtstore.ArchiveMessage
+	if _, err := pr.ReadMsg(&msg); err != nil {
+		return sm.State{}, fmt.Errorf("reading archive header: %w", err)
+	}
+	header := msg.GetHeader()
+	if header == nil {
+		return sm.State{}, errors.New("archive does not start with a header")
+	}
+	if header.Version != Version {
+		return sm.State{}, fmt.Errorf("unsupported archive version %d", header.Version)
+	}
+	if header.State == nil {
+		return sm.State{}, errors.New("archive header has no state")
+	}
+	state, err := sm.FromProto(header.State)
+	if err != nil {
+		return sm.State{}, err
+	}
+	if state.ChainID != header.ChainID || state.LastBlockHeight != header.EndHeight ||
+		header.StartHeight < state.InitialHeight || header.StartHeight > header.EndHeight {
+		return sm.State{}, fmt.Errorf("invalid archive header (chain %q, heights [%d, %d])",
+			header.ChainID, header.StartHeight, header.EndHeight)
+	}
+	if err := imp.trust(header.StartHeight, state, trust); err != nil {
+		return sm.State{}, err
+	}
+
+	for height := header.StartHeight; height <= header.EndHeight; height++ {
+		msg.Reset()
+		if _, err := pr.ReadMsg(&msg); err != nil {
+			return sm.State{}, fmt.Errorf("reading block %d: %w", height, err)
+		}
+		ab := msg.GetBlock()
+		if ab == nil {
+			return sm.State{}, fmt.Errorf("expected block %d, got %T", height, msg.Sum)
+		}
+		if err := imp.importBlock(height, ab); err != nil {
+			return sm.State{}, fmt.Errorf("importing block %d: %w", height, err)
+		}
+	}
+
+	sum := hasher.Sum(nil)
+	msg.Reset()
+	if _, err := pr.ReadMsg(&msg); err != nil {
+		return sm.State{}, fmt.Errorf("reading archive checksum: %w", err)
+	}
+	checksum := msg.GetChecksum()
+	if checksum == nil {
+		return sm.State{}, errors.New("archive does not end with a checksum")
+	}
+	if !bytes.Equal(checksum.Sha256, sum) {
+		return sm.State{}, fmt.Errorf("archive checksum mismatch: expected %X, got %X", checksum.Sha256, sum)
+	}
+
+	if err := imp.verifyState(state); err != nil {
+		return sm.State{}, err
+	}
+	// The validator sets and params following the archive are only known from
+	// the state, so they are saved in full.
+	state.LastHeightValidatorsChanged = state.LastBlockHeight + 1
+	state.LastHeightConsensusParamsChanged = state.LastBlockHeight + 1
+	if err := imp.stateStore.Bootstrap(*state); err != nil {
+		return sm.State{}, err
+	}
+	return *state, nil
+}
+
+// trust sets what the first block of an archive starting at startHeight is
+// verified against.
+func (imp *importer) trust(startHeight int64, state *sm.State, trust Trust) error {
+	imp.chainID = state.ChainID
+	imp.trustedHash = trust.Hash
+
+	if imp.blockStore.Size() > 0 {
+		height := imp.blockStore.Height()
+		if height+1 != startHeight {
+			return fmt.Errorf("archive starts at height %d but the block store ends at height %d",
+				startHeight, height)
+		}
+		meta := imp.blockStore.LoadBlockMeta(height)
+		if meta == nil {
+			return fmt.Errorf("no block meta found for height %d", height)
+		}
+		imp.lastBlockID = &meta.BlockID
+		imp.nextValsHash = meta.Header.NextValidatorsHash
+		return nil
+	}
+	// The blocks of a failed import are deleted, but not the base of the
+	// block store.
+	if base := imp.blockStore.Base(); base > 0 && base != startHeight {
+		return fmt.Errorf("archive starts at height %d but the block store starts at height %d",
+			startHeight, base)
+	}
+	if len(trust.Hash) > 0 {
+		return nil
+	}
+
+	genDoc := trust.GenesisDoc
+	if startHeight != state.InitialHeight || genDoc == nil || len(genDoc.Validators) == 0 {
+		return fmt.Errorf("cannot verify the validators of block %d: a trusted hash is required", startHeight)
+	}
+	if genDoc.ChainID != state.ChainID || genDoc.InitialHeight != state.InitialHeight {
+		return fmt.Errorf("archive of chain %q at initial height %d does not match the genesis (chain %q at initial height %d)",
+			state.ChainID, state.InitialHeight, genDoc.ChainID, genDoc.InitialHeight)
+	}
+	vals := make([]*types.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		vals[i] = types.NewValidator(val.PubKey, val.Power)
+	}
+	imp.nextValsHash = types.NewValidatorSet(vals).Hash()
+	return nil
+}
+
+func (imp *importer) importBlock(height int64, ab *cmtstore.ArchiveBlock) error {
+	block, err := types.BlockFromProto(ab.Block)
+	if err != nil {
+		return err
+	}
+	if block.Height != height || block.ChainID != imp.chainID {
+		return fmt.Errorf("unexpected block %d of chain %q", block.Height, block.ChainID)
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return err
+	}
+	if imp.lastBlockID != nil && !block.LastBlockID.Equals(*imp.lastBlockID) {
+		return fmt.Errorf("last block ID %v does not match the previous block %v", block.LastBlockID, *imp.lastBlockID)
+	}
+	if imp.nextValsHash != nil && !bytes.Equal(block.ValidatorsHash, imp.nextValsHash) {
+		return fmt.Errorf("validators hash %X is not trusted, expected %X", block.ValidatorsHash, imp.nextValsHash)
+	}
+	if imp.lastResp != nil {
+		if err := verifyResults(block.AppHash, block.LastResultsHash, imp.lastResp); err != nil {
+			return fmt.Errorf("previous block: %w", err)
+		}
+	}
+
+	vals, err := types.ValidatorSetFromProto(ab.Validators)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(block.ValidatorsHash, vals.Hash()) {
+		return errors.New("validator set does not match the block header")
+	}
+	if ab.ConsensusParams == nil {
+		return errors.New("missing consensus params")
+	}
+	params := types.ConsensusParamsFromProto(*ab.ConsensusParams)
+	if !bytes.Equal(block.ConsensusHash, params.Hash()) {
+		return errors.New("consensus params do not match the block header")
+	}
+
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return err
+	}
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	if imp.trustedHash != nil && !bytes.Equal(blockID.Hash, imp.trustedHash) {
+		return fmt.Errorf("block hash %X does not match the trusted hash %X", blockID.Hash, imp.trustedHash)
+	}
+
+	var (
+		extCommit *types.ExtendedCommit
+		commit    *types.Commit
+	)
+	if ab.ExtendedCommit != nil {
+		if extCommit, err = types.ExtendedCommitFromProto(ab.ExtendedCommit); err != nil {
+			return err
+		}
+		if err := extCommit.EnsureExtensions(true); err != nil {
+			return err
+		}
+		commit = extCommit.ToCommit()
+	} else if commit, err = types.CommitFromProto(ab.SeenCommit); err != nil {
+		return err
+	}
+	if err := vals.VerifyCommitLight(imp.chainID, blockID, height, commit); err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+
+	if extCommit != nil {
+		imp.blockStore.SaveBlockWithExtendedCommit(block, parts, extCommit)
+	} else {
+		imp.blockStore.SaveBlock(block, parts, commit)
+	}
+	imp.saved++
+	if ab.FinalizeBlockResponse != nil {
+		if err := imp.stateStore.SaveFinalizeBlockResponse(height, ab.FinalizeBlockResponse); err != nil {
+			return err
+		}
+	}
+
+	// Validator sets and params are only saved in full when they change.
+	if imp.lastVals == nil || !bytes.Equal(vals.Hash(), imp.lastVals.Hash()) {
+		imp.valsChanged = height
+	}
+	if err := imp.stateStore.SaveValidators(height, imp.valsChanged, vals); err != nil {
+		return err
+	}
+	if imp.lastParams == nil || !imp.lastParams.Equal(ab.ConsensusParams) {
+		imp.paramsChanged = height
+	}
+	if err := imp.stateStore.SaveConsensusParams(height, imp.paramsChanged, params); err != nil {
+		return err
+	}
+
+	imp.trustedHash = nil
+	imp.nextValsHash = block.NextValidatorsHash
+	imp.lastBlockID = &blockID
+	imp.lastResp = ab.FinalizeBlockResponse
+	imp.lastVals = vals
+	imp.lastParams = ab.ConsensusParams
+	return nil
+}
+
+// verifyState checks that the state of the archive follows its last block.
+func (imp *importer) verifyState(state *sm.State) error {
+	if !state.LastBlockID.Equals(*imp.lastBlockID) {
+		return fmt.Errorf("state block ID %v does not match the last block %v", state.LastBlockID, *imp.lastBlockID)
+	}
+	if !bytes.Equal(state.LastValidators.Hash(), imp.lastVals.Hash()) {
+		return errors.New("state validator set does not match the last block")
+	}
+	if !bytes.Equal(state.Validators.Hash(), imp.nextValsHash) {
+		return errors.New("state validator set does not match the next validators of the last block")
+	}
+	if imp.lastResp != nil {
+		if err := verifyResults(state.AppHash, state.LastResultsHash, imp.lastResp); err != nil {
+			return fmt.Errorf("state: %w", err)
+		}
+	}
+	return nil
+}
+
+// verifyResults checks that the app hash and last results hash committed to by
+// the following block or state match the given finalize block response.
+func verifyResults(appHash, lastResultsHash []byte, resp *abci.ResponseFinalizeBlock) error {
+	if !bytes.Equal(appHash, resp.AppHash) {
+		return fmt.Errorf("app hash %X does not match the finalize block response (%X)", appHash, resp.AppHash)
+	}
+	if !bytes.Equal(lastResultsHash, sm.TxResultsHash(resp.TxResults)) {
+		return errors.New("last results hash does not match the finalize block response")
+	}
+	return nil
+}
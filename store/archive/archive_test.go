@@ -0,0 +1,293 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+const testChainID = "archive-chain"
+
+// makeChain commits numBlocks blocks with the kvstore application. A
+// validator joins the validator set at valChangeHeight.
+func makeChain(t *testing.T, numBlocks, valChangeHeight int64) (*store.BlockStore, sm.Store, Trust) {
+	t.Helper()
+
+	valSet, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	genDoc := test.GenesisDoc(time.Now(), valSet.Validators, test.ConsensusParams(), testChainID)
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil)
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(), mp,
+		sm.EmptyEvidencePool{}, blockStore)
+
+	newVal, err := cryptoenc.PubKeyToProto(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= numBlocks; height++ {
+		txs := []types.Tx{kvstore.NewTxFromID(int(height))}
+		if height == valChangeHeight {
+			txs = append(txs, kvstore.MakeValSetChangeTx(newVal, 1))
+		}
+		block := state.MakeBlock(height, txs, lastCommit, nil, state.Validators.Proposer.Address)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+		commit, err := test.MakeCommit(blockID, height, 0, state.Validators, privVals, testChainID,
+			block.Time.Add(time.Second))
+		require.NoError(t, err)
+
+		state, err = blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, parts, commit)
+		lastCommit = commit
+	}
+	return blockStore, stateStore, Trust{GenesisDoc: genDoc}
+}
+
+func TestExportImport(t *testing.T) {
+	const numBlocks = 8
+	blockStore, stateStore, trust := makeChain(t, numBlocks, 3)
+
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, blockStore, stateStore, 1, numBlocks))
+
+	newBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	newStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	imported, err := Import(&buf, newBlockStore, newStateStore, trust)
+	require.NoError(t, err)
+
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, 2, state.Validators.Size())
+	assertSameState(t, state, imported)
+	assertSameStores(t, blockStore, stateStore, newBlockStore, newStateStore, 1, numBlocks)
+}
+
+func TestExportImportRanges(t *testing.T) {
+	const numBlocks = 8
+	blockStore, stateStore, trust := makeChain(t, numBlocks, 5)
+
+	// The archives are imported one after the other.
+	newBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	newStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	for _, r := range [][2]int64{{1, 4}, {5, numBlocks}} {
+		var buf bytes.Buffer
+		require.NoError(t, Export(&buf, blockStore, stateStore, r[0], r[1]))
+		imported, err := Import(&buf, newBlockStore, newStateStore, trust)
+		require.NoError(t, err)
+		assert.Equal(t, r[1], imported.LastBlockHeight)
+	}
+
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	imported, err := newStateStore.Load()
+	require.NoError(t, err)
+	assertSameState(t, state, imported)
+	assertSameStores(t, blockStore, stateStore, newBlockStore, newStateStore, 1, numBlocks)
+
+	// Archives not following the stored blocks are refused.
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, blockStore, stateStore, 2, 4))
+	_, err = Import(&buf, newBlockStore, newStateStore, trust)
+	require.ErrorContains(t, err, "block store ends at height 8")
+}
+
+func TestImportTrust(t *testing.T) {
+	const numBlocks = 6
+	blockStore, stateStore, trust := makeChain(t, numBlocks, 3)
+	// A chain with the same ID signed by other validators.
+	forgedBlockStore, forgedStateStore, _ := makeChain(t, numBlocks, 3)
+
+	testCases := map[string]struct {
+		blockStore sm.BlockStore
+		stateStore sm.Store
+		from       int64
+		trust      Trust
+		err        string
+	}{
+		"genesis": {
+			blockStore: blockStore, stateStore: stateStore, from: 1, trust: trust,
+		},
+		"forged genesis": {
+			blockStore: forgedBlockStore, stateStore: forgedStateStore, from: 1, trust: trust,
+			err: "importing block 1: validators hash",
+		},
+		"no genesis": {
+			blockStore: blockStore, stateStore: stateStore, from: 1,
+			err: "a trusted hash is required",
+		},
+		"hash": {
+			blockStore: blockStore, stateStore: stateStore, from: 3,
+			trust: Trust{Hash: blockStore.LoadBlockMeta(3).BlockID.Hash},
+		},
+		"forged hash": {
+			blockStore: forgedBlockStore, stateStore: forgedStateStore, from: 3,
+			trust: Trust{Hash: blockStore.LoadBlockMeta(3).BlockID.Hash},
+			err:   "importing block 3: block hash",
+		},
+		"no hash": {
+			blockStore: blockStore, stateStore: stateStore, from: 3, trust: trust,
+			err: "a trusted hash is required",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Export(&buf, tc.blockStore, tc.stateStore, tc.from, numBlocks))
+			newBlockStore := store.NewBlockStore(dbm.NewMemDB())
+			_, err := Import(&buf, newBlockStore, sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), tc.trust)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				assert.Zero(t, newBlockStore.Size())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(numBlocks), newBlockStore.Height())
+		})
+	}
+
+	// A forged archive following trusted blocks is refused.
+	newBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	newStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, blockStore, stateStore, 1, 2))
+	_, err := Import(&buf, newBlockStore, newStateStore, trust)
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, Export(&buf, forgedBlockStore, forgedStateStore, 3, numBlocks))
+	_, err = Import(&buf, newBlockStore, newStateStore, Trust{})
+	require.ErrorContains(t, err, "importing block 3")
+	assert.Equal(t, int64(2), newBlockStore.Height())
+}
+
+func TestImportCorrupted(t *testing.T) {
+	blockStore, stateStore, trust := makeChain(t, 3, 0)
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, blockStore, stateStore, 1, 3))
+	archive := buf.Bytes()
+
+	testCases := map[string]struct {
+		corrupt func([]byte) []byte
+		err     string
+	}{
+		"tx": {
+			corrupt: func(bz []byte) []byte {
+				i := bytes.Index(bz, kvstore.NewTxFromID(2))
+				bz[i] = '9'
+				return bz
+			},
+			err: "importing block 2: wrong Header.DataHash",
+		},
+		"checksum": {
+			corrupt: func(bz []byte) []byte {
+				bz[len(bz)-1] ^= 0xFF
+				return bz
+			},
+			err: "archive checksum mismatch",
+		},
+		"truncated": {
+			corrupt: func(bz []byte) []byte {
+				return bz[:len(bz)/2]
+			},
+			err: "reading block",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bz := tc.corrupt(bytes.Clone(archive))
+			newStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+			newBlockStore := store.NewBlockStore(dbm.NewMemDB())
+			_, err := Import(bytes.NewReader(bz), newBlockStore, newStateStore, trust)
+			require.ErrorContains(t, err, tc.err)
+
+			// The blocks are deleted and the state is not saved.
+			assert.Zero(t, newBlockStore.Size())
+			state, err := newStateStore.Load()
+			require.NoError(t, err)
+			assert.True(t, state.IsEmpty())
+
+			// The archive can then be imported.
+			_, err = Import(bytes.NewReader(archive), newBlockStore, newStateStore, trust)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func assertSameState(t *testing.T, expected, actual sm.State) {
+	t.Helper()
+
+	// The validator sets and params following the imported blocks are saved
+	// in full, so they are recorded as changed.
+	expected.LastHeightValidatorsChanged = actual.LastHeightValidatorsChanged
+	expected.LastHeightConsensusParamsChanged = actual.LastHeightConsensusParamsChanged
+	assert.Equal(t, expected.Bytes(), actual.Bytes())
+}
+
+func assertSameStores(
+	t *testing.T,
+	blockStore *store.BlockStore, stateStore sm.Store,
+	newBlockStore *store.BlockStore, newStateStore sm.Store,
+	from, to int64,
+) {
+	t.Helper()
+
+	assert.Equal(t, from, newBlockStore.Base())
+	assert.Equal(t, to, newBlockStore.Height())
+	for height := from; height <= to; height++ {
+		assert.Equal(t, blockStore.LoadBlockMeta(height), newBlockStore.LoadBlockMeta(height))
+		assert.Equal(t, blockStore.LoadSeenCommit(height), newBlockStore.LoadSeenCommit(height))
+
+		resp, err := stateStore.LoadFinalizeBlockResponse(height)
+		require.NoError(t, err)
+		newResp, err := newStateStore.LoadFinalizeBlockResponse(height)
+		require.NoError(t, err)
+		assert.Equal(t, resp, newResp)
+
+		params, err := stateStore.LoadConsensusParams(height)
+		require.NoError(t, err)
+		newParams, err := newStateStore.LoadConsensusParams(height)
+		require.NoError(t, err)
+		assert.Equal(t, params, newParams)
+	}
+	for height := from; height <= to+2; height++ {
+		vals, err := stateStore.LoadValidators(height)
+		require.NoError(t, err)
+		newVals, err := newStateStore.LoadValidators(height)
+		require.NoError(t, err)
+		assert.Equal(t, vals, newVals, "validators at height %d", height)
+	}
+}
@@ -0,0 +1,880 @@
+package store
+
+import (
+	"container/list"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/evidence"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmterrors "github.com/cometbft/cometbft/types/errors"
+)
+
+const (
+	segmentFileExt = ".seg"
+
+	// maximum number of segment files kept open for reading
+	maxOpenSegments = 64
+)
+
+var segmentSizeKey = []byte("segmentSize")
+
+/*
+FlatFileBlockStore is a block store which appends the block parts, block metas
+and commits to segment files, each covering a fixed range of heights, and keeps
+their location in a small index database, under the same keys as BlockStore.
+
+Writing a block appends its records to the segment of its height and syncs it,
+before atomically writing their locations along with the new height to the
+index. The records appended by a write which didn't complete are never
+referenced, and are thus harmless.
+
+Pruning removes the pruned blocks from the index like BlockStore does, and
+deletes the segment files whose heights are all beyond the evidence point,
+which is much cheaper than deleting each record from a key-value database.
+
+FlatFileBlockStore methods will panic if they encounter errors reading or
+deserializing the stored data, indicating probable corruption on disk.
+*/
+type FlatFileBlockStore struct {
+	dir         string
+	index       dbm.DB
+	segmentSize int64
+
+	// writeMtx serializes the writes to the segment files and the index,
+	// including the updates of the base and height.
+	writeMtx     cmtsync.Mutex
+	segment      *os.File // the segment being appended to, if any
+	segmentFirst int64    // the first height of the segment being appended to
+	segmentLen   int64    // the length of the segment being appended to
+
+	// readers keeps the segments recently read from open.
+	readers *segmentReaders
+
+	// mtx guards access to the fields listed below it.
+	mtx    cmtsync.RWMutex
+	base   int64
+	height int64
+}
+
+var _ sm.BlockStore = (*FlatFileBlockStore)(nil)
+
+// NewFlatFileBlockStore returns a new FlatFileBlockStore storing the segment
+// files in dir and their index in the given DB, initialized to the last height
+// that was committed to the index. The segment size can't be changed once the
+// store has been created.
+func NewFlatFileBlockStore(dir string, index dbm.DB, segmentSize int64) (*FlatFileBlockStore, error) {
+	if segmentSize <= 0 {
+		return nil, fmt.Errorf("segment size must be positive, got %d", segmentSize)
+	}
+	bz, err := index.Get(segmentSizeKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) > 0 {
+		stored, err := strconv.ParseInt(string(bz), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored segment size %q: %w", bz, err)
+		}
+		if stored != segmentSize {
+			return nil, fmt.Errorf("block store was created with segment size %d, can't open it with %d",
+				stored, segmentSize)
+		}
+	} else if err := index.SetSync(segmentSizeKey, []byte(strconv.FormatInt(segmentSize, 10))); err != nil {
+		return nil, err
+	}
+	if err := cmtos.EnsureDir(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	bss := LoadBlockStoreState(index)
+	return &FlatFileBlockStore{
+		dir:         dir,
+		index:       index,
+		segmentSize: segmentSize,
+		readers:     newSegmentReaders(maxOpenSegments),
+		base:        bss.Base,
+		height:      bss.Height,
+	}, nil
+}
+
+// Base returns the first known contiguous block height, or 0 for empty block stores.
+func (bs *FlatFileBlockStore) Base() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.base
+}
+
+// Height returns the last known contiguous block height, or 0 for empty block stores.
+func (bs *FlatFileBlockStore) Height() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.height
+}
+
+// Size returns the number of blocks in the block store.
+func (bs *FlatFileBlockStore) Size() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	if bs.height == 0 {
+		return 0
+	}
+	return bs.height - bs.base + 1
+}
+
+// LoadBaseMeta atomically loads the base block meta, or returns nil if no base is found.
+func (bs *FlatFileBlockStore) LoadBaseMeta() *types.BlockMeta {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	if bs.base == 0 {
+		return nil
+	}
+	return bs.LoadBlockMeta(bs.base)
+}
+
+// LoadBlock returns the block with the given height.
+// If no block is found for that height, it returns nil.
+func (bs *FlatFileBlockStore) LoadBlock(height int64) *types.Block {
+	return loadBlock(bs, height)
+}
+
+// LoadBlockByHash returns the block with the given hash.
+// If no block is found for that hash, it returns nil.
+func (bs *FlatFileBlockStore) LoadBlockByHash(hash []byte) *types.Block {
+	bz, err := bs.index.Get(calcBlockHashKey(hash))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	return bs.LoadBlock(parseHashHeight(bz))
+}
+
+// LoadBlockPart returns the Part at the given index
+// from the block at the given height.
+// If no part is found for the given height and index, it returns nil.
+func (bs *FlatFileBlockStore) LoadBlockPart(height int64, index int) *types.Part {
+	return decodeBlockPart(bs.loadRecord(calcBlockPartKey(height, index)))
+}
+
+// LoadBlockMeta returns the BlockMeta for the given height.
+// If no block is found for the given height, it returns nil.
+func (bs *FlatFileBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return decodeBlockMeta(bs.loadRecord(calcBlockMetaKey(height)))
+}
+
+// LoadBlockMetaByHash returns the blockmeta who's header corresponds to the given
+// hash. If none is found, returns nil.
+func (bs *FlatFileBlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta {
+	bz, err := bs.index.Get(calcBlockHashKey(hash))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	return bs.LoadBlockMeta(parseHashHeight(bz))
+}
+
+// LoadBlockCommit returns the Commit for the given height.
+// This commit consists of the +2/3 and other Precommit-votes for block at `height`,
+// and it comes from the block.LastCommit for `height+1`.
+// If no commit is found for the given height, it returns nil.
+func (bs *FlatFileBlockStore) LoadBlockCommit(height int64) *types.Commit {
+	return decodeBlockCommit(bs.loadRecord(calcBlockCommitKey(height)))
+}
+
+// LoadBlockExtendedCommit returns the ExtendedCommit for the given height.
+// The extended commit is not guaranteed to contain the same +2/3 precommits data
+// as the commit in the block.
+func (bs *FlatFileBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	return decodeExtendedCommit(bs.loadRecord(calcExtCommitKey(height)))
+}
+
+// LoadSeenCommit returns the locally seen Commit for the given height.
+// This is useful when we've seen a commit, but there has not yet been
+// a new block at `height + 1` that includes this commit in its block.LastCommit.
+func (bs *FlatFileBlockStore) LoadSeenCommit(height int64) *types.Commit {
+	return decodeSeenCommit(bs.loadRecord(calcSeenCommitKey(height)))
+}
+
+// PruneBlocks removes block up to (but not including) a height. It returns the
+// number of blocks pruned and the evidence retain height - the height at which
+// data needed to prove evidence must not be removed. The segment files whose
+// heights are all below the evidence retain height are deleted.
+func (bs *FlatFileBlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64, error) {
+	if height <= 0 {
+		return 0, -1, fmt.Errorf("height must be greater than 0")
+	}
+	bs.mtx.RLock()
+	if height > bs.height {
+		bs.mtx.RUnlock()
+		return 0, -1, fmt.Errorf("cannot prune beyond the latest height %v", bs.height)
+	}
+	base := bs.base
+	bs.mtx.RUnlock()
+	if height < base {
+		return 0, -1, fmt.Errorf("cannot prune to height %v, it is lower than base height %v",
+			height, base)
+	}
+
+	pruned := uint64(0)
+	batch := bs.index.NewBatch()
+	defer batch.Close()
+	flush := func(batch dbm.Batch, base int64) error {
+		// Don't let a concurrent save persist the previous base.
+		bs.writeMtx.Lock()
+		defer bs.writeMtx.Unlock()
+
+		// We can't trust batches to be atomic, so update base first to make sure noone
+		// tries to access missing blocks.
+		bs.mtx.Lock()
+		bs.base = base
+		bs.mtx.Unlock()
+		bs.saveState()
+
+		err := batch.WriteSync()
+		if err != nil {
+			return fmt.Errorf("failed to prune up to height %v: %w", base, err)
+		}
+		batch.Close()
+		return nil
+	}
+
+	evidencePoint := height
+	for h := base; h < height; h++ {
+		meta := bs.LoadBlockMeta(h)
+		if meta == nil { // assume already deleted
+			continue
+		}
+
+		// This logic is in place to protect data that proves malicious behavior.
+		// If the height is within the evidence age, we continue to persist the header and commit data.
+		if evidencePoint == height && !evidence.IsEvidenceExpired(state.LastBlockHeight, state.LastBlockTime, h, meta.Header.Time, state.ConsensusParams.Evidence) {
+			evidencePoint = h
+		}
+
+		keys := [][]byte{calcBlockHashKey(meta.BlockID.Hash), calcSeenCommitKey(h)}
+		// if height is beyond the evidence point we dont delete the header
+		// and the commit data
+		if h < evidencePoint {
+			keys = append(keys, calcBlockMetaKey(h), calcBlockCommitKey(h))
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, calcBlockPartKey(h, p))
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return 0, -1, err
+			}
+		}
+		pruned++
+
+		// flush every 1000 blocks to avoid batches becoming too large
+		if pruned%1000 == 0 && pruned > 0 {
+			err := flush(batch, h)
+			if err != nil {
+				return 0, -1, err
+			}
+			batch = bs.index.NewBatch()
+			defer batch.Close()
+		}
+	}
+
+	err := flush(batch, height)
+	if err != nil {
+		return 0, -1, err
+	}
+	if err := bs.pruneSegments(evidencePoint); err != nil {
+		return 0, -1, err
+	}
+	return pruned, evidencePoint, nil
+}
+
+// pruneSegments deletes the segment files whose heights are all below the
+// given height, along with whatever the index still references in them.
+func (bs *FlatFileBlockStore) pruneSegments(height int64) error {
+	entries, err := os.ReadDir(bs.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		first, ok := parseSegmentFileName(entry.Name())
+		if !ok {
+			continue
+		}
+		last := first + bs.segmentSize - 1
+		if last >= height {
+			continue
+		}
+
+		batch := bs.index.NewBatch()
+		for h := first; h <= last; h++ {
+			if err := bs.deleteHeight(batch, h); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err := batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return fmt.Errorf("failed to prune segment %v: %w", first, err)
+		}
+
+		bs.writeMtx.Lock()
+		if bs.segment != nil && bs.segmentFirst == first {
+			bs.closeSegment()
+		}
+		bs.readers.remove(first)
+		err = os.Remove(filepath.Join(bs.dir, entry.Name()))
+		bs.writeMtx.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to delete segment %v: %w", first, err)
+		}
+	}
+	return nil
+}
+
+// deleteHeight adds the deletion of all the index entries of the given height
+// to the batch.
+func (bs *FlatFileBlockStore) deleteHeight(batch dbm.Batch, height int64) error {
+	keys := [][]byte{
+		calcBlockCommitKey(height),
+		calcSeenCommitKey(height),
+		calcExtCommitKey(height),
+		calcBlockMetaKey(height),
+	}
+	if meta := bs.LoadBlockMeta(height); meta != nil {
+		keys = append(keys, calcBlockHashKey(meta.BlockID.Hash))
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, calcBlockPartKey(height, p))
+		}
+	}
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveBlock persists the given block, blockParts, and seenCommit to the
+// segment of the block's height.
+// blockParts: Must be parts of the block
+// seenCommit: The +2/3 precommits that were seen which committed at height.
+func (bs *FlatFileBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+	if block == nil {
+		panic("BlockStore can only save a non-nil block")
+	}
+	if err := bs.saveBlock(block, blockParts, seenCommit, nil); err != nil {
+		panic(err)
+	}
+}
+
+// SaveBlockWithExtendedCommit persists the given block, blockParts, and
+// seenExtendedCommit to the segment of the block's height. seenExtendedCommit
+// is stored both as the seenCommit and as the ExtendedCommit data for the
+// height.
+func (bs *FlatFileBlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet, seenExtendedCommit *types.ExtendedCommit) {
+	if block == nil {
+		panic("BlockStore can only save a non-nil block")
+	}
+	if err := seenExtendedCommit.EnsureExtensions(true); err != nil {
+		panic(fmt.Errorf("problems saving block with extensions: %w", err))
+	}
+	if err := bs.saveBlock(block, blockParts, seenExtendedCommit.ToCommit(), seenExtendedCommit); err != nil {
+		panic(err)
+	}
+}
+
+func (bs *FlatFileBlockStore) saveBlock(
+	block *types.Block,
+	blockParts *types.PartSet,
+	seenCommit *types.Commit,
+	seenExtendedCommit *types.ExtendedCommit,
+) error {
+	bs.writeMtx.Lock()
+	defer bs.writeMtx.Unlock()
+
+	height := block.Height
+	if g, w := height, bs.Height()+1; bs.Base() > 0 && g != w {
+		return fmt.Errorf("BlockStore can only save contiguous blocks. Wanted %v, got %v", w, g)
+	}
+	if !blockParts.IsComplete() {
+		return errors.New("BlockStore can only save complete block part sets")
+	}
+	if height != seenCommit.Height {
+		return fmt.Errorf("BlockStore cannot save seen commit of a different height (block: %d, commit: %d)", height, seenCommit.Height)
+	}
+
+	var keys, records [][]byte
+	for i := 0; i < int(blockParts.Total()); i++ {
+		pbp, err := blockParts.GetPart(i).ToProto()
+		if err != nil {
+			return cmterrors.ErrMsgToProto{MessageName: "Part", Err: err}
+		}
+		keys = append(keys, calcBlockPartKey(height, i))
+		records = append(records, mustEncode(pbp))
+	}
+	pbm := types.NewBlockMeta(block, blockParts).ToProto()
+	if pbm == nil {
+		return errors.New("nil blockmeta")
+	}
+	keys = append(keys, calcBlockMetaKey(height), calcBlockCommitKey(height-1), calcSeenCommitKey(height))
+	records = append(records, mustEncode(pbm), mustEncode(block.LastCommit.ToProto()), mustEncode(seenCommit.ToProto()))
+	if seenExtendedCommit != nil {
+		keys = append(keys, calcExtCommitKey(height))
+		records = append(records, mustEncode(seenExtendedCommit.ToProto()))
+	}
+
+	locations, err := bs.appendRecords(height, records)
+	if err != nil {
+		return err
+	}
+
+	// The base can't change while writeMtx is held, as pruning holds it too.
+	bs.mtx.RLock()
+	bss := cmtstore.BlockStoreState{Base: bs.base, Height: height}
+	bs.mtx.RUnlock()
+	if bss.Base == 0 {
+		bss.Base = height
+	}
+
+	batch := bs.index.NewBatch()
+	defer batch.Close()
+	for i, key := range keys {
+		if err := batch.Set(key, locations[i].encode()); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(calcBlockHashKey(block.Hash()), []byte(fmt.Sprintf("%d", height))); err != nil {
+		return err
+	}
+	if err := batch.Set(blockStoreKey, mustEncode(&bss)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("failed to save block %v: %w", height, err)
+	}
+
+	bs.mtx.Lock()
+	bs.height = height
+	if bs.base == 0 {
+		bs.base = height
+	}
+	bs.mtx.Unlock()
+	return nil
+}
+
+// SaveSeenCommit saves a seen commit, used by e.g. the state sync reactor when bootstrapping node.
+func (bs *FlatFileBlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) error {
+	bs.writeMtx.Lock()
+	defer bs.writeMtx.Unlock()
+
+	locations, err := bs.appendRecords(height, [][]byte{mustEncode(seenCommit.ToProto())})
+	if err != nil {
+		return err
+	}
+	return bs.index.SetSync(calcSeenCommitKey(height), locations[0].encode())
+}
+
+// saveRetained saves the block meta and commit of a height retained below the
+// base to prove evidence, e.g. when migrating a pruned block store.
+func (bs *FlatFileBlockStore) saveRetained(height int64, meta *types.BlockMeta, commit *types.Commit) error {
+	bs.writeMtx.Lock()
+	defer bs.writeMtx.Unlock()
+
+	keys := [][]byte{calcBlockMetaKey(height)}
+	records := [][]byte{mustEncode(meta.ToProto())}
+	if commit != nil {
+		keys = append(keys, calcBlockCommitKey(height))
+		records = append(records, mustEncode(commit.ToProto()))
+	}
+	locations, err := bs.appendRecords(height, records)
+	if err != nil {
+		return err
+	}
+
+	batch := bs.index.NewBatch()
+	defer batch.Close()
+	for i, key := range keys {
+		if err := batch.Set(key, locations[i].encode()); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+func (bs *FlatFileBlockStore) saveState() {
+	bs.mtx.RLock()
+	bss := cmtstore.BlockStoreState{
+		Base:   bs.base,
+		Height: bs.height,
+	}
+	bs.mtx.RUnlock()
+	SaveBlockStoreState(&bss, bs.index)
+}
+
+// DeleteLatestBlock removes the block pointed to by height,
+// lowering height by one. Its records are left in the segment, and are
+// overwritten in the index by the next block saved at this height.
+func (bs *FlatFileBlockStore) DeleteLatestBlock() error {
+	bs.writeMtx.Lock()
+	defer bs.writeMtx.Unlock()
+
+	bs.mtx.RLock()
+	targetHeight := bs.height
+	bs.mtx.RUnlock()
+
+	batch := bs.index.NewBatch()
+	defer batch.Close()
+
+	// delete what we can, skipping what's already missing, to ensure partial
+	// blocks get deleted fully.
+	if meta := bs.LoadBlockMeta(targetHeight); meta != nil {
+		if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+			return err
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			if err := batch.Delete(calcBlockPartKey(targetHeight, p)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := batch.Delete(calcBlockCommitKey(targetHeight)); err != nil {
+		return err
+	}
+	if err := batch.Delete(calcSeenCommitKey(targetHeight)); err != nil {
+		return err
+	}
+	// delete last, so as to not leave keys built on meta.BlockID dangling
+	if err := batch.Delete(calcBlockMetaKey(targetHeight)); err != nil {
+		return err
+	}
+
+	bs.mtx.Lock()
+	bs.height = targetHeight - 1
+	bs.mtx.Unlock()
+	bs.saveState()
+
+	err := batch.WriteSync()
+	if err != nil {
+		return fmt.Errorf("failed to delete height %v: %w", targetHeight, err)
+	}
+	return nil
+}
+
+// Close closes the segment files and the index.
+func (bs *FlatFileBlockStore) Close() error {
+	bs.writeMtx.Lock()
+	err := bs.closeSegment()
+	bs.writeMtx.Unlock()
+	bs.readers.close()
+	if ierr := bs.index.Close(); ierr != nil {
+		return ierr
+	}
+	return err
+}
+
+//-----------------------------------------------------------------------------
+
+// appendRecords appends the records to the segment of the given height, syncs
+// it and returns their locations. It must be called with writeMtx held.
+func (bs *FlatFileBlockStore) appendRecords(height int64, records [][]byte) ([]recordLocation, error) {
+	first := (height-1)/bs.segmentSize*bs.segmentSize + 1
+	if bs.segment == nil || bs.segmentFirst != first {
+		if err := bs.closeSegment(); err != nil {
+			return nil, err
+		}
+		f, err := os.OpenFile(bs.segmentPath(first), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		bs.segment, bs.segmentFirst, bs.segmentLen = f, first, info.Size()
+	}
+
+	var buf []byte
+	locations := make([]recordLocation, len(records))
+	for i, record := range records {
+		locations[i] = recordLocation{
+			segment:  first,
+			offset:   bs.segmentLen + int64(len(buf)),
+			length:   int64(len(record)),
+			checksum: crc32.ChecksumIEEE(record),
+		}
+		buf = append(buf, record...)
+	}
+	n, err := bs.segment.Write(buf)
+	bs.segmentLen += int64(n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to append to segment %v: %w", first, err)
+	}
+	if err := bs.segment.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync segment %v: %w", first, err)
+	}
+	return locations, nil
+}
+
+// closeSegment closes the segment being appended to, if any. It must be
+// called with writeMtx held.
+func (bs *FlatFileBlockStore) closeSegment() error {
+	if bs.segment == nil {
+		return nil
+	}
+	err := bs.segment.Close()
+	bs.segment = nil
+	return err
+}
+
+// loadRecord returns the record referenced by the given index key, or nil if
+// there is none, or if its segment has been pruned.
+func (bs *FlatFileBlockStore) loadRecord(key []byte) []byte {
+	bz, err := bs.index.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	loc, err := decodeRecordLocation(bz)
+	if err != nil {
+		panic(fmt.Errorf("invalid location of %s: %w", key, err))
+	}
+
+	r, err := bs.readers.acquire(loc.segment, bs.segmentPath(loc.segment))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+	defer bs.readers.release(r)
+
+	record := make([]byte, loc.length)
+	if _, err := r.file.ReadAt(record, loc.offset); err != nil {
+		if errors.Is(err, io.EOF) {
+			panic(fmt.Errorf("segment %v is truncated, can't read %s", loc.segment, key))
+		}
+		panic(err)
+	}
+	if crc32.ChecksumIEEE(record) != loc.checksum {
+		panic(fmt.Errorf("checksum mismatch reading %s from segment %v", key, loc.segment))
+	}
+	return record
+}
+
+// segmentReaders keeps up to size segment files open for reading, closing the
+// least recently used ones. A file is only closed once all the readers which
+// acquired it have released it.
+type segmentReaders struct {
+	mtx      cmtsync.Mutex
+	size     int
+	segments map[int64]*list.Element
+	list     *list.List
+}
+
+type segmentReader struct {
+	segment int64
+	file    *os.File
+	refs    int
+	evicted bool
+}
+
+func newSegmentReaders(size int) *segmentReaders {
+	return &segmentReaders{
+		size:     size,
+		segments: make(map[int64]*list.Element, size),
+		list:     list.New(),
+	}
+}
+
+// acquire returns the reader of the given segment, opening the file at path
+// if it isn't open yet. The reader must be released once done.
+func (r *segmentReaders) acquire(segment int64, path string) (*segmentReader, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if e, ok := r.segments[segment]; ok {
+		r.list.MoveToFront(e)
+		sr := e.Value.(*segmentReader)
+		sr.refs++
+		return sr, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if r.list.Len() >= r.size {
+		r.evict(r.list.Back())
+	}
+	sr := &segmentReader{segment: segment, file: f, refs: 1}
+	r.segments[segment] = r.list.PushFront(sr)
+	return sr, nil
+}
+
+// release releases a reader returned by acquire.
+func (r *segmentReaders) release(sr *segmentReader) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	sr.refs--
+	if sr.evicted && sr.refs == 0 {
+		sr.file.Close()
+	}
+}
+
+// remove closes the file of the given segment, e.g. before it is deleted.
+func (r *segmentReaders) remove(segment int64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if e, ok := r.segments[segment]; ok {
+		r.evict(e)
+	}
+}
+
+// close closes all the files.
+func (r *segmentReaders) close() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for r.list.Len() > 0 {
+		r.evict(r.list.Back())
+	}
+}
+
+// evict removes the reader of the given element, closing its file unless it
+// is still in use. It must be called with mtx held.
+func (r *segmentReaders) evict(e *list.Element) {
+	sr := r.list.Remove(e).(*segmentReader)
+	delete(r.segments, sr.segment)
+	sr.evicted = true
+	if sr.refs == 0 {
+		sr.file.Close()
+	}
+}
+
+func (bs *FlatFileBlockStore) segmentPath(first int64) string {
+	return filepath.Join(bs.dir, fmt.Sprintf("%020d%s", first, segmentFileExt))
+}
+
+func parseSegmentFileName(name string) (int64, bool) {
+	if !strings.HasSuffix(name, segmentFileExt) {
+		return 0, false
+	}
+	first, err := strconv.ParseInt(strings.TrimSuffix(name, segmentFileExt), 10, 64)
+	if err != nil || first <= 0 {
+		return 0, false
+	}
+	return first, true
+}
+
+// recordLocation is the location of a record in the segment files.
+type recordLocation struct {
+	segment  int64 // the first height of the segment
+	offset   int64
+	length   int64
+	checksum uint32
+}
+
+func (l recordLocation) encode() []byte {
+	bz := make([]byte, 0, 3*binary.MaxVarintLen64+4)
+	bz = binary.AppendUvarint(bz, uint64(l.segment))
+	bz = binary.AppendUvarint(bz, uint64(l.offset))
+	bz = binary.AppendUvarint(bz, uint64(l.length))
+	return binary.BigEndian.AppendUint32(bz, l.checksum)
+}
+
+func decodeRecordLocation(bz []byte) (recordLocation, error) {
+	var (
+		loc    recordLocation
+		fields [3]uint64
+	)
+	for i := range fields {
+		v, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return loc, errors.New("malformed varint")
+		}
+		fields[i], bz = v, bz[n:]
+	}
+	if len(bz) != 4 {
+		return loc, fmt.Errorf("expected a 4 bytes checksum, got %d bytes", len(bz))
+	}
+	loc.segment, loc.offset, loc.length = int64(fields[0]), int64(fields[1]), int64(fields[2])
+	loc.checksum = binary.BigEndian.Uint32(bz)
+	return loc, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// MigrateToFlatFile copies the blocks of src to dst, which must be empty,
+// along with the block metas and commits retained below the base of src to
+// prove evidence. Neither store must be in use during the migration.
+func MigrateToFlatFile(src *BlockStore, dst *FlatFileBlockStore) error {
+	if dst.Height() != 0 {
+		return fmt.Errorf("destination block store is not empty, its height is %d", dst.Height())
+	}
+
+	base, height := src.Base(), src.Height()
+	for h := base; h > 0 && h <= height; h++ {
+		meta := src.LoadBlockMeta(h)
+		block := src.LoadBlock(h)
+		if meta == nil || block == nil {
+			return fmt.Errorf("block %d is missing", h)
+		}
+		parts := types.NewPartSetFromHeader(meta.BlockID.PartSetHeader)
+		for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+			part := src.LoadBlockPart(h, i)
+			if part == nil {
+				return fmt.Errorf("part %d of block %d is missing", i, h)
+			}
+			if _, err := parts.AddPart(part); err != nil {
+				return fmt.Errorf("invalid part %d of block %d: %w", i, h, err)
+			}
+		}
+
+		if extCommit := src.LoadBlockExtendedCommit(h); extCommit != nil {
+			dst.SaveBlockWithExtendedCommit(block, parts, extCommit)
+			continue
+		}
+		seenCommit := src.LoadSeenCommit(h)
+		if seenCommit == nil {
+			seenCommit = src.LoadBlockCommit(h)
+		}
+		if seenCommit == nil {
+			return fmt.Errorf("commit of block %d is missing", h)
+		}
+		dst.SaveBlock(block, parts, seenCommit)
+	}
+
+	for h := base - 1; h > 0; h-- {
+		meta := src.LoadBlockMeta(h)
+		if meta == nil {
+			break
+		}
+		if err := dst.saveRetained(h, meta, src.LoadBlockCommit(h)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
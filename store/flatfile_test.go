@@ -0,0 +1,310 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/internal/test"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func makeGenesisState(t *testing.T) sm.State {
+	t.Helper()
+	config := test.ResetTestRoot("flatfile_block_store_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	return state
+}
+
+func saveTestBlocks(t *testing.T, bs sm.BlockStore, state sm.State, from, to int64) {
+	t.Helper()
+	for h := from; h <= to; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(2)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+	}
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestFlatFileBlockStore(t *testing.T) {
+	state := makeGenesisState(t)
+	dir := t.TempDir()
+	index := dbm.NewMemDB()
+	bs, err := NewFlatFileBlockStore(dir, index, 10)
+	require.NoError(t, err)
+	assert.Nil(t, bs.LoadBaseMeta())
+	assert.Nil(t, bs.LoadBlock(1))
+
+	saveTestBlocks(t, bs, state, 1, 25)
+	assert.EqualValues(t, 1, bs.Base())
+	assert.EqualValues(t, 25, bs.Height())
+	assert.EqualValues(t, 25, bs.Size())
+	assert.Equal(t, []string{
+		"00000000000000000001.seg",
+		"00000000000000000011.seg",
+		"00000000000000000021.seg",
+	}, segmentFiles(t, dir))
+
+	block := state.MakeBlock(26, test.MakeNTxs(26, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+	partSet, err := block.MakePartSet(2)
+	require.NoError(t, err)
+	seenCommit := makeTestExtCommit(26, cmttime.Now())
+	bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+
+	loaded := bs.LoadBlock(26)
+	require.NotNil(t, loaded)
+	assert.Equal(t, block.Hash(), loaded.Hash())
+	assert.Equal(t, block.Hash(), bs.LoadBlockByHash(block.Hash()).Hash())
+	assert.Equal(t, partSet.GetPart(1), bs.LoadBlockPart(26, 1))
+	assert.Nil(t, bs.LoadBlockPart(26, int(partSet.Total())))
+	meta := bs.LoadBlockMeta(26)
+	require.NotNil(t, meta)
+	assert.Equal(t, partSet.Header(), meta.BlockID.PartSetHeader)
+	assert.Equal(t, meta, bs.LoadBlockMetaByHash(block.Hash()))
+	assert.Equal(t, seenCommit.ToCommit(), bs.LoadSeenCommit(26))
+	assert.Equal(t, seenCommit, bs.LoadBlockExtendedCommit(26))
+	assert.Equal(t, block.LastCommit.ToProto(), bs.LoadBlockCommit(25).ToProto())
+	assert.Nil(t, bs.LoadBlockCommit(26))
+	assert.Nil(t, bs.LoadBlock(27))
+
+	// the seen commit of a height which is not stored, e.g. after state sync
+	require.NoError(t, bs.SaveSeenCommit(100, seenCommit.ToCommit()))
+	assert.Equal(t, seenCommit.ToCommit(), bs.LoadSeenCommit(100))
+
+	// blocks must be contiguous
+	assert.Panics(t, func() { bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit) })
+
+	require.NoError(t, bs.DeleteLatestBlock())
+	assert.EqualValues(t, 25, bs.Height())
+	assert.Nil(t, bs.LoadBlock(26))
+	assert.Nil(t, bs.LoadBlockMetaByHash(block.Hash()))
+	bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+	require.NoError(t, bs.Close())
+
+	// the segment size can't be changed
+	_, err = NewFlatFileBlockStore(dir, index, 20)
+	require.Error(t, err)
+
+	bs, err = NewFlatFileBlockStore(dir, index, 10)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, bs.Base())
+	assert.EqualValues(t, 26, bs.Height())
+	assert.Equal(t, block.Hash(), bs.LoadBlock(26).Hash())
+	saveTestBlocks(t, bs, state, 27, 27)
+	assert.NotNil(t, bs.LoadBlock(27))
+
+	// corrupted records are detected
+	f, err := os.OpenFile(filepath.Join(dir, "00000000000000000001.seg"), os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("corrupted"), 0)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Panics(t, func() { bs.LoadBlock(1) })
+}
+
+func TestFlatFileBlockStorePruneBlocks(t *testing.T) {
+	state := makeGenesisState(t)
+	dir := t.TempDir()
+	bs, err := NewFlatFileBlockStore(dir, dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	defer bs.Close()
+
+	_, _, err = bs.PruneBlocks(1, state)
+	require.Error(t, err)
+
+	// make more than 1000 blocks, to test batch deletions
+	saveTestBlocks(t, bs, state, 1, 1500)
+	assert.Len(t, segmentFiles(t, dir), 15)
+
+	state.LastBlockTime = time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	state.LastBlockHeight = 1500
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 400
+	state.ConsensusParams.Evidence.MaxAgeDuration = 1 * time.Second
+
+	pruned, evidenceRetainHeight, err := bs.PruneBlocks(1200, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1199, pruned)
+	assert.EqualValues(t, 1200, bs.Base())
+	assert.EqualValues(t, 301, bs.Size())
+	assert.EqualValues(t, 1100, evidenceRetainHeight)
+
+	// the segments below the evidence retain height are deleted
+	files := segmentFiles(t, dir)
+	require.Len(t, files, 5)
+	assert.Equal(t, "00000000000000001001.seg", files[0])
+
+	require.NotNil(t, bs.LoadBlock(1200))
+	require.Nil(t, bs.LoadBlock(1199))
+	require.NotNil(t, bs.LoadBlockMeta(1100))
+	require.Nil(t, bs.LoadBlockMeta(1099))
+	require.NotNil(t, bs.LoadBlockCommit(1100))
+	require.Nil(t, bs.LoadBlockCommit(1099))
+	require.Nil(t, bs.LoadBlockExtendedCommit(1000))
+
+	_, _, err = bs.PruneBlocks(1199, state)
+	require.Error(t, err)
+
+	pruned, _, err = bs.PruneBlocks(1300, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, pruned)
+	assert.EqualValues(t, 1300, bs.Base())
+	// the evidence retained in the deleted segment has expired
+	assert.Len(t, segmentFiles(t, dir), 4)
+	require.Nil(t, bs.LoadBlockMeta(1100))
+	require.NotNil(t, bs.LoadBlockMeta(1200))
+
+	state.LastBlockHeight = 1800
+	pruned, evidenceRetainHeight, err = bs.PruneBlocks(1500, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 200, pruned)
+	assert.EqualValues(t, 1400, evidenceRetainHeight)
+	assert.Len(t, segmentFiles(t, dir), 2)
+	assert.Nil(t, bs.LoadBlockMeta(1300))
+	assert.NotNil(t, bs.LoadBlock(1500))
+
+	saveTestBlocks(t, bs, state, 1501, 1501)
+	assert.NotNil(t, bs.LoadBlock(1501))
+}
+
+func TestFlatFileBlockStoreConcurrentSaveAndPrune(t *testing.T) {
+	state := makeGenesisState(t)
+	index := dbm.NewMemDB()
+	bs, err := NewFlatFileBlockStore(t.TempDir(), index, 10)
+	require.NoError(t, err)
+	defer bs.Close()
+	saveTestBlocks(t, bs, state, 1, 20)
+
+	var prunedTo atomic.Int64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for h := int64(21); h <= 300; h++ {
+			saveTestBlocks(t, bs, state, h, h)
+			// a save must never revert the base of a concurrent prune
+			assert.GreaterOrEqual(t, bs.Base(), prunedTo.Load(), h)
+		}
+	}()
+	for target := int64(2); target <= 250; target++ {
+		for bs.Height() < target {
+			time.Sleep(time.Millisecond)
+		}
+		_, _, err := bs.PruneBlocks(target, state)
+		require.NoError(t, err)
+		prunedTo.Store(target)
+	}
+	<-done
+
+	assert.EqualValues(t, 250, bs.Base())
+	assert.EqualValues(t, 300, bs.Height())
+	bss := LoadBlockStoreState(index)
+	assert.EqualValues(t, 250, bss.Base)
+	assert.EqualValues(t, 300, bss.Height)
+}
+
+func TestMigrateToFlatFile(t *testing.T) {
+	state := makeGenesisState(t)
+	src := NewBlockStore(dbm.NewMemDB())
+	saveTestBlocks(t, src, state, 1, 300)
+
+	state.LastBlockTime = time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	state.LastBlockHeight = 300
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 200
+	state.ConsensusParams.Evidence.MaxAgeDuration = 1 * time.Second
+	_, evidenceRetainHeight, err := src.PruneBlocks(150, state)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, evidenceRetainHeight)
+
+	dst, err := NewFlatFileBlockStore(t.TempDir(), dbm.NewMemDB(), 50)
+	require.NoError(t, err)
+	defer dst.Close()
+	require.NoError(t, MigrateToFlatFile(src, dst))
+	assert.EqualValues(t, 150, dst.Base())
+	assert.EqualValues(t, 300, dst.Height())
+
+	for h := int64(1); h <= 300; h++ {
+		assert.Equal(t, src.LoadBlock(h), dst.LoadBlock(h), h)
+		assert.Equal(t, src.LoadBlockMeta(h), dst.LoadBlockMeta(h), h)
+		assert.Equal(t, src.LoadBlockCommit(h), dst.LoadBlockCommit(h), h)
+		if h >= 150 {
+			assert.Equal(t, src.LoadSeenCommit(h), dst.LoadSeenCommit(h), h)
+			assert.Equal(t, src.LoadBlockExtendedCommit(h), dst.LoadBlockExtendedCommit(h), h)
+		}
+	}
+	// the data retained to prove evidence is migrated
+	assert.NotNil(t, dst.LoadBlockMeta(100))
+	assert.NotNil(t, dst.LoadBlockCommit(100))
+	assert.Nil(t, dst.LoadBlockMeta(99))
+
+	// the destination must be empty
+	require.Error(t, MigrateToFlatFile(src, dst))
+}
+
+func TestSegmentReaders(t *testing.T) {
+	dir := t.TempDir()
+	paths := make([]string, 3)
+	for i := range paths {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("%d.seg", i))
+		require.NoError(t, os.WriteFile(paths[i], []byte{byte(i)}, 0o600))
+	}
+	isClosed := func(sr *segmentReader) bool {
+		_, err := sr.file.ReadAt(make([]byte, 1), 0)
+		return errors.Is(err, os.ErrClosed)
+	}
+
+	readers := newSegmentReaders(2)
+	r0, err := readers.acquire(0, paths[0])
+	require.NoError(t, err)
+	readers.release(r0)
+	r1, err := readers.acquire(1, paths[1])
+	require.NoError(t, err)
+	readers.release(r1)
+
+	// The open file is reused.
+	r, err := readers.acquire(0, paths[0])
+	require.NoError(t, err)
+	assert.Same(t, r0, r)
+	readers.release(r)
+
+	// The least recently used file is closed.
+	r2, err := readers.acquire(2, paths[2])
+	require.NoError(t, err)
+	assert.True(t, isClosed(r1))
+	assert.False(t, isClosed(r0))
+
+	// A removed file is closed once released.
+	readers.remove(2)
+	assert.False(t, isClosed(r2))
+	readers.release(r2)
+	assert.True(t, isClosed(r2))
+
+	_, err = readers.acquire(3, filepath.Join(dir, "missing.seg"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	readers.close()
+	assert.True(t, isClosed(r0))
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strconv"
 
 	cmterrors "github.com/cometbft/cometbft/types/errors"
@@ -10,6 +11,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/evidence"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
@@ -59,6 +61,38 @@ func NewBlockStore(db dbm.DB) *BlockStore {
 	}
 }
 
+// NewBlockStoreFromConfig opens the block store backend selected in the
+// storage configuration, using dbProvider to open its databases.
+func NewBlockStoreFromConfig(config *cfg.Config, dbProvider cfg.DBProvider) (sm.BlockStore, error) {
+	switch config.Storage.BlockStoreBackend {
+	case cfg.BlockStoreBackendDB:
+		db, err := dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+		if err != nil {
+			return nil, err
+		}
+		return NewBlockStore(db), nil
+	case cfg.BlockStoreBackendFlatFile:
+		return NewFlatFileBlockStoreFromConfig(config, dbProvider)
+	default:
+		return nil, fmt.Errorf("unknown block store backend: %q", config.Storage.BlockStoreBackend)
+	}
+}
+
+// NewFlatFileBlockStoreFromConfig opens the FlatFileBlockStore of the node,
+// whatever the block store backend selected in the storage configuration.
+func NewFlatFileBlockStoreFromConfig(config *cfg.Config, dbProvider cfg.DBProvider) (*FlatFileBlockStore, error) {
+	index, err := dbProvider(&cfg.DBContext{ID: "blockstore_index", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	bs, err := NewFlatFileBlockStore(filepath.Join(config.DBDir(), "blockstore.seg"), index, config.Storage.BlockStoreSegmentSize)
+	if err != nil {
+		index.Close()
+		return nil, err
+	}
+	return bs, nil
+}
+
 // Base returns the first known contiguous block height, or 0 for empty block stores.
 func (bs *BlockStore) Base() int64 {
 	bs.mtx.RLock()
@@ -96,6 +130,11 @@ func (bs *BlockStore) LoadBaseMeta() *types.BlockMeta {
 // LoadBlock returns the block with the given height.
 // If no block is found for that height, it returns nil.
 func (bs *BlockStore) LoadBlock(height int64) *types.Block {
+	return loadBlock(bs, height)
+}
+
+// loadBlock loads the block with the given height from its parts.
+func loadBlock(bs sm.BlockStore, height int64) *types.Block {
 	blockMeta := bs.LoadBlockMeta(height)
 	if blockMeta == nil {
 		return nil
@@ -139,29 +178,37 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) *types.Block {
 		return nil
 	}
 
+	return bs.LoadBlock(parseHashHeight(bz))
+}
+
+// parseHashHeight parses the height stored under the key of a block hash.
+func parseHashHeight(bz []byte) int64 {
 	s := string(bz)
 	height, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
 		panic(fmt.Sprintf("failed to extract height from %s: %v", s, err))
 	}
-	return bs.LoadBlock(height)
+	return height
 }
 
 // LoadBlockPart returns the Part at the given index
 // from the block at the given height.
 // If no part is found for the given height and index, it returns nil.
 func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
-	pbpart := new(cmtproto.Part)
-
 	bz, err := bs.db.Get(calcBlockPartKey(height, index))
 	if err != nil {
 		panic(err)
 	}
+	return decodeBlockPart(bz)
+}
+
+func decodeBlockPart(bz []byte) *types.Part {
 	if len(bz) == 0 {
 		return nil
 	}
 
-	err = proto.Unmarshal(bz, pbpart)
+	pbpart := new(cmtproto.Part)
+	err := proto.Unmarshal(bz, pbpart)
 	if err != nil {
 		panic(fmt.Errorf("unmarshal to cmtproto.Part failed: %w", err))
 	}
@@ -176,17 +223,20 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 // LoadBlockMeta returns the BlockMeta for the given height.
 // If no block is found for the given height, it returns nil.
 func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
-	pbbm := new(cmtproto.BlockMeta)
 	bz, err := bs.db.Get(calcBlockMetaKey(height))
 	if err != nil {
 		panic(err)
 	}
+	return decodeBlockMeta(bz)
+}
 
+func decodeBlockMeta(bz []byte) *types.BlockMeta {
 	if len(bz) == 0 {
 		return nil
 	}
 
-	err = proto.Unmarshal(bz, pbbm)
+	pbbm := new(cmtproto.BlockMeta)
+	err := proto.Unmarshal(bz, pbbm)
 	if err != nil {
 		panic(fmt.Errorf("unmarshal to cmtproto.BlockMeta: %w", err))
 	}
@@ -210,12 +260,7 @@ func (bs *BlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta {
 		return nil
 	}
 
-	s := string(bz)
-	height, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
-		panic(fmt.Sprintf("failed to extract height from %s: %v", s, err))
-	}
-	return bs.LoadBlockMeta(height)
+	return bs.LoadBlockMeta(parseHashHeight(bz))
 }
 
 // LoadBlockCommit returns the Commit for the given height.
@@ -223,15 +268,19 @@ func (bs *BlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta {
 // and it comes from the block.LastCommit for `height+1`.
 // If no commit is found for the given height, it returns nil.
 func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
-	pbc := new(cmtproto.Commit)
 	bz, err := bs.db.Get(calcBlockCommitKey(height))
 	if err != nil {
 		panic(err)
 	}
+	return decodeBlockCommit(bz)
+}
+
+func decodeBlockCommit(bz []byte) *types.Commit {
 	if len(bz) == 0 {
 		return nil
 	}
-	err = proto.Unmarshal(bz, pbc)
+	pbc := new(cmtproto.Commit)
+	err := proto.Unmarshal(bz, pbc)
 	if err != nil {
 		panic(fmt.Errorf("error reading block commit: %w", err))
 	}
@@ -246,15 +295,19 @@ func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 // The extended commit is not guaranteed to contain the same +2/3 precommits data
 // as the commit in the block.
 func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
-	pbec := new(cmtproto.ExtendedCommit)
 	bz, err := bs.db.Get(calcExtCommitKey(height))
 	if err != nil {
 		panic(fmt.Errorf("fetching extended commit: %w", err))
 	}
+	return decodeExtendedCommit(bz)
+}
+
+func decodeExtendedCommit(bz []byte) *types.ExtendedCommit {
 	if len(bz) == 0 {
 		return nil
 	}
-	err = proto.Unmarshal(bz, pbec)
+	pbec := new(cmtproto.ExtendedCommit)
+	err := proto.Unmarshal(bz, pbec)
 	if err != nil {
 		panic(fmt.Errorf("decoding extended commit: %w", err))
 	}
@@ -269,15 +322,19 @@ func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommi
 // This is useful when we've seen a commit, but there has not yet been
 // a new block at `height + 1` that includes this commit in its block.LastCommit.
 func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
-	pbc := new(cmtproto.Commit)
 	bz, err := bs.db.Get(calcSeenCommitKey(height))
 	if err != nil {
 		panic(err)
 	}
+	return decodeSeenCommit(bz)
+}
+
+func decodeSeenCommit(bz []byte) *types.Commit {
 	if len(bz) == 0 {
 		return nil
 	}
-	err = proto.Unmarshal(bz, pbc)
+	pbc := new(cmtproto.Commit)
+	err := proto.Unmarshal(bz, pbc)
 	if err != nil {
 		panic(fmt.Sprintf("error reading block seen commit: %v", err))
 	}
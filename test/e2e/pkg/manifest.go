@@ -61,6 +61,10 @@ type Manifest struct {
 	// in precommit messages.
 	VoteExtensionsEnableHeight int64 `toml:"vote_extensions_enable_height"`
 
+	// PbtsEnableHeight configures the first height during which the chain
+	// will use proposer-based timestamps.
+	PbtsEnableHeight int64 `toml:"pbts_enable_height"`
+
 	// ABCIProtocol specifies the protocol used to communicate with the ABCI
 	// application: "unix", "tcp", "grpc", "builtin" or "builtin_connsync".
 	//
@@ -88,6 +88,7 @@ type Testnet struct {
 	UpgradeVersion                   string
 	Prometheus                       bool
 	VoteExtensionsEnableHeight       int64
+	PbtsEnableHeight                 int64
 	VoteExtensionSize                uint
 	PeerGossipIntraloopSleepDuration time.Duration
 }
@@ -167,6 +168,7 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		UpgradeVersion:                   manifest.UpgradeVersion,
 		Prometheus:                       manifest.Prometheus,
 		VoteExtensionsEnableHeight:       manifest.VoteExtensionsEnableHeight,
+		PbtsEnableHeight:                 manifest.PbtsEnableHeight,
 		VoteExtensionSize:                manifest.VoteExtensionSize,
 		PeerGossipIntraloopSleepDuration: manifest.PeerGossipIntraloopSleepDuration,
 	}
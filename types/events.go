@@ -82,6 +82,8 @@ type EventDataNewBlockEvents struct {
 type EventDataNewEvidence struct {
 	Height   int64    `json:"height"`
 	Evidence Evidence `json:"evidence"`
+	// Addresses of the validators which misbehaved.
+	ValidatorAddresses []Address `json:"validator_addresses"`
 }
 
 // All txs fire EventDataTx
@@ -124,6 +126,8 @@ type EventDataVote struct {
 type EventDataString string
 
 type EventDataValidatorSetUpdates struct {
+	// Height of the block whose execution updated the validator set.
+	Height           int64        `json:"height"`
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
@@ -491,6 +491,17 @@ func (evl EvidenceList) ToABCI() []abci.Misbehavior {
 
 //------------------------------------------ PROTO --------------------------------------
 
+// EvidenceValidatorAddresses returns the addresses of the validators which
+// misbehaved, as reported to the application.
+func EvidenceValidatorAddresses(evidence Evidence) []Address {
+	misbehavior := evidence.ABCI()
+	addrs := make([]Address, len(misbehavior))
+	for i, m := range misbehavior {
+		addrs[i] = m.Validator.Address
+	}
+	return addrs
+}
+
 // EvidenceToProto is a generalized function for encoding evidence that conforms to the
 // evidence interface to protobuf
 func EvidenceToProto(evidence Evidence) (*cmtproto.Evidence, error) {
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -38,6 +39,8 @@ type ConsensusParams struct {
 	Validator ValidatorParams `json:"validator"`
 	Version   VersionParams   `json:"version"`
 	ABCI      ABCIParams      `json:"abci"`
+	Synchrony SynchronyParams `json:"synchrony"`
+	Feature   FeatureParams   `json:"feature"`
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -82,6 +85,41 @@ func (a ABCIParams) VoteExtensionsEnabled(h int64) bool {
 	return a.VoteExtensionsEnableHeight <= h
 }
 
+// SynchronyParams determine the bounds of the clock drift between validators
+// and of the message delay used by proposer-based timestamps (PBTS).
+type SynchronyParams struct {
+	Precision    time.Duration `json:"precision"`
+	MessageDelay time.Duration `json:"message_delay"`
+}
+
+// InRound returns the synchrony params used in the given round. The message
+// delay is increased by 10% for every round after the first one, so that
+// consensus eventually progresses if it is set too low.
+func (sp SynchronyParams) InRound(round int32) SynchronyParams {
+	return SynchronyParams{
+		Precision:    sp.Precision,
+		MessageDelay: time.Duration(float64(sp.MessageDelay) * math.Pow(1.1, float64(round))),
+	}
+}
+
+// FeatureParams configure the heights from which features of consensus are
+// enabled.
+type FeatureParams struct {
+	PbtsEnableHeight int64 `json:"pbts_enable_height"`
+}
+
+// PbtsEnabled returns true if proposer-based timestamps are enabled at height
+// h and false otherwise.
+func (f FeatureParams) PbtsEnabled(h int64) bool {
+	if h < 1 {
+		panic(fmt.Errorf("cannot check if PBTS is enabled for height %d (< 1)", h))
+	}
+	if f.PbtsEnableHeight == 0 {
+		return false
+	}
+	return f.PbtsEnableHeight <= h
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -90,6 +128,8 @@ func DefaultConsensusParams() *ConsensusParams {
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
 		ABCI:      DefaultABCIParams(),
+		Synchrony: DefaultSynchronyParams(),
+		Feature:   DefaultFeatureParams(),
 	}
 }
 
@@ -131,6 +171,24 @@ func DefaultABCIParams() ABCIParams {
 	}
 }
 
+// DefaultSynchronyParams returns a default SynchronyParams.
+func DefaultSynchronyParams() SynchronyParams {
+	return SynchronyParams{
+		// 505ms was selected as the default to enable chains that have validators
+		// in mixed leap-second handling environments.
+		// For more information, see: https://github.com/tendermint/tendermint/issues/7724
+		Precision:    505 * time.Millisecond,
+		MessageDelay: 15 * time.Second,
+	}
+}
+
+func DefaultFeatureParams() FeatureParams {
+	return FeatureParams{
+		// When set to 0, proposer-based timestamps are disabled.
+		PbtsEnableHeight: 0,
+	}
+}
+
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -189,6 +247,27 @@ func (params ConsensusParams) ValidateBasic() error {
 		return fmt.Errorf("ABCI.VoteExtensionsEnableHeight cannot be negative. Got: %d", params.ABCI.VoteExtensionsEnableHeight)
 	}
 
+	if params.Feature.PbtsEnableHeight < 0 {
+		return fmt.Errorf("Feature.PbtsEnableHeight cannot be negative. Got: %d", params.Feature.PbtsEnableHeight)
+	}
+
+	// The synchrony params are only used with PBTS, and may be unset in the
+	// params of chains that do not use it.
+	if params.Synchrony.Precision < 0 {
+		return fmt.Errorf("synchrony.Precision cannot be negative. Got: %v", params.Synchrony.Precision)
+	}
+	if params.Synchrony.MessageDelay < 0 {
+		return fmt.Errorf("synchrony.MessageDelay cannot be negative. Got: %v", params.Synchrony.MessageDelay)
+	}
+	if params.Feature.PbtsEnableHeight > 0 {
+		if params.Synchrony.Precision == 0 {
+			return errors.New("synchrony.Precision must be greater than 0 when PBTS is enabled")
+		}
+		if params.Synchrony.MessageDelay == 0 {
+			return errors.New("synchrony.MessageDelay must be greater than 0 when PBTS is enabled")
+		}
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -206,6 +285,11 @@ func (params ConsensusParams) ValidateBasic() error {
 }
 
 func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams, h int64) error {
+	if updated.Feature != nil {
+		if err := params.validatePbtsEnableHeightUpdate(updated.Feature.PbtsEnableHeight, h); err != nil {
+			return err
+		}
+	}
 	if updated.Abci == nil {
 		return nil
 	}
@@ -229,6 +313,31 @@ func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams,
 	return nil
 }
 
+// validatePbtsEnableHeightUpdate checks that PBTS is only enabled from a
+// future height, and that the height is not modified once reached. Unlike
+// vote extensions, PBTS can be enabled on a running chain.
+func (params ConsensusParams) validatePbtsEnableHeightUpdate(updated, h int64) error {
+	current := params.Feature.PbtsEnableHeight
+	if current == updated {
+		return nil
+	}
+	if current != 0 && updated == 0 {
+		return errors.New("PBTS cannot be disabled once enabled")
+	}
+	if updated <= h {
+		return fmt.Errorf("PbtsEnableHeight cannot be updated to a past height, "+
+			"initial height: %d, current height %d",
+			current, h)
+	}
+	if current != 0 && current <= h {
+		return fmt.Errorf("PbtsEnableHeight cannot be modified once"+
+			"the initial height has occurred, "+
+			"initial height: %d, current height %d",
+			current, h)
+	}
+	return nil
+}
+
 // Hash returns a hash of a subset of the parameters to store in the block header.
 // Only the Block.MaxBytes and Block.MaxGas are included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
@@ -283,6 +392,17 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Abci != nil {
 		res.ABCI.VoteExtensionsEnableHeight = params2.Abci.GetVoteExtensionsEnableHeight()
 	}
+	if params2.Synchrony != nil {
+		if params2.Synchrony.Precision != nil {
+			res.Synchrony.Precision = *params2.Synchrony.Precision
+		}
+		if params2.Synchrony.MessageDelay != nil {
+			res.Synchrony.MessageDelay = *params2.Synchrony.MessageDelay
+		}
+	}
+	if params2.Feature != nil {
+		res.Feature.PbtsEnableHeight = params2.Feature.GetPbtsEnableHeight()
+	}
 	return res
 }
 
@@ -306,6 +426,13 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Abci: &cmtproto.ABCIParams{
 			VoteExtensionsEnableHeight: params.ABCI.VoteExtensionsEnableHeight,
 		},
+		Synchrony: &cmtproto.SynchronyParams{
+			Precision:    durationPtr(params.Synchrony.Precision),
+			MessageDelay: durationPtr(params.Synchrony.MessageDelay),
+		},
+		Feature: &cmtproto.FeatureParams{
+			PbtsEnableHeight: params.Feature.PbtsEnableHeight,
+		},
 	}
 }
 
@@ -330,5 +457,20 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 	if pbParams.Abci != nil {
 		c.ABCI.VoteExtensionsEnableHeight = pbParams.Abci.GetVoteExtensionsEnableHeight()
 	}
+	if pbParams.Synchrony != nil {
+		if pbParams.Synchrony.Precision != nil {
+			c.Synchrony.Precision = *pbParams.Synchrony.Precision
+		}
+		if pbParams.Synchrony.MessageDelay != nil {
+			c.Synchrony.MessageDelay = *pbParams.Synchrony.MessageDelay
+		}
+	}
+	if pbParams.Feature != nil {
+		c.Feature.PbtsEnableHeight = pbParams.Feature.GetPbtsEnableHeight()
+	}
 	return c
 }
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -202,6 +202,25 @@ func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
 		}
 		require.NoError(t, initialParams.ValidateUpdate(update, 500))
 	})
+	t.Run("set from 0 once the chain is running", func(t *testing.T) {
+		initialParams := makeParams(1, 0, 2, 0, valEd25519, 0)
+		update := &cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{
+				VoteExtensionsEnableHeight: 10,
+			},
+		}
+		require.Error(t, initialParams.ValidateUpdate(update, 1))
+		require.Error(t, initialParams.ValidateUpdate(update, 5))
+	})
+	t.Run("set from 0 to the current height", func(t *testing.T) {
+		initialParams := makeParams(1, 0, 2, 0, valEd25519, 0)
+		update := &cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{
+				VoteExtensionsEnableHeight: 5,
+			},
+		}
+		require.Error(t, initialParams.ValidateUpdate(update, 5))
+	})
 	t.Run("updated from 0 to 0", func(t *testing.T) {
 		initialParams := makeParams(1, 0, 2, 0, valEd25519, 0)
 		update := &cmtproto.ConsensusParams{
@@ -234,3 +253,63 @@ func TestProto(t *testing.T) {
 
 	}
 }
+
+func TestConsensusParamsValidation_PBTS(t *testing.T) {
+	params := makeParams(1, 0, 2, 0, valEd25519, 0)
+	require.NoError(t, params.ValidateBasic())
+
+	// The synchrony params are required once PBTS is enabled.
+	params.Feature.PbtsEnableHeight = 10
+	require.Error(t, params.ValidateBasic())
+	params.Synchrony = DefaultSynchronyParams()
+	require.NoError(t, params.ValidateBasic())
+
+	params.Synchrony.MessageDelay = -1
+	require.Error(t, params.ValidateBasic())
+	params.Synchrony.MessageDelay = time.Second
+	params.Feature.PbtsEnableHeight = -1
+	require.Error(t, params.ValidateBasic())
+}
+
+func TestConsensusParamsUpdate_PBTS(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519, 0)
+	precision := 2 * time.Second
+
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Synchrony: &cmtproto.SynchronyParams{Precision: &precision},
+		Feature:   &cmtproto.FeatureParams{PbtsEnableHeight: 10},
+	})
+	assert.Equal(t, precision, updated.Synchrony.Precision)
+	// Unset fields are not updated.
+	assert.Equal(t, params.Synchrony.MessageDelay, updated.Synchrony.MessageDelay)
+	assert.EqualValues(t, 10, updated.Feature.PbtsEnableHeight)
+	assert.False(t, updated.Feature.PbtsEnabled(9))
+	assert.True(t, updated.Feature.PbtsEnabled(10))
+}
+
+func TestConsensusParamsValidateUpdate_PBTS(t *testing.T) {
+	update := func(height int64) *cmtproto.ConsensusParams {
+		return &cmtproto.ConsensusParams{Feature: &cmtproto.FeatureParams{PbtsEnableHeight: height}}
+	}
+
+	disabled := makeParams(1, 0, 2, 0, valEd25519, 0)
+	require.NoError(t, disabled.ValidateUpdate(update(0), 5))
+	// PBTS can be enabled at a future upgrade height only.
+	require.NoError(t, disabled.ValidateUpdate(update(10), 5))
+	require.Error(t, disabled.ValidateUpdate(update(5), 5))
+
+	enabled := makeParams(1, 0, 2, 0, valEd25519, 0)
+	enabled.Feature.PbtsEnableHeight = 10
+	require.NoError(t, enabled.ValidateUpdate(update(20), 5))
+	require.Error(t, enabled.ValidateUpdate(update(0), 5))
+	require.Error(t, enabled.ValidateUpdate(update(20), 10))
+	require.Error(t, enabled.ValidateUpdate(update(0), 10))
+}
+
+func TestSynchronyParamsInRound(t *testing.T) {
+	sp := SynchronyParams{Precision: time.Second, MessageDelay: 10 * time.Second}
+	assert.Equal(t, sp, sp.InRound(0))
+	assert.Equal(t, time.Second, sp.InRound(1).Precision)
+	assert.Equal(t, 11*time.Second, sp.InRound(1).MessageDelay)
+	assert.Greater(t, sp.InRound(10).MessageDelay, sp.InRound(9).MessageDelay)
+}
@@ -79,6 +79,17 @@ func (p *Proposal) ValidateBasic() error {
 	return nil
 }
 
+// IsTimely checks whether a proposal received at recvTime is timely according
+// to proposer-based timestamps (PBTS), that is whether recvTime is within
+// [Timestamp - Precision, Timestamp + MessageDelay + Precision], with the
+// synchrony params of the round of the proposal.
+func (p *Proposal) IsTimely(recvTime time.Time, sp SynchronyParams) bool {
+	sp = sp.InRound(p.Round)
+	lhs := p.Timestamp.Add(-sp.Precision)
+	rhs := p.Timestamp.Add(sp.MessageDelay).Add(sp.Precision)
+	return !recvTime.Before(lhs) && !recvTime.After(rhs)
+}
+
 // String returns a string representation of the Proposal.
 //
 // 1. height
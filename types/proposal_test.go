@@ -190,3 +190,29 @@ func TestProposalProtoBuf(t *testing.T) {
 		}
 	}
 }
+
+func TestProposalIsTimely(t *testing.T) {
+	timestamp := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	sp := SynchronyParams{Precision: time.Second, MessageDelay: 2 * time.Second}
+
+	testCases := []struct {
+		name     string
+		round    int32
+		recvTime time.Time
+		timely   bool
+	}{
+		{"received at timestamp", 0, timestamp, true},
+		{"received before timestamp within precision", 0, timestamp.Add(-time.Second), true},
+		{"received too early", 0, timestamp.Add(-time.Second - 1), false},
+		{"received within message delay and precision", 0, timestamp.Add(3 * time.Second), true},
+		{"received too late", 0, timestamp.Add(3*time.Second + 1), false},
+		{"message delay increases with rounds", 3, timestamp.Add(3*time.Second + 1), true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p := Proposal{Round: tc.round, Timestamp: timestamp}
+			assert.Equal(t, tc.timely, p.IsTimely(tc.recvTime, sp))
+		})
+	}
+}
@@ -161,11 +161,25 @@ func (voteSet *VoteSet) AddVote(vote *Vote) (added bool, err error) {
 	voteSet.mtx.Lock()
 	defer voteSet.mtx.Unlock()
 
-	return voteSet.addVote(vote)
+	return voteSet.addVote(vote, false)
+}
+
+// AddPreverifiedVote is like AddVote, except that it does not verify the
+// signature of the vote, nor its extension signature if it has one. The
+// caller must have verified them with the chain ID of the vote set and the
+// public key whose address is vote.ValidatorAddress.
+func (voteSet *VoteSet) AddPreverifiedVote(vote *Vote) (added bool, err error) {
+	if voteSet == nil {
+		panic("AddPreverifiedVote() on nil VoteSet")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	return voteSet.addVote(vote, true)
 }
 
 // NOTE: Validates as much as possible before attempting to verify the signature.
-func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
+func (voteSet *VoteSet) addVote(vote *Vote, preverified bool) (added bool, err error) {
 	if vote == nil {
 		return false, ErrVoteNil
 	}
@@ -214,13 +228,20 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 	}
 
 	// Check signature.
-	if voteSet.extensionsEnabled {
+	switch {
+	case voteSet.extensionsEnabled && preverified:
+		if err := vote.EnsureExtension(); err != nil {
+			return false, fmt.Errorf("invalid extended vote: %w", err)
+		}
+	case voteSet.extensionsEnabled:
 		if err := vote.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
 			return false, fmt.Errorf("failed to verify extended vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 		}
-	} else {
-		if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
-			return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
+	default:
+		if !preverified {
+			if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+				return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
+			}
 		}
 		if len(vote.ExtensionSignature) > 0 || len(vote.Extension) > 0 {
 			return false, fmt.Errorf("unexpected vote extension data present in vote; ext_len %d, sig_len %d",
@@ -45,6 +45,40 @@ func TestVoteSet_AddVote_Good(t *testing.T) {
 	assert.False(t, ok || !blockID.IsNil(), "there should be no 2/3 majority")
 }
 
+func TestVoteSet_AddPreverifiedVote(t *testing.T) {
+	height, round := int64(1), int32(0)
+	valSet, privValidators := RandValidatorSet(4, 10)
+	voteSet := NewExtendedVoteSet("test_chain_id", height, round, cmtproto.PrecommitType, valSet)
+	blockID := BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}}
+
+	newVote := func(idx int32) *Vote {
+		pubKey, err := privValidators[idx].GetPubKey()
+		require.NoError(t, err)
+		return &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   idx,
+			Height:           height,
+			Round:            round,
+			Type:             cmtproto.PrecommitType,
+			Timestamp:        cmttime.Now(),
+			BlockID:          blockID,
+		}
+	}
+
+	// the signatures are not verified
+	vote := newVote(0)
+	vote.Signature = []byte("signature")
+	vote.ExtensionSignature = []byte("extension signature")
+	added, err := voteSet.AddPreverifiedVote(vote)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// but the extension signature must be present
+	added, err = voteSet.AddPreverifiedVote(newVote(1))
+	require.ErrorIs(t, err, ErrVoteExtensionAbsent)
+	assert.False(t, added)
+}
+
 func TestVoteSet_AddVote_Bad(t *testing.T) {
 	height, round := int64(1), int32(0)
 	voteSet, _, privValidators := randVoteSet(height, round, cmtproto.PrevoteType, 10, 1, false)